@@ -99,7 +99,7 @@ func (in *APIAuthInterceptor) AuthenticationInterceptor(ctx context.Context, req
 	}
 	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-	principalID, err := in.getPrincipalID(ctx, accessTokenStr)
+	principalID, scope, err := in.getPrincipalID(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod, authContext) {
 			return handler(ctx, request)
@@ -108,6 +108,9 @@ func (in *APIAuthInterceptor) AuthenticationInterceptor(ctx context.Context, req
 	}
 
 	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
+	if scope != nil {
+		ctx = context.WithValue(ctx, common.AccessTokenScopeContextKey, scope)
+	}
 	return handler(ctx, request)
 }
 
@@ -129,7 +132,7 @@ func (in *APIAuthInterceptor) AuthenticationStreamInterceptor(request any, ss gr
 	}
 	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
 
-	principalID, err := in.getPrincipalID(ctx, accessTokenStr)
+	principalID, scope, err := in.getPrincipalID(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod, authContext) {
 			return handler(request, ss)
@@ -138,6 +141,9 @@ func (in *APIAuthInterceptor) AuthenticationStreamInterceptor(request any, ss gr
 	}
 
 	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
+	if scope != nil {
+		ctx = context.WithValue(ctx, common.AccessTokenScopeContextKey, scope)
+	}
 	sss := overrideStream{ServerStream: ss, childCtx: ctx}
 	return handler(request, sss)
 }
@@ -151,65 +157,80 @@ func (s overrideStream) Context() context.Context {
 	return s.childCtx
 }
 
-func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr string) (int, error) {
+// authenticate returns the principal ID of the access token.
+// The returned scope is non-nil if the request is authenticated by a personal access token or a service account key.
+func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr string) (int, *common.AccessTokenScope, error) {
 	if accessTokenStr == "" {
-		return 0, status.Errorf(codes.Unauthenticated, "access token not found")
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
 	if _, ok := in.stateCfg.ExpireCache.Get(accessTokenStr); ok {
-		return 0, status.Errorf(codes.Unauthenticated, "access token expired")
+		return 0, nil, status.Errorf(codes.Unauthenticated, "access token expired")
 	}
-	claims := &claimsMessage{}
-	if _, err := jwt.ParseWithClaims(accessTokenStr, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, status.Errorf(codes.Unauthenticated, "unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+
+	var principalID int
+	var scope *common.AccessTokenScope
+	if IsOpaqueAccessToken(accessTokenStr) {
+		id, s, err := in.authenticateAccessToken(ctx, accessTokenStr)
+		if err != nil {
+			return 0, nil, err
 		}
-		if kid, ok := t.Header["kid"].(string); ok {
-			if kid == "v1" {
-				return []byte(in.secret), nil
+		principalID, scope = id, s
+	} else {
+		claims := &claimsMessage{}
+		if _, err := jwt.ParseWithClaims(accessTokenStr, claims, func(t *jwt.Token) (any, error) {
+			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+				return nil, status.Errorf(codes.Unauthenticated, "unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+			}
+			if kid, ok := t.Header["kid"].(string); ok {
+				if kid == "v1" {
+					return []byte(in.secret), nil
+				}
 			}
+			return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
+		}); err != nil {
+			if errors.Is(err, jwt.ErrTokenExpired) {
+				return 0, nil, status.Errorf(codes.Unauthenticated, "access token expired")
+			}
+			return 0, nil, status.Errorf(codes.Unauthenticated, "failed to parse claim")
 		}
-		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
-	}); err != nil {
-		if errors.Is(err, jwt.ErrTokenExpired) {
-			return 0, status.Errorf(codes.Unauthenticated, "access token expired")
+		if !audienceContains(claims.Audience, fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode)) {
+			return 0, nil, status.Errorf(codes.Unauthenticated,
+				"invalid access token, audience mismatch, got %q, expected %q. you may send request to the wrong environment",
+				claims.Audience,
+				fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode),
+			)
 		}
-		return 0, status.Errorf(codes.Unauthenticated, "failed to parse claim")
-	}
-	if !audienceContains(claims.Audience, fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode)) {
-		return 0, status.Errorf(codes.Unauthenticated,
-			"invalid access token, audience mismatch, got %q, expected %q. you may send request to the wrong environment",
-			claims.Audience,
-			fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode),
-		)
-	}
 
-	principalID, err := strconv.Atoi(claims.Subject)
-	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
+		id, err := strconv.Atoi(claims.Subject)
+		if err != nil {
+			return 0, nil, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
+		}
+		principalID = id
 	}
+
 	user, err := in.store.GetUserByID(ctx, principalID)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", principalID)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", principalID)
 	}
 	if user == nil {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", principalID)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", principalID)
 	}
 	if user.MemberDeleted {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
+		return 0, nil, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
 	}
 
-	return principalID, nil
+	return principalID, scope, nil
 }
 
-func (in *APIAuthInterceptor) getPrincipalID(ctx context.Context, accessTokenStr string) (int, error) {
-	principalID, err := in.authenticate(ctx, accessTokenStr)
+func (in *APIAuthInterceptor) getPrincipalID(ctx context.Context, accessTokenStr string) (int, *common.AccessTokenScope, error) {
+	principalID, scope, err := in.authenticate(ctx, accessTokenStr)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	// Only update for authorized request.
 	in.profile.LastActiveTs = time.Now().Unix()
-	return principalID, nil
+	return principalID, scope, nil
 }
 
 // GetUserIDFromMFATempToken returns the user ID from the MFA temp token.
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// WorkloadIdentityTokenDuration is the duration of the access token exchanged from a workload identity token.
+	WorkloadIdentityTokenDuration = 1 * time.Hour
+
+	githubActionsIssuer = "https://token.actions.githubusercontent.com"
+	gitlabIssuer        = "https://gitlab.com"
+)
+
+// oidcVerifiers caches the ID token verifiers by issuer so that the discovery document and
+// the JSON web key set are not fetched on every token exchange.
+var oidcVerifiers sync.Map
+
+// workloadIdentityClaims is the union of the claims used by the supported workload identity providers.
+type workloadIdentityClaims struct {
+	// GitHub Actions.
+	Repository string `json:"repository"`
+	// GitLab CI.
+	ProjectPath string `json:"project_path"`
+	RefType     string `json:"ref_type"`
+	// GitHub Actions and GitLab CI.
+	Ref string `json:"ref"`
+}
+
+// GetWorkloadIdentityIssuer returns the issuer URL of the workload identity configuration.
+func GetWorkloadIdentityIssuer(config *storepb.WorkloadIdentityConfig) string {
+	if config.IssuerUrl != "" {
+		return strings.TrimSuffix(config.IssuerUrl, "/")
+	}
+	switch config.ProviderType {
+	case storepb.WorkloadIdentityConfig_GITHUB:
+		return githubActionsIssuer
+	case storepb.WorkloadIdentityConfig_GITLAB:
+		return gitlabIssuer
+	default:
+		return ""
+	}
+}
+
+// ValidateWorkloadIdentityConfig validates the workload identity configuration.
+func ValidateWorkloadIdentityConfig(config *storepb.WorkloadIdentityConfig) error {
+	if config.ProviderType == storepb.WorkloadIdentityConfig_PROVIDER_TYPE_UNSPECIFIED {
+		return errors.New("provider type is required")
+	}
+	// The issuer of the Kubernetes cluster that Bytebase runs in is discovered if not set.
+	if GetWorkloadIdentityIssuer(config) == "" && config.ProviderType != storepb.WorkloadIdentityConfig_KUBERNETES {
+		return errors.New("issuer url is required")
+	}
+	if len(config.AllowedAudiences) == 0 {
+		return errors.New("at least one allowed audience is required")
+	}
+	for _, pattern := range []string{config.Repository, config.Branch, config.Subject} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid pattern %q", pattern)
+		}
+	}
+	if config.Repository == "" && config.Subject == "" {
+		// Otherwise any workload of the issuer could impersonate the service account.
+		return errors.New("either repository or subject is required")
+	}
+	// The owner of the repository cannot be a wildcard, otherwise any repository of the issuer, e.g. all of github.com, is allowed.
+	if config.Repository != "" {
+		owner, _, ok := strings.Cut(config.Repository, "/")
+		if !ok || owner == "" || hasWildcard(owner) {
+			return errors.Errorf("repository %q must start with the owner, such as org/repo or org/*", config.Repository)
+		}
+	}
+	if config.Subject != "" && strings.Trim(config.Subject, "*?") == "" {
+		return errors.Errorf("subject %q matches any workload", config.Subject)
+	}
+	return nil
+}
+
+// hasWildcard returns true if the pattern contains the special characters of path.Match.
+func hasWildcard(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[\`)
+}
+
+// VerifyWorkloadIdentityToken verifies the signature and the claims of the OIDC ID token against
+// the workload identity configuration.
+func VerifyWorkloadIdentityToken(ctx context.Context, config *storepb.WorkloadIdentityConfig, rawIDToken string) error {
+	if err := ValidateWorkloadIdentityConfig(config); err != nil {
+		return errors.Wrapf(err, "invalid workload identity config")
+	}
+	verifier, err := getOIDCVerifier(ctx, GetWorkloadIdentityIssuer(config))
+	if err != nil {
+		return err
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return errors.Wrapf(err, "failed to verify ID token")
+	}
+	if !slices.ContainsFunc(idToken.Audience, func(audience string) bool {
+		return slices.Contains(config.AllowedAudiences, audience)
+	}) {
+		return errors.Errorf("audience %v is not allowed", idToken.Audience)
+	}
+
+	var claims workloadIdentityClaims
+	if err := idToken.Claims(&claims); err != nil {
+		return errors.Wrapf(err, "failed to parse claims")
+	}
+	if config.Subject != "" && !matchClaim(config.Subject, idToken.Subject) {
+		return errors.Errorf("subject %q is not allowed", idToken.Subject)
+	}
+	if config.Repository != "" {
+		repository := claims.Repository
+		if config.ProviderType == storepb.WorkloadIdentityConfig_GITLAB {
+			repository = claims.ProjectPath
+		}
+		if !matchClaim(config.Repository, repository) {
+			return errors.Errorf("repository %q is not allowed", repository)
+		}
+	}
+	if config.Branch != "" {
+		branch, ok := getBranchFromClaims(config.ProviderType, &claims)
+		if !ok {
+			return errors.Errorf("ref %q is not a branch", claims.Ref)
+		}
+		if !matchClaim(config.Branch, branch) {
+			return errors.Errorf("branch %q is not allowed", branch)
+		}
+	}
+	return nil
+}
+
+// getOIDCVerifier returns the ID token verifier of the issuer.
+// The empty issuer stands for the Kubernetes cluster that Bytebase runs in.
+func getOIDCVerifier(ctx context.Context, issuer string) (*oidc.IDTokenVerifier, error) {
+	if v, ok := oidcVerifiers.Load(issuer); ok {
+		if verifier, ok := v.(*oidc.IDTokenVerifier); ok {
+			return verifier, nil
+		}
+	}
+	// The verifier outlives the request, so it must not be bound to the request context.
+	ctx = context.WithoutCancel(ctx)
+	// The audience is checked against the allowed audiences instead of a single client ID.
+	config := &oidc.Config{SkipClientIDCheck: true}
+	var verifier *oidc.IDTokenVerifier
+	if issuer == "" {
+		v, err := getInClusterKubernetesVerifier(ctx, config)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to discover the Kubernetes service account issuer")
+		}
+		verifier = v
+	} else {
+		provider, err := oidc.NewProvider(ctx, issuer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to discover OIDC provider %q", issuer)
+		}
+		verifier = provider.Verifier(config)
+	}
+	oidcVerifiers.Store(issuer, verifier)
+	return verifier, nil
+}
+
+func getBranchFromClaims(providerType storepb.WorkloadIdentityConfig_ProviderType, claims *workloadIdentityClaims) (string, bool) {
+	switch providerType {
+	case storepb.WorkloadIdentityConfig_GITLAB:
+		// GitLab uses the bare branch name in ref.
+		if claims.RefType != "branch" {
+			return "", false
+		}
+		return claims.Ref, true
+	default:
+		branch, ok := strings.CutPrefix(claims.Ref, "refs/heads/")
+		if !ok {
+			return "", false
+		}
+		return branch, true
+	}
+}
+
+func matchClaim(pattern, value string) bool {
+	if value == "" {
+		return false
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"github.com/pkg/errors"
+)
+
+// kubernetesServiceAccountDir is where Kubernetes mounts the service account token and the cluster CA of the pod.
+// It's a variable to be replaced in tests.
+var kubernetesServiceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// kubernetesDiscovery is the service account issuer discovery document of the Kubernetes API server.
+type kubernetesDiscovery struct {
+	Issuer     string   `json:"issuer"`
+	Algorithms []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// getInClusterKubernetesVerifier discovers the service account issuer of the cluster that Bytebase runs in
+// and verifies the ID tokens with the JSON web key set served by the API server.
+// Docs: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#service-account-issuer-discovery
+func getInClusterKubernetesVerifier(ctx context.Context, config *oidc.Config) (*oidc.IDTokenVerifier, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, errors.New("issuer url is required if Bytebase does not run in a Kubernetes cluster")
+	}
+	ca, err := os.ReadFile(filepath.Join(kubernetesServiceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the cluster CA")
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(ca) {
+		return nil, errors.New("invalid cluster CA")
+	}
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &serviceAccountTransport{
+			tokenFile: filepath.Join(kubernetesServiceAccountDir, "token"),
+			base: &http.Transport{
+				TLSClientConfig: &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
+			},
+		},
+	}
+	apiServerURL := "https://" + net.JoinHostPort(host, port)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiServerURL+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the discovery document")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the discovery document")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to get the discovery document, status: %s, body: %s", resp.Status, body)
+	}
+	var discovery kubernetesDiscovery
+	if err := json.Unmarshal(body, &discovery); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal the discovery document")
+	}
+	if discovery.Issuer == "" {
+		return nil, errors.New("issuer is missing in the discovery document")
+	}
+
+	// The jwks_uri of the discovery document may be an external address, while the API server always serves the key set.
+	keySet := oidc.NewRemoteKeySet(oidc.ClientContext(ctx, client), apiServerURL+"/openid/v1/jwks")
+	verifierConfig := *config
+	if len(verifierConfig.SupportedSigningAlgs) == 0 {
+		verifierConfig.SupportedSigningAlgs = discovery.Algorithms
+	}
+	return oidc.NewVerifier(discovery.Issuer, keySet, &verifierConfig), nil
+}
+
+// serviceAccountTransport authenticates the requests with the service account token of the pod.
+// The token file is read on every request since the kubelet rotates the projected token.
+type serviceAccountTransport struct {
+	tokenFile string
+	base      http.RoundTripper
+}
+
+func (t *serviceAccountTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := os.ReadFile(t.tokenFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the service account token")
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", strings.TrimSpace(string(token))))
+	return t.base.RoundTrip(req)
+}
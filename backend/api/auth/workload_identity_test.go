@@ -0,0 +1,389 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const testKeyID = "test-key"
+
+// testIssuer is a local OIDC issuer serving the discovery document and the JSON web key set.
+type testIssuer struct {
+	key    *rsa.PrivateKey
+	server *httptest.Server
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                issuer.server.URL,
+			"jwks_uri":                              issuer.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(getJWKS(&key.PublicKey))
+	})
+	issuer.server = httptest.NewServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func getJWKS(key *rsa.PublicKey) map[string]any {
+	return map[string]any{
+		"keys": []map[string]any{
+			{
+				"kty": "RSA",
+				"kid": testKeyID,
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	}
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestValidateWorkloadIdentityConfig(t *testing.T) {
+	tests := []struct {
+		repository string
+		subject    string
+		wantErr    bool
+	}{
+		{repository: "bytebase/bytebase"},
+		{repository: "bytebase/*"},
+		{repository: "group/subgroup/*"},
+		{subject: "repo:bytebase/bytebase:*"},
+		// Any repository of the issuer.
+		{repository: "*", wantErr: true},
+		{repository: "*/*", wantErr: true},
+		{repository: "*/bytebase", wantErr: true},
+		{repository: "byte*/bytebase", wantErr: true},
+		{repository: "bytebase", wantErr: true},
+		// Any workload of the issuer.
+		{subject: "*", wantErr: true},
+		{wantErr: true},
+		{repository: "bytebase/[", wantErr: true},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		err := ValidateWorkloadIdentityConfig(&storepb.WorkloadIdentityConfig{
+			ProviderType:     storepb.WorkloadIdentityConfig_GITHUB,
+			AllowedAudiences: []string{"bytebase"},
+			Repository:       test.repository,
+			Subject:          test.subject,
+		})
+		if test.wantErr {
+			a.Error(err, "repository %q, subject %q", test.repository, test.subject)
+		} else {
+			a.NoError(err, "repository %q, subject %q", test.repository, test.subject)
+		}
+	}
+}
+
+func TestVerifyWorkloadIdentityToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	now := time.Now()
+	githubClaims := func(overrides jwt.MapClaims) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"iss":        issuer.server.URL,
+			"aud":        "bytebase",
+			"sub":        "repo:bytebase/bytebase:ref:refs/heads/main",
+			"iat":        now.Unix(),
+			"exp":        now.Add(time.Hour).Unix(),
+			"repository": "bytebase/bytebase",
+			"ref":        "refs/heads/main",
+		}
+		for k, v := range overrides {
+			claims[k] = v
+		}
+		return claims
+	}
+	githubConfig := &storepb.WorkloadIdentityConfig{
+		ProviderType:     storepb.WorkloadIdentityConfig_GITHUB,
+		IssuerUrl:        issuer.server.URL,
+		AllowedAudiences: []string{"bytebase"},
+		Repository:       "bytebase/*",
+		Branch:           "main",
+	}
+
+	tests := []struct {
+		name    string
+		config  *storepb.WorkloadIdentityConfig
+		token   string
+		wantErr string
+	}{
+		{
+			name:   "match",
+			config: githubConfig,
+			token:  signToken(t, issuer.key, githubClaims(nil)),
+		},
+		{
+			name:    "wrong audience",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"aud": "other"})),
+			wantErr: "audience",
+		},
+		{
+			name:    "wrong repository",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"repository": "other/bytebase"})),
+			wantErr: "repository",
+		},
+		{
+			name:    "nested repository is not matched by the wildcard",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"repository": "bytebase/a/b"})),
+			wantErr: "repository",
+		},
+		{
+			name:    "wrong branch",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"ref": "refs/heads/dev"})),
+			wantErr: "branch",
+		},
+		{
+			name:    "tag is not a branch",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"ref": "refs/tags/main"})),
+			wantErr: "not a branch",
+		},
+		{
+			name:    "expired token",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"iat": now.Add(-2 * time.Hour).Unix(), "exp": now.Add(-time.Hour).Unix()})),
+			wantErr: "expired",
+		},
+		{
+			name:    "wrong issuer",
+			config:  githubConfig,
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"iss": "https://token.actions.githubusercontent.com"})),
+			wantErr: "issued by a different provider",
+		},
+		{
+			name:    "wrong signature",
+			config:  githubConfig,
+			token:   signToken(t, otherKey, githubClaims(nil)),
+			wantErr: "failed to verify ID token",
+		},
+		{
+			name: "subject wildcard",
+			config: &storepb.WorkloadIdentityConfig{
+				ProviderType:     storepb.WorkloadIdentityConfig_GITHUB,
+				IssuerUrl:        issuer.server.URL,
+				AllowedAudiences: []string{"other", "bytebase"},
+				Subject:          "repo:bytebase/bytebase:ref:refs/heads/*",
+			},
+			token: signToken(t, issuer.key, githubClaims(jwt.MapClaims{"aud": []string{"bytebase", "sts"}})),
+		},
+		{
+			name: "wrong subject",
+			config: &storepb.WorkloadIdentityConfig{
+				ProviderType:     storepb.WorkloadIdentityConfig_GITHUB,
+				IssuerUrl:        issuer.server.URL,
+				AllowedAudiences: []string{"bytebase"},
+				Subject:          "repo:bytebase/bytebase:environment:*",
+			},
+			token:   signToken(t, issuer.key, githubClaims(nil)),
+			wantErr: "subject",
+		},
+		{
+			name: "gitlab project path and branch",
+			config: &storepb.WorkloadIdentityConfig{
+				ProviderType:     storepb.WorkloadIdentityConfig_GITLAB,
+				IssuerUrl:        issuer.server.URL,
+				AllowedAudiences: []string{"bytebase"},
+				Repository:       "group/*",
+				Branch:           "release-*",
+			},
+			token: signToken(t, issuer.key, githubClaims(jwt.MapClaims{"project_path": "group/project", "ref": "release-1", "ref_type": "branch"})),
+		},
+		{
+			name: "gitlab tag",
+			config: &storepb.WorkloadIdentityConfig{
+				ProviderType:     storepb.WorkloadIdentityConfig_GITLAB,
+				IssuerUrl:        issuer.server.URL,
+				AllowedAudiences: []string{"bytebase"},
+				Repository:       "group/*",
+				Branch:           "*",
+			},
+			token:   signToken(t, issuer.key, githubClaims(jwt.MapClaims{"project_path": "group/project", "ref": "v1", "ref_type": "tag"})),
+			wantErr: "not a branch",
+		},
+		{
+			name: "invalid config",
+			config: &storepb.WorkloadIdentityConfig{
+				ProviderType:     storepb.WorkloadIdentityConfig_GITHUB,
+				IssuerUrl:        issuer.server.URL,
+				AllowedAudiences: []string{"bytebase"},
+				Repository:       "*",
+			},
+			token:   signToken(t, issuer.key, githubClaims(nil)),
+			wantErr: "invalid workload identity config",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := VerifyWorkloadIdentityToken(context.Background(), test.config, test.token)
+			if test.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatchClaim(t *testing.T) {
+	a := require.New(t)
+	a.True(matchClaim("bytebase/*", "bytebase/bytebase"))
+	a.True(matchClaim("main", "main"))
+	a.False(matchClaim("bytebase/*", "bytebase/a/b"))
+	a.False(matchClaim("bytebase/*", "other/bytebase"))
+	// The empty claim never matches.
+	a.False(matchClaim("*", ""))
+	a.False(matchClaim("[", "["))
+}
+
+func TestGetBranchFromClaims(t *testing.T) {
+	tests := []struct {
+		providerType storepb.WorkloadIdentityConfig_ProviderType
+		claims       *workloadIdentityClaims
+		want         string
+		wantOK       bool
+	}{
+		{
+			providerType: storepb.WorkloadIdentityConfig_GITHUB,
+			claims:       &workloadIdentityClaims{Ref: "refs/heads/feature/a"},
+			want:         "feature/a",
+			wantOK:       true,
+		},
+		{
+			providerType: storepb.WorkloadIdentityConfig_GITHUB,
+			claims:       &workloadIdentityClaims{Ref: "refs/pull/1/merge"},
+		},
+		{
+			providerType: storepb.WorkloadIdentityConfig_GITLAB,
+			claims:       &workloadIdentityClaims{Ref: "main", RefType: "branch"},
+			want:         "main",
+			wantOK:       true,
+		},
+		{
+			providerType: storepb.WorkloadIdentityConfig_GITLAB,
+			claims:       &workloadIdentityClaims{Ref: "v1", RefType: "tag"},
+		},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		branch, ok := getBranchFromClaims(test.providerType, test.claims)
+		a.Equal(test.wantOK, ok, test.claims.Ref)
+		a.Equal(test.want, branch, test.claims.Ref)
+	}
+}
+
+func TestKubernetesIssuerDiscovery(t *testing.T) {
+	a := require.New(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	a.NoError(err)
+	const (
+		kubernetesIssuer = "https://kubernetes.default.svc.cluster.local"
+		serviceToken     = "service-account-token"
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The API server requires the service account token of the pod.
+		if r.Header.Get("Authorization") != "Bearer "+serviceToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"issuer": kubernetesIssuer,
+				// The external address is ignored, the key set is always fetched from the API server.
+				"jwks_uri":                              "https://unreachable.example.com/openid/v1/jwks",
+				"id_token_signing_alg_values_supported": []string{"RS256"},
+			})
+		case "/openid/v1/jwks":
+			_ = json.NewEncoder(w).Encode(getJWKS(&key.PublicKey))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	a.NoError(os.WriteFile(filepath.Join(dir, "ca.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+	a.NoError(os.WriteFile(filepath.Join(dir, "token"), []byte(serviceToken+"\n"), 0600))
+	defer func(d string) { kubernetesServiceAccountDir = d }(kubernetesServiceAccountDir)
+	kubernetesServiceAccountDir = dir
+	u, err := url.Parse(server.URL)
+	a.NoError(err)
+	host, port, err := net.SplitHostPort(u.Host)
+	a.NoError(err)
+	t.Setenv("KUBERNETES_SERVICE_HOST", host)
+	t.Setenv("KUBERNETES_SERVICE_PORT", port)
+	// The verifier of the in-cluster issuer is cached by the empty issuer.
+	oidcVerifiers.Delete("")
+	defer oidcVerifiers.Delete("")
+
+	config := &storepb.WorkloadIdentityConfig{
+		ProviderType:     storepb.WorkloadIdentityConfig_KUBERNETES,
+		AllowedAudiences: []string{"bytebase"},
+		Subject:          "system:serviceaccount:ci:*",
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss": kubernetesIssuer,
+		"aud": "bytebase",
+		"sub": "system:serviceaccount:ci:deployer",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	a.NoError(VerifyWorkloadIdentityToken(context.Background(), config, signToken(t, key, claims)))
+
+	claims["sub"] = "system:serviceaccount:default:app"
+	a.ErrorContains(VerifyWorkloadIdentityToken(context.Background(), config, signToken(t, key, claims)), "subject")
+}
+
+func TestKubernetesIssuerDiscoveryOutsideCluster(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	oidcVerifiers.Delete("")
+	err := VerifyWorkloadIdentityToken(context.Background(), &storepb.WorkloadIdentityConfig{
+		ProviderType:     storepb.WorkloadIdentityConfig_KUBERNETES,
+		AllowedAudiences: []string{"bytebase"},
+		Subject:          "system:serviceaccount:ci:deployer",
+	}, "token")
+	require.ErrorContains(t, err, "issuer url is required")
+}
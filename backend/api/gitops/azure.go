@@ -62,12 +62,18 @@ func getAzurePullRequestInfo(ctx context.Context, vcsProvider *store.VCSProvider
 		return nil, errors.Errorf("failed to list merge request files by commit %v, error %v", pushEvent.Resource.LastMergeCommit.CommitID, err)
 	}
 
+	// The review result is published on the head commit of the source branch rather than the merge commit.
+	var commitID string
+	if pushEvent.Resource.LastMergeSourceCommit != nil {
+		commitID = pushEvent.Resource.LastMergeSourceCommit.CommitID
+	}
 	prInfo := &pullRequestInfo{
 		action: actionType,
 		// TODO(ed): get the email.
 		url:         pushEvent.Resource.Links.Web.Href,
 		title:       pushEvent.Resource.Title,
 		description: pushEvent.Resource.Description,
+		commitID:    commitID,
 		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
 	}
 
@@ -34,6 +34,7 @@ func getBitBucketPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProv
 		url:         pushEvent.PullRequest.Links.HTML.Href,
 		title:       pushEvent.PullRequest.Title,
 		description: pushEvent.PullRequest.Description,
+		commitID:    pushEvent.PullRequest.Source.Commit.Hash,
 		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
 	}
 
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
@@ -31,7 +32,9 @@ type pullRequestInfo struct {
 	title       string
 	description string
 	url         string
-	changes     []*fileChange
+	// commitID is the head commit of the pull request.
+	commitID string
+	changes  []*fileChange
 }
 
 type fileChange struct {
@@ -114,6 +117,12 @@ const (
 	commentPrefixBytebaseBot     = "**[Bytebase Bot]**"
 	commentPrefixSQLReview       = "**[Bytebase SQL Review]**"
 	commentPrefixSQLReviewPassed = "SQL Review Check Passed"
+
+	// reviewResultName is the check name of the SQL review result in the VCS.
+	reviewResultName = "Bytebase SQL Review"
+
+	// sqlReviewViolationRecordRetention is how long the SQL review violations of a pull request are remembered since the last review.
+	sqlReviewViolationRecordRetention = 30 * 24 * time.Hour
 )
 
 func getPullRequestComment(externalURL, issue string) string {
@@ -131,7 +140,7 @@ func convertFileContentToUTF8String(content string) string {
 
 func getFileWebURLInPR(webURL string, line int32, vcsType storepb.VCSType) string {
 	switch vcsType {
-	case storepb.VCSType_GITHUB:
+	case storepb.VCSType_GITHUB, storepb.VCSType_GITEA:
 		return fmt.Sprintf("%sR%d", webURL, line)
 	case storepb.VCSType_GITLAB:
 		return fmt.Sprintf("%s_0_%d", webURL, line)
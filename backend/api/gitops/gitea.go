@@ -0,0 +1,63 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func getGiteaPullRequestInfo(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, body []byte) (*pullRequestInfo, error) {
+	var pushEvent gitea.PullRequestPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+
+	var actionType webhookAction
+	switch pushEvent.Action {
+	case gitea.PullRequestEventOpened, gitea.PullRequestEventReopened, gitea.PullRequestEventSynchronized:
+		actionType = webhookActionSQLReview
+	case gitea.PullRequestEventClosed:
+		if !pushEvent.PullRequest.Merged {
+			return nil, errors.Errorf("skip pull request close action, pull request is not merged")
+		}
+		actionType = webhookActionCreateIssue
+	default:
+		return nil, errors.Errorf(`skip webhook event action "%s"`, pushEvent.Action)
+	}
+
+	if pushEvent.PullRequest.Base.Ref != vcsConnector.Payload.Branch {
+		return nil, errors.Errorf("skip branch, got %q, want %q", pushEvent.PullRequest.Base.Ref, vcsConnector.Payload.Branch)
+	}
+
+	provider := vcs.Get(storepb.VCSType_GITEA, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	mrFiles, err := provider.ListPullRequestFile(ctx, vcsConnector.Payload.ExternalId, fmt.Sprintf("%d", pushEvent.Number))
+	if err != nil {
+		return nil, errors.Errorf("failed to list merge %q request files, error %v", pushEvent.PullRequest.HTMLURL, err)
+	}
+
+	prInfo := &pullRequestInfo{
+		action:      actionType,
+		email:       pushEvent.Sender.Email,
+		url:         pushEvent.PullRequest.HTMLURL,
+		title:       pushEvent.PullRequest.Title,
+		description: pushEvent.PullRequest.Body,
+		commitID:    pushEvent.PullRequest.Head.SHA,
+		changes:     getChangesByFileList(mrFiles, vcsConnector.Payload.BaseDirectory),
+	}
+
+	for _, file := range prInfo.changes {
+		content, err := provider.ReadFileContent(ctx, vcsConnector.Payload.ExternalId, file.path, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: pushEvent.PullRequest.Head.SHA})
+		if err != nil {
+			return nil, errors.Errorf("failed read file content, merge request %q, file %q, error %v", pushEvent.PullRequest.HTMLURL, file.path, err)
+		}
+		file.content = convertFileContentToUTF8String(content)
+	}
+	return prInfo, nil
+}
@@ -0,0 +1,284 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	refHeadsPrefix = "refs/heads/"
+	refTagsPrefix  = "refs/tags/"
+)
+
+// pushInfo is the branch or tag update in the push event.
+type pushInfo struct {
+	refType vcs.RefType
+	// refName is the branch or tag name without the "refs/heads/" or "refs/tags/" prefix.
+	refName string
+	// before is the commit of the ref before the push. It's empty or all zeros for the new ref.
+	before string
+	// after is the commit of the ref after the push. It's empty or all zeros for the deleted ref.
+	after string
+	email string
+	url   string
+}
+
+// newPushInfo returns nil if the ref is neither a branch nor a tag.
+func newPushInfo(ref, before, after, email, url string) *pushInfo {
+	info := &pushInfo{
+		before: before,
+		after:  after,
+		email:  email,
+		url:    url,
+	}
+	switch {
+	case strings.HasPrefix(ref, refHeadsPrefix):
+		info.refType, info.refName = vcs.RefTypeBranch, strings.TrimPrefix(ref, refHeadsPrefix)
+	case strings.HasPrefix(ref, refTagsPrefix):
+		info.refType, info.refName = vcs.RefTypeTag, strings.TrimPrefix(ref, refTagsPrefix)
+	default:
+		return nil
+	}
+	return info
+}
+
+func isEmptyCommit(commit string) bool {
+	return strings.Trim(commit, "0") == ""
+}
+
+func getGitHubPushInfo(body []byte) ([]*pushInfo, error) {
+	var pushEvent github.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if info := newPushInfo(pushEvent.Ref, pushEvent.Before, pushEvent.After, pushEvent.Pusher.Email, pushEvent.Compare); info != nil {
+		return []*pushInfo{info}, nil
+	}
+	return nil, nil
+}
+
+func getGitLabPushInfo(body []byte) ([]*pushInfo, error) {
+	var pushEvent gitlab.WebhookPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if pushEvent.ObjectKind != gitlab.WebhookPush && pushEvent.ObjectKind != gitlab.WebhookTagPush {
+		return nil, errors.Errorf("skip webhook event type %s", pushEvent.ObjectKind)
+	}
+	url := fmt.Sprintf("%s/-/commit/%s", pushEvent.Project.WebURL, pushEvent.After)
+	if info := newPushInfo(pushEvent.Ref, pushEvent.Before, pushEvent.After, pushEvent.UserEmail, url); info != nil {
+		return []*pushInfo{info}, nil
+	}
+	return nil, nil
+}
+
+func getBitbucketPushInfo(body []byte) ([]*pushInfo, error) {
+	var pushEvent bitbucket.WebhookPushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	var infos []*pushInfo
+	for _, change := range pushEvent.Push.Changes {
+		// The new ref is empty if the ref is deleted.
+		if change.New.Name == "" {
+			continue
+		}
+		ref := refHeadsPrefix + change.New.Name
+		if change.New.Type == "tag" {
+			ref = refTagsPrefix + change.New.Name
+		}
+		// The actor doesn't include the email.
+		url := fmt.Sprintf("%s/commits/%s", pushEvent.Repository.Links.HTML.Href, change.New.Target.Hash)
+		if info := newPushInfo(ref, change.Old.Target.Hash, change.New.Target.Hash, "", url); info != nil {
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+func getAzurePushInfo(body []byte) ([]*pushInfo, error) {
+	var pushEvent azure.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if pushEvent.Resource == nil {
+		return nil, errors.Errorf("empty push event resource")
+	}
+	var email string
+	if pushEvent.Resource.PushedBy != nil {
+		email = pushEvent.Resource.PushedBy.UniqueName
+	}
+	var webURL string
+	if pushEvent.Resource.Repository != nil {
+		webURL = pushEvent.Resource.Repository.WebURL
+	}
+	var infos []*pushInfo
+	for _, refUpdate := range pushEvent.Resource.RefUpdates {
+		url := fmt.Sprintf("%s/commit/%s", webURL, refUpdate.NewObjectID)
+		if info := newPushInfo(refUpdate.Name, refUpdate.OldObjectID, refUpdate.NewObjectID, email, url); info != nil {
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+func getGiteaPushInfo(body []byte) ([]*pushInfo, error) {
+	var pushEvent gitea.PushEvent
+	if err := json.Unmarshal(body, &pushEvent); err != nil {
+		return nil, errors.Errorf("failed to unmarshal push event, error %v", err)
+	}
+	if info := newPushInfo(pushEvent.Ref, pushEvent.Before, pushEvent.After, pushEvent.Pusher.Email, pushEvent.CompareURL); info != nil {
+		return []*pushInfo{info}, nil
+	}
+	return nil, nil
+}
+
+// isPushTriggered returns true if the VCS connector deploys on push or tag events instead of merged pull requests.
+func isPushTriggered(vcsConnector *store.VCSConnectorMessage) bool {
+	switch vcsConnector.Payload.Trigger {
+	case storepb.VCSConnector_PUSH, storepb.VCSConnector_TAG:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchPushTrigger returns an empty string if the push matches the trigger of the VCS connector,
+// otherwise the reason to skip it.
+func matchPushTrigger(vcsConnector *store.VCSConnectorMessage, info *pushInfo) string {
+	switch vcsConnector.Payload.Trigger {
+	case storepb.VCSConnector_PUSH:
+		if info.refType != vcs.RefTypeBranch || info.refName != vcsConnector.Payload.Branch {
+			return fmt.Sprintf("skip %s %q, want branch %q", info.refType, info.refName, vcsConnector.Payload.Branch)
+		}
+	case storepb.VCSConnector_TAG:
+		if info.refType != vcs.RefTypeTag {
+			return fmt.Sprintf("skip %s %q, want tag", info.refType, info.refName)
+		}
+		if pattern := vcsConnector.Payload.TagPattern; pattern != "" {
+			if ok, _ := path.Match(pattern, info.refName); !ok {
+				return fmt.Sprintf("skip tag %q, want tag pattern %q", info.refName, pattern)
+			}
+		}
+	default:
+		return fmt.Sprintf("skip push event for VCS connector with trigger %v", vcsConnector.Payload.Trigger)
+	}
+	if isEmptyCommit(info.after) {
+		return fmt.Sprintf("skip deleted %s %q", info.refType, info.refName)
+	}
+	return ""
+}
+
+// handlePushEvent creates the issue for the new versioned migration files under the base directory
+// between the last processed commit of the VCS connector and the pushed commit.
+//
+// The last processed commit is claimed by compare-and-swap before creating the issue, so the
+// replayed or concurrent webhooks for the same commits don't create duplicate issues.
+// It returns the message for the webhook response.
+func (s *Service) handlePushEvent(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, info *pushInfo) (string, error) {
+	if reason := matchPushTrigger(vcsConnector, info); reason != "" {
+		return reason, nil
+	}
+
+	lastProcessedCommit := vcsConnector.Payload.LastProcessedCommit
+	if lastProcessedCommit == info.after {
+		return fmt.Sprintf("commit %s has been processed", info.after), nil
+	}
+	base := lastProcessedCommit
+	if base == "" && info.refType == vcs.RefTypeBranch {
+		base = info.before
+	}
+	if isEmptyCommit(base) {
+		// There is nothing to diff for the first push of a new ref, so it becomes the baseline.
+		if _, err := s.store.UpdateVCSConnectorLastProcessedCommit(ctx, vcsConnector.UID, lastProcessedCommit, info.after); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("initialize the last processed commit to %s", info.after), nil
+	}
+
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	diffs, err := provider.CompareCommits(ctx, vcsConnector.Payload.ExternalId, base, info.after)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to compare commits %s...%s", base, info.after)
+	}
+	var files []*vcs.PullRequestFile
+	for _, diff := range diffs {
+		// Only the new migration files are applied, the modified ones have been applied before.
+		if diff.Type != vcs.FileDiffTypeAdded {
+			continue
+		}
+		files = append(files, &vcs.PullRequestFile{
+			Path:         diff.Path,
+			LastCommitID: info.after,
+		})
+	}
+	changes := getChangesByFileList(files, vcsConnector.Payload.BaseDirectory)
+	if len(changes) == 0 {
+		// Keep the last processed commit, so that a stale event can't move it backwards.
+		return fmt.Sprintf("no new migration file directly under the base directory %q in %s...%s", vcsConnector.Payload.BaseDirectory, base, info.after), nil
+	}
+
+	claimed, err := s.store.UpdateVCSConnectorLastProcessedCommit(ctx, vcsConnector.UID, lastProcessedCommit, info.after)
+	if err != nil {
+		return "", err
+	}
+	if !claimed {
+		return fmt.Sprintf("commit %s is being processed by another event", info.after), nil
+	}
+
+	issueName, err := func() (string, error) {
+		for _, change := range changes {
+			content, err := provider.ReadFileContent(ctx, vcsConnector.Payload.ExternalId, change.path, vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: info.after})
+			if err != nil {
+				return "", errors.Errorf("failed read file content, commit %q, file %q, error %v", info.after, change.path, err)
+			}
+			change.content = convertFileContentToUTF8String(content)
+		}
+
+		shortCommit := info.after
+		if len(shortCommit) > 7 {
+			shortCommit = shortCommit[:7]
+		}
+		user := s.getUserByEmailOrBot(ctx, info.email)
+		childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+		childCtx = context.WithValue(childCtx, common.UserContextKey, user)
+		issue, err := s.createIssueFromPRInfo(childCtx, project, vcsProvider, vcsConnector, &pullRequestInfo{
+			action:      webhookActionCreateIssue,
+			email:       info.email,
+			title:       fmt.Sprintf("Apply migrations from %s %s@%s", info.refType, info.refName, shortCommit),
+			description: fmt.Sprintf("Created by the push to %s %s: %s", info.refType, info.refName, info.url),
+			url:         info.url,
+			commitID:    info.after,
+			changes:     changes,
+		})
+		if err != nil {
+			return "", err
+		}
+		return issue.Name, nil
+	}()
+	if err != nil {
+		// Release the claim, so that the redelivered webhook can retry.
+		if _, rerr := s.store.UpdateVCSConnectorLastProcessedCommit(ctx, vcsConnector.UID, info.after, lastProcessedCommit); rerr != nil {
+			slog.Error("failed to reset the last processed commit", slog.String("commit", lastProcessedCommit), log.BBError(rerr))
+		}
+		return "", errors.Wrapf(err, "failed to create issue for commit %s", info.after)
+	}
+	return fmt.Sprintf("successfully create issue %s for %s %s", issueName, info.refType, info.refName), nil
+}
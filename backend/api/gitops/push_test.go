@@ -0,0 +1,88 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetGitHubPushInfo(t *testing.T) {
+	infos, err := getGitHubPushInfo([]byte(`{"ref":"refs/tags/v1.0.0","before":"0000000000000000000000000000000000000000","after":"5a96148ac5ef11a53b838b8cc0d9c929420657f3","compare":"https://github.com/octocat/db/compare/v1.0.0","pusher":{"name":"octocat","email":"octocat@example.com"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []*pushInfo{{
+		refType: vcs.RefTypeTag,
+		refName: "v1.0.0",
+		before:  "0000000000000000000000000000000000000000",
+		after:   "5a96148ac5ef11a53b838b8cc0d9c929420657f3",
+		email:   "octocat@example.com",
+		url:     "https://github.com/octocat/db/compare/v1.0.0",
+	}}, infos)
+
+	infos, err = getGitHubPushInfo([]byte(`{"ref":"refs/notes/commits"}`))
+	assert.NoError(t, err)
+	assert.Empty(t, infos)
+}
+
+func TestMatchPushTrigger(t *testing.T) {
+	tests := []struct {
+		trigger    storepb.VCSConnector_Trigger
+		tagPattern string
+		info       *pushInfo
+		want       bool
+	}{
+		{
+			trigger: storepb.VCSConnector_PUSH,
+			info:    &pushInfo{refType: vcs.RefTypeBranch, refName: "release", after: "abc"},
+			want:    true,
+		},
+		{
+			trigger: storepb.VCSConnector_PUSH,
+			info:    &pushInfo{refType: vcs.RefTypeBranch, refName: "main", after: "abc"},
+			want:    false,
+		},
+		{
+			// The deleted branch.
+			trigger: storepb.VCSConnector_PUSH,
+			info:    &pushInfo{refType: vcs.RefTypeBranch, refName: "release", after: "0000000000000000000000000000000000000000"},
+			want:    false,
+		},
+		{
+			trigger:    storepb.VCSConnector_TAG,
+			tagPattern: "v*",
+			info:       &pushInfo{refType: vcs.RefTypeTag, refName: "v1.0.0", after: "abc"},
+			want:       true,
+		},
+		{
+			trigger:    storepb.VCSConnector_TAG,
+			tagPattern: "v*",
+			info:       &pushInfo{refType: vcs.RefTypeTag, refName: "nightly", after: "abc"},
+			want:       false,
+		},
+		{
+			trigger: storepb.VCSConnector_TAG,
+			info:    &pushInfo{refType: vcs.RefTypeBranch, refName: "release", after: "abc"},
+			want:    false,
+		},
+		{
+			trigger: storepb.VCSConnector_PULL_REQUEST,
+			info:    &pushInfo{refType: vcs.RefTypeBranch, refName: "release", after: "abc"},
+			want:    false,
+		},
+	}
+
+	for _, test := range tests {
+		vcsConnector := &store.VCSConnectorMessage{
+			Payload: &storepb.VCSConnector{
+				Branch:     "release",
+				Trigger:    test.trigger,
+				TagPattern: test.tagPattern,
+			},
+		}
+		reason := matchPushTrigger(vcsConnector, test.info)
+		assert.Equal(t, test.want, reason == "", "%v %s %q: %s", test.trigger, test.info.refType, test.info.refName, reason)
+	}
+}
@@ -0,0 +1,269 @@
+package gitops
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// appliedMigration is the done change history with a version.
+type appliedMigration struct {
+	version string
+	// statementSHA1 is the hex encoded SHA1 of the applied sheet statement.
+	// It's only set for the versions of the migration files in the pull request.
+	statementSHA1 string
+}
+
+// databaseMigrationHistory is the applied migrations of a target database.
+type databaseMigrationHistory struct {
+	// database is the database resource name, e.g. instances/{instance}/databases/{database}.
+	database    string
+	applied     []*appliedMigration
+	hasBaseline bool
+}
+
+// versionProblem is the problem of the migration file versions in the pull request.
+type versionProblem struct {
+	status v1pb.Advice_Status
+	// path is the file path of the migration file. It's empty for the problem of the databases.
+	path      string
+	title     string
+	databases []string
+}
+
+func (s *Service) checkMigrationVersionsWithPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) ([]*versionProblem, error) {
+	databases, err := s.listTargetDatabases(ctx, project, vcsConnector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list target databases")
+	}
+	var versions []string
+	for _, change := range prInfo.changes {
+		versions = append(versions, change.version)
+	}
+	histories, err := s.listDatabaseMigrationHistories(ctx, databases, versions)
+	if err != nil {
+		return nil, err
+	}
+	return checkMigrationVersions(prInfo.changes, histories), nil
+}
+
+// listTargetDatabases returns the databases the migration files of the VCS connector will be applied to.
+func (s *Service) listTargetDatabases(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage) ([]*store.DatabaseMessage, error) {
+	dbg := vcsConnector.Payload.GetDatabaseGroup()
+	if dbg == "" {
+		return s.listDatabases(ctx, project)
+	}
+	_, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(dbg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project id and database group id from %q", dbg)
+	}
+	databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database group %q", databaseGroupID)
+	}
+	if databaseGroup == nil {
+		return nil, errors.Errorf("database group %q not found", databaseGroupID)
+	}
+	allDatabases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list databases for project %q", project.ResourceID)
+	}
+	matchedDatabases, _, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, databaseGroup, allDatabases)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get matched and unmatched databases in database group %q", databaseGroupID)
+	}
+	return matchedDatabases, nil
+}
+
+// listDatabaseMigrationHistories lists the applied migrations of the databases.
+// Only the statements of the given versions are loaded to compare with the migration files.
+func (s *Service) listDatabaseMigrationHistories(ctx context.Context, databases []*store.DatabaseMessage, versions []string) ([]*databaseMigrationHistory, error) {
+	var histories []*databaseMigrationHistory
+	for _, database := range databases {
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", database.InstanceID)
+		}
+		if instance == nil {
+			return nil, errors.Errorf("instance %q not found", database.InstanceID)
+		}
+		done := db.Done
+		changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+			InstanceID: &instance.UID,
+			DatabaseID: &database.UID,
+			Status:     &done,
+			// Skip loading the statements, only those of the versions in the pull request are loaded below.
+			TruncateSize: 0,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list change history for database %q", database.DatabaseName)
+		}
+		history := &databaseMigrationHistory{
+			database: common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		}
+		for _, changeHistory := range changeHistories {
+			if changeHistory.Type == db.Baseline {
+				history.hasBaseline = true
+			}
+			if changeHistory.Version.Version == "" {
+				continue
+			}
+			migration := &appliedMigration{
+				version: changeHistory.Version.Version,
+			}
+			if slices.ContainsFunc(versions, func(version string) bool {
+				return compareVersion(version, changeHistory.Version.Version) == 0
+			}) {
+				statement, err := s.getChangeHistoryStatement(ctx, instance.UID, changeHistory.UID)
+				if err != nil {
+					return nil, err
+				}
+				migration.statementSHA1 = getStatementSHA1(statement)
+			}
+			history.applied = append(history.applied, migration)
+		}
+		histories = append(histories, history)
+	}
+	return histories, nil
+}
+
+// getChangeHistoryStatement returns the full statement of the change history.
+func (s *Service) getChangeHistoryStatement(ctx context.Context, instanceUID int, uid string) (string, error) {
+	changeHistories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		ID:         &uid,
+		InstanceID: &instanceUID,
+		ShowFull:   true,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get change history %q", uid)
+	}
+	if len(changeHistories) == 0 {
+		return "", errors.Errorf("change history %q not found", uid)
+	}
+	return changeHistories[0].Statement, nil
+}
+
+// checkMigrationVersions checks the versions of the migration files in the pull request against each other
+// and against the applied migrations of the target databases.
+func checkMigrationVersions(changes []*fileChange, histories []*databaseMigrationHistory) []*versionProblem {
+	var problems []*versionProblem
+
+	pathsByVersion := make(map[string][]string)
+	for _, change := range changes {
+		pathsByVersion[change.version] = append(pathsByVersion[change.version], change.path)
+	}
+	for _, change := range changes {
+		if paths := pathsByVersion[change.version]; len(paths) > 1 {
+			var others []string
+			for _, p := range paths {
+				if p != change.path {
+					others = append(others, p)
+				}
+			}
+			problems = append(problems, &versionProblem{
+				status: v1pb.Advice_ERROR,
+				path:   change.path,
+				title:  fmt.Sprintf("Version %s is duplicated with %s", change.version, strings.Join(others, ", ")),
+			})
+		}
+	}
+
+	var missingBaseline []string
+	for _, history := range histories {
+		if !history.hasBaseline {
+			missingBaseline = append(missingBaseline, history.database)
+		}
+	}
+	if len(missingBaseline) > 0 {
+		problems = append(problems, &versionProblem{
+			status:    v1pb.Advice_WARNING,
+			title:     "No baseline is established, the migration versions may not reflect the actual schema",
+			databases: missingBaseline,
+		})
+	}
+
+	for _, change := range changes {
+		statementSHA1 := getStatementSHA1(change.content)
+		var modified, applied, outOfOrder []string
+		outOfOrderVersion := ""
+		for _, history := range histories {
+			var latest string
+			var sameVersion *appliedMigration
+			for _, migration := range history.applied {
+				if compareVersion(migration.version, latest) > 0 {
+					latest = migration.version
+				}
+				if compareVersion(migration.version, change.version) == 0 {
+					sameVersion = migration
+				}
+			}
+			switch {
+			case sameVersion != nil && sameVersion.statementSHA1 != statementSHA1:
+				modified = append(modified, history.database)
+			case sameVersion != nil:
+				applied = append(applied, history.database)
+			case compareVersion(change.version, latest) < 0:
+				outOfOrder = append(outOfOrder, history.database)
+				if compareVersion(latest, outOfOrderVersion) > 0 {
+					outOfOrderVersion = latest
+				}
+			}
+		}
+		if len(modified) > 0 {
+			problems = append(problems, &versionProblem{
+				status:    v1pb.Advice_ERROR,
+				path:      change.path,
+				title:     fmt.Sprintf("Version %s has been applied with a different statement, the applied migration file should not be modified", change.version),
+				databases: modified,
+			})
+		}
+		if len(applied) > 0 {
+			problems = append(problems, &versionProblem{
+				status:    v1pb.Advice_WARNING,
+				path:      change.path,
+				title:     fmt.Sprintf("Version %s has been applied", change.version),
+				databases: applied,
+			})
+		}
+		if len(outOfOrder) > 0 {
+			problems = append(problems, &versionProblem{
+				status:    v1pb.Advice_ERROR,
+				path:      change.path,
+				title:     fmt.Sprintf("Version %s is lower than the applied version %s", change.version, outOfOrderVersion),
+				databases: outOfOrder,
+			})
+		}
+	}
+	return problems
+}
+
+// compareVersion compares the numeric versions by value, so that "0010" equals "10".
+// It falls back to the string comparison for the non-numeric versions.
+func compareVersion(a, b string) int {
+	if versionRE.FindString(a) == a && versionRE.FindString(b) == b {
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func getStatementSHA1(statement string) string {
+	h := sha1.Sum([]byte(statement))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,65 @@
+package gitops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "0010", b: "10", want: 0},
+		{a: "9", b: "10", want: -1},
+		{a: "20240101", b: "20231231", want: 1},
+		{a: "1", b: "", want: 1},
+		{a: "1a", b: "1b", want: -1},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, compareVersion(test.a, test.b), "%q vs %q", test.a, test.b)
+	}
+}
+
+func TestCheckMigrationVersions(t *testing.T) {
+	a := assert.New(t)
+	changes := []*fileChange{
+		{path: "migrations/0003_add_index.sql", version: "0003", content: "CREATE INDEX idx ON t(a);"},
+		{path: "migrations/0003_add_column.sql", version: "0003", content: "ALTER TABLE t ADD COLUMN b INT;"},
+		{path: "migrations/0001_create_table.sql", version: "0001", content: "CREATE TABLE t(a INT);"},
+		{path: "migrations/0002_insert.sql", version: "0002", content: "INSERT INTO t VALUES (1);"},
+	}
+	histories := []*databaseMigrationHistory{
+		{
+			database:    "instances/prod/databases/db",
+			hasBaseline: true,
+			applied: []*appliedMigration{
+				{version: "0001", statementSHA1: getStatementSHA1("CREATE TABLE t(a BIGINT);")},
+				{version: "0004", statementSHA1: getStatementSHA1("DROP TABLE x;")},
+			},
+		},
+		{
+			database: "instances/test/databases/db",
+			applied: []*appliedMigration{
+				{version: "1", statementSHA1: getStatementSHA1("CREATE TABLE t(a INT);")},
+			},
+		},
+	}
+
+	got := checkMigrationVersions(changes, histories)
+	want := []*versionProblem{
+		{status: v1pb.Advice_ERROR, path: "migrations/0003_add_index.sql", title: "Version 0003 is duplicated with migrations/0003_add_column.sql"},
+		{status: v1pb.Advice_ERROR, path: "migrations/0003_add_column.sql", title: "Version 0003 is duplicated with migrations/0003_add_index.sql"},
+		{status: v1pb.Advice_WARNING, title: "No baseline is established, the migration versions may not reflect the actual schema", databases: []string{"instances/test/databases/db"}},
+		{status: v1pb.Advice_ERROR, path: "migrations/0003_add_index.sql", title: "Version 0003 is lower than the applied version 0004", databases: []string{"instances/prod/databases/db"}},
+		{status: v1pb.Advice_ERROR, path: "migrations/0003_add_column.sql", title: "Version 0003 is lower than the applied version 0004", databases: []string{"instances/prod/databases/db"}},
+		{status: v1pb.Advice_ERROR, path: "migrations/0001_create_table.sql", title: "Version 0001 has been applied with a different statement, the applied migration file should not be modified", databases: []string{"instances/prod/databases/db"}},
+		{status: v1pb.Advice_WARNING, path: "migrations/0001_create_table.sql", title: "Version 0001 has been applied", databases: []string{"instances/test/databases/db"}},
+		{status: v1pb.Advice_ERROR, path: "migrations/0002_insert.sql", title: "Version 0002 is lower than the applied version 0004", databases: []string{"instances/prod/databases/db"}},
+	}
+	a.Equal(want, got)
+}
@@ -6,12 +6,15 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -21,7 +24,9 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
 
 	"github.com/bytebase/bytebase/backend/store"
@@ -81,6 +86,8 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 		}
 
 		var prInfo *pullRequestInfo
+		// pushInfos is set for the push and tag events, which are handled by the push or tag triggered connectors.
+		var pushInfos []*pushInfo
 		switch vcsProvider.Type {
 		case storepb.VCSType_GITHUB:
 			secretToken := c.Request().Header.Get("X-Hub-Signature-256")
@@ -99,13 +106,20 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			case "ping":
 				return c.String(http.StatusOK, "OK")
 			case "pull_request":
+			case "push":
+				pushInfos, err = getGitHubPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info, error %v", err))
+				}
 			default:
 				return c.String(http.StatusOK, fmt.Sprintf(`skip webhook event "%v"`, eventType))
 			}
 
-			prInfo, err = getGitHubPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
-			if err != nil {
-				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+			if eventType == "pull_request" {
+				prInfo, err = getGitHubPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
 			}
 		case storepb.VCSType_GITLAB:
 			secretToken := c.Request().Header.Get("X-Gitlab-Token")
@@ -113,12 +127,28 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook secret token %q", secretToken))
 			}
 
-			prInfo, err = getGitLabPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
-			if err != nil {
-				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+			// https://docs.gitlab.com/ee/user/project/integrations/webhook_events.html
+			switch eventType := c.Request().Header.Get("X-Gitlab-Event"); eventType {
+			case "Push Hook", "Tag Push Hook":
+				pushInfos, err = getGitLabPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info, error %v", err))
+				}
+			default:
+				prInfo, err = getGitLabPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
 			}
 		case storepb.VCSType_BITBUCKET:
 			eventType := c.Request().Header.Get("X-Event-Key")
+			if eventType == bitbucket.RepoPushEvent {
+				pushInfos, err = getBitbucketPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info, error %v", err))
+				}
+				break
+			}
 			var action webhookAction
 			switch bitbucket.PullRequestEventType(eventType) {
 			case bitbucket.PullRequestEventCreated, bitbucket.PullRequestEventUpdated:
@@ -140,34 +170,96 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook secret token %q", secretToken))
 			}
 
+			var event struct {
+				EventType string `json:"eventType"`
+			}
+			if err := json.Unmarshal(body, &event); err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to unmarshal event, error %v", err))
+			}
+			if event.EventType == azure.PushEventType {
+				pushInfos, err = getAzurePushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info, error %v", err))
+				}
+				break
+			}
+
 			prInfo, err = getAzurePullRequestInfo(ctx, vcsProvider, vcsConnector, body)
 			if err != nil {
 				return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
 			}
+		case storepb.VCSType_GITEA:
+			// Forgejo also sends the X-Gitea-* headers for compatibility, but prefers its own.
+			signature := c.Request().Header.Get("X-Forgejo-Signature")
+			if signature == "" {
+				signature = c.Request().Header.Get("X-Gitea-Signature")
+			}
+			ok, err := validateGiteaWebhookSignature(signature, vcsConnector.Payload.WebhookSecretToken, body)
+			if err != nil {
+				return c.String(http.StatusOK, fmt.Sprintf("failed to validate webhook signature %q, error %v", signature, err))
+			}
+			if !ok {
+				return c.String(http.StatusOK, fmt.Sprintf("invalid webhook signature %q", signature))
+			}
+			eventType := c.Request().Header.Get("X-Forgejo-Event")
+			if eventType == "" {
+				eventType = c.Request().Header.Get("X-Gitea-Event")
+			}
+			switch eventType {
+			case "pull_request":
+				prInfo, err = getGiteaPullRequestInfo(ctx, vcsProvider, vcsConnector, body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get pr info from pull request, error %v", err))
+				}
+			case "push":
+				pushInfos, err = getGiteaPushInfo(body)
+				if err != nil {
+					return c.String(http.StatusOK, fmt.Sprintf("failed to get push info, error %v", err))
+				}
+			default:
+				return c.String(http.StatusOK, fmt.Sprintf(`skip webhook event "%v"`, eventType))
+			}
 		default:
 			return nil
 		}
+		if prInfo == nil {
+			if len(pushInfos) == 0 {
+				return c.String(http.StatusOK, "no branch or tag update in the push event")
+			}
+			var messages []string
+			for i, info := range pushInfos {
+				if i > 0 {
+					// Reload the last processed commit updated by the previous ref.
+					vcsConnector, err = s.store.GetVCSConnector(ctx, &store.FindVCSConnectorMessage{ProjectID: &projectID, ResourceID: &vcsConnectorID})
+					if err != nil || vcsConnector == nil {
+						return c.String(http.StatusOK, fmt.Sprintf("failed to get project %q VCS connector %q, error %v", projectID, vcsConnectorID, err))
+					}
+				}
+				message, err := s.handlePushEvent(ctx, project, vcsProvider, vcsConnector, info)
+				if err != nil {
+					slog.Error("failed to handle push event", slog.String("ref", info.refName), slog.String("commit", info.after), log.BBError(err))
+					message = fmt.Sprintf("failed to handle the push to %s %q, error %v", info.refType, info.refName, err)
+				}
+				messages = append(messages, message)
+			}
+			return c.String(http.StatusOK, strings.Join(messages, "\n"))
+		}
+		if prInfo.action == webhookActionCreateIssue && isPushTriggered(vcsConnector) {
+			// The merged changes are deployed by the push or tag events.
+			return c.String(http.StatusOK, fmt.Sprintf("skip the merged pull request %q for VCS connector with trigger %v", prInfo.url, vcsConnector.Payload.Trigger))
+		}
 		if len(prInfo.changes) == 0 {
 			return c.String(http.StatusOK, fmt.Sprintf("no relevant file change directly under the base directory %q for pull request %q", vcsConnector.Payload.BaseDirectory, prInfo.url))
 		}
 
-		user := func() *store.UserMessage {
-			user, err := s.store.GetUserByEmail(ctx, prInfo.email)
-			if err != nil {
-				slog.Error("failed to find user by email", slog.String("email", prInfo.email), log.BBError(err))
-				return s.store.GetSystemBotUser(ctx)
-			}
-			if user == nil {
-				return s.store.GetSystemBotUser(ctx)
-			}
-			return user
-		}()
+		user := s.getUserByEmailOrBot(ctx, prInfo.email)
 		childCtx := context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
 		childCtx = context.WithValue(childCtx, common.UserContextKey, user)
 
 		var comment string
 		var commentPrefix string
 		var createCommentIfNotExist bool
+		var reviewResult *vcs.ReviewResult
 		switch prInfo.action {
 		case webhookActionCreateIssue:
 			issue, err := s.createIssueFromPRInfo(childCtx, project, vcsProvider, vcsConnector, prInfo)
@@ -178,13 +270,17 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			commentPrefix = commentPrefixBytebaseBot
 			createCommentIfNotExist = true
 		case webhookActionSQLReview:
-			comment, err = s.sqlReviewWithPRInfo(childCtx, project, vcsConnector, vcsProvider.Type, prInfo)
+			comment, reviewResult, err = s.sqlReviewWithPRInfo(childCtx, project, vcsConnector, vcsProvider.Type, prInfo)
 			if err != nil {
 				return c.String(http.StatusOK, fmt.Sprintf("failed to exec sql review for pull request %s, error %v", prInfo.url, err))
 			}
 			if comment != "" {
 				comment = fmt.Sprintf("%s\n\n---\n\nClick [here](%s) to check the SQL review config", comment, fmt.Sprintf("%s/sql-review", setting.ExternalUrl))
 			}
+			reviewResult.DetailsURL = fmt.Sprintf("%s/sql-review", setting.ExternalUrl)
+			if setting.ExternalUrl == "" {
+				reviewResult.DetailsURL = prInfo.url
+			}
 			commentPrefix = commentPrefixSQLReview
 			// We don't have the "Enable SQL review" option for VCS connection.
 			// It's confused that projects may not have the active SQL review policy but will get "SQL Reivew Check Passed" comment.
@@ -209,10 +305,31 @@ func (s *Service) RegisterWebhookRoutes(g *echo.Group) {
 			}
 		}
 
+		// Publish the SQL review result as the commit status, so that the branch protection can block the merge on errors.
+		// The comment is still the main feedback, so we don't fail the webhook if the VCS rejects the status.
+		if reviewResult != nil && prInfo.commitID != "" {
+			if err := publishReviewResult(ctx, vcsProvider, vcsConnector, prInfo, reviewResult); err != nil {
+				slog.Warn("failed to publish review result", slog.String("pr", prInfo.url), log.BBError(err))
+			}
+		}
+
 		return c.String(http.StatusOK, fmt.Sprintf("successfully handle the pull request %v", prInfo.url))
 	})
 }
 
+// getUserByEmailOrBot returns the system bot if the user is not found by the email.
+func (s *Service) getUserByEmailOrBot(ctx context.Context, email string) *store.UserMessage {
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		slog.Error("failed to find user by email", slog.String("email", email), log.BBError(err))
+		return s.store.GetSystemBotUser(ctx)
+	}
+	if user == nil {
+		return s.store.GetSystemBotUser(ctx)
+	}
+	return user
+}
+
 // validateGitHubWebhookSignature256 returns true if the signature matches the
 // HMAC hex digested SHA256 hash of the body using the given key.
 func validateGitHubWebhookSignature256(signature, key string, body []byte) (bool, error) {
@@ -229,15 +346,30 @@ func validateGitHubWebhookSignature256(signature, key string, body []byte) (bool
 	return subtle.ConstantTimeCompare([]byte(signature), []byte(got)) == 1, nil
 }
 
-func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, vcsType storepb.VCSType, prInfo *pullRequestInfo) (string, error) {
+// validateGiteaWebhookSignature returns true if the signature matches the
+// HMAC hex digested SHA256 hash of the body using the given key.
+// Unlike GitHub, Gitea and Forgejo send the hex digest without the "sha256=" prefix.
+func validateGiteaWebhookSignature(signature, key string, body []byte) (bool, error) {
+	m := hmac.New(sha256.New, []byte(key))
+	if _, err := m.Write(body); err != nil {
+		return false, err
+	}
+	got := hex.EncodeToString(m.Sum(nil))
+	return subtle.ConstantTimeCompare([]byte(signature), []byte(got)) == 1, nil
+}
+
+func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.ProjectMessage, vcsConnector *store.VCSConnectorMessage, vcsType storepb.VCSType, prInfo *pullRequestInfo) (string, *vcs.ReviewResult, error) {
 	instance, database, err := s.getDatabaseSample(ctx, project, vcsConnector)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to get database sample")
+		return "", nil, errors.Wrapf(err, "failed to get database sample")
 	}
 
 	content := []string{}
 	errorCount := 0
 	warnCount := 0
+	var annotations []*vcs.ReviewAnnotation
+	// violations identify the violations regardless of the lines, which move across the pushes.
+	var violations []string
 
 	for _, change := range prInfo.changes {
 		changeType := v1pb.CheckRequest_DDL
@@ -272,6 +404,14 @@ func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.Projec
 			}
 			message := fmt.Sprintf("- **[%s]** %s ([line%d](%s))", advice.Status.String(), advice.Title, advice.Line, getFileWebURLInPR(change.webURL, advice.Line, vcsType))
 			adviceMessage = append(adviceMessage, message)
+			violations = append(violations, getSQLReviewViolation(change.path, advice.Title, advice.Content))
+			annotations = append(annotations, &vcs.ReviewAnnotation{
+				Path:    change.path,
+				Line:    int(advice.Line),
+				Level:   convertToReviewAnnotationLevel(advice.Status),
+				Title:   advice.Title,
+				Message: advice.Content,
+			})
 		}
 
 		if len(adviceMessage) > 0 {
@@ -286,11 +426,123 @@ func (s *Service) sqlReviewWithPRInfo(ctx context.Context, project *store.Projec
 		}
 	}
 
+	if problems, err := s.checkMigrationVersionsWithPRInfo(ctx, project, vcsConnector, prInfo); err != nil {
+		slog.Error("failed to check migration versions", slog.String("project", project.ResourceID), log.BBError(err))
+	} else if len(problems) > 0 {
+		webURLs := make(map[string]string)
+		for _, change := range prInfo.changes {
+			webURLs[change.path] = change.webURL
+		}
+		var problemMessage []string
+		for _, problem := range problems {
+			if problem.status == v1pb.Advice_ERROR {
+				errorCount++
+			} else {
+				warnCount++
+			}
+			message := fmt.Sprintf("- **[%s]** %s", problem.status.String(), problem.title)
+			if problem.path != "" {
+				message = fmt.Sprintf("- **[%s]** [%s](%s): %s", problem.status.String(), problem.path, webURLs[problem.path], problem.title)
+				annotations = append(annotations, &vcs.ReviewAnnotation{
+					Path:    problem.path,
+					Line:    1,
+					Level:   convertToReviewAnnotationLevel(problem.status),
+					Title:   problem.title,
+					Message: fmt.Sprintf("Affected databases: %s", strings.Join(problem.databases, ", ")),
+				})
+			}
+			if len(problem.databases) > 0 {
+				message = fmt.Sprintf("%s (affected databases: %s)", message, strings.Join(problem.databases, ", "))
+			}
+			problemMessage = append(problemMessage, message)
+			violations = append(violations, getSQLReviewViolation(problem.path, problem.title, strings.Join(problem.databases, ",")))
+		}
+		if len(content) > 0 {
+			content = append(content, "\n")
+		}
+		content = append(content, "Migration version check\n")
+		content = append(content, strings.Join(problemMessage, "\n\n"))
+	}
+
+	reviewResult := &vcs.ReviewResult{
+		Name:        reviewResultName,
+		CommitID:    prInfo.commitID,
+		State:       vcs.ReviewStateSuccess,
+		Title:       commentPrefixSQLReviewPassed,
+		Summary:     commentPrefixSQLReviewPassed,
+		Annotations: annotations,
+	}
 	if len(content) == 0 {
-		return commentPrefixSQLReviewPassed, nil
+		return commentPrefixSQLReviewPassed, reviewResult, nil
+	}
+	if errorCount > 0 {
+		reviewResult.State = vcs.ReviewStateFailure
+	}
+	reviewResult.Title = fmt.Sprintf("%d errors, %d warnings", errorCount, warnCount)
+	reviewResult.Summary = strings.Join(content, "\n")
+
+	if hasNew, err := s.recordSQLReviewViolations(ctx, prInfo.url, violations); err != nil {
+		slog.Error("failed to record SQL review violations", slog.String("pull request", prInfo.url), log.BBError(err))
+	} else if hasNew {
+		s.webhookManager.CreateEvent(ctx, &webhook.Event{
+			Actor:   s.store.GetSystemBotUser(ctx),
+			Type:    webhook.EventTypeVCSSQLReviewViolation,
+			Project: webhook.NewProject(project),
+			VCSSQLReviewViolation: &webhook.EventVCSSQLReviewViolation{
+				PullRequestTitle: prInfo.title,
+				PullRequestURL:   prInfo.url,
+				ErrorCount:       errorCount,
+				WarningCount:     warnCount,
+			},
+		})
+	}
+
+	return fmt.Sprintf("\n%d errors, %d warnings\n\n---\n\n%s", errorCount, warnCount, strings.Join(content, "\n")), reviewResult, nil
+}
+
+// recordSQLReviewViolations records the SQL review violations of the pull request and returns whether
+// there are violations not found in the last review, so that the pushes without new violations are not notified.
+func (s *Service) recordSQLReviewViolations(ctx context.Context, pullRequestURL string, violations []string) (bool, error) {
+	fingerprints := getSQLReviewViolationFingerprints(violations)
+	key := fmt.Sprintf("sql-review/%s", pullRequestURL)
+	record, err := s.store.GetWebhookEventRecord(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if err := s.store.UpsertWebhookEventRecord(ctx, &store.WebhookEventRecordMessage{
+		Key:          key,
+		Fingerprints: fingerprints,
+		ExpireTime:   time.Now().Add(sqlReviewViolationRecordRetention),
+	}); err != nil {
+		return false, err
+	}
+	return hasNewSQLReviewViolations(record, fingerprints), nil
+}
+
+// getSQLReviewViolationFingerprints returns the sorted and deduplicated fingerprints of the violations.
+func getSQLReviewViolationFingerprints(violations []string) []string {
+	var fingerprints []string
+	for _, violation := range violations {
+		fingerprints = append(fingerprints, getStatementSHA1(violation))
+	}
+	slices.Sort(fingerprints)
+	return slices.Compact(fingerprints)
+}
+
+// hasNewSQLReviewViolations returns whether the fingerprints have any violation not in the record of the last review.
+// The first review of the pull request is always new.
+func hasNewSQLReviewViolations(record *store.WebhookEventRecordMessage, fingerprints []string) bool {
+	if record == nil {
+		return true
 	}
+	return slices.ContainsFunc(fingerprints, func(fingerprint string) bool {
+		return !slices.Contains(record.Fingerprints, fingerprint)
+	})
+}
 
-	return fmt.Sprintf("\n%d errors, %d warnings\n\n---\n\n%s", errorCount, warnCount, strings.Join(content, "\n")), nil
+// getSQLReviewViolation identifies the violation of the file regardless of the line, which moves across the pushes.
+func getSQLReviewViolation(path, title, detail string) string {
+	return strings.Join([]string{path, title, detail}, "\x00")
 }
 
 func (s *Service) createIssueFromPRInfo(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, prInfo *pullRequestInfo) (*v1pb.Issue, error) {
@@ -441,6 +693,36 @@ func upsertPullRequestComment(
 	return nil
 }
 
+// publishReviewResult publishes the SQL review result to the head commit of the pull request.
+func publishReviewResult(
+	ctx context.Context,
+	vcsProvider *store.VCSProviderMessage,
+	vcsConnector *store.VCSConnectorMessage,
+	prInfo *pullRequestInfo,
+	reviewResult *vcs.ReviewResult,
+) error {
+	pullRequestID := getPullRequestID(prInfo.url)
+	provider := vcs.Get(
+		vcsProvider.Type,
+		vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken},
+	)
+	if err := provider.PublishReviewResult(ctx, vcsConnector.Payload.ExternalId, pullRequestID, reviewResult); err != nil {
+		return errors.Wrapf(err, `failed to publish review result for PR "%s"`, prInfo.url)
+	}
+	return nil
+}
+
+func convertToReviewAnnotationLevel(status v1pb.Advice_Status) vcs.ReviewAnnotationLevel {
+	switch status {
+	case v1pb.Advice_ERROR:
+		return vcs.ReviewAnnotationLevelFailure
+	case v1pb.Advice_WARNING:
+		return vcs.ReviewAnnotationLevelWarning
+	default:
+		return vcs.ReviewAnnotationLevelNotice
+	}
+}
+
 func (s *Service) getDatabaseSample(
 	ctx context.Context,
 	project *store.ProjectMessage,
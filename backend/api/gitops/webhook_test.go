@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/bytebase/bytebase/backend/store"
 )
 
 func TestValidateGitHubWebhookSignature256(t *testing.T) {
@@ -40,3 +42,60 @@ func TestValidateGitHubWebhookSignature256(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestValidateGiteaWebhookSignature(t *testing.T) {
+	const payload = `{"action":"opened","number":7,"pull_request":{"html_url":"https://gitea.com/octocat/db/pulls/7","base":{"ref":"main"},"head":{"ref":"feature","sha":"5a96148ac5ef11a53b838b8cc0d9c929420657f3"}}}`
+
+	t.Run("wrong key", func(t *testing.T) {
+		got, err := validateGiteaWebhookSignature(
+			"21c753db9e11403f231f6f7cba7fcb6a17def69f0ea5c8a15e266e2119ca821f",
+			"abadkey",
+			[]byte(payload),
+		)
+		assert.False(t, got)
+		assert.NoError(t, err)
+	})
+
+	t.Run("github prefixed signature", func(t *testing.T) {
+		got, err := validateGiteaWebhookSignature(
+			"sha256=21c753db9e11403f231f6f7cba7fcb6a17def69f0ea5c8a15e266e2119ca821f",
+			"bZovosSKsJ8QKCG9",
+			[]byte(payload),
+		)
+		assert.False(t, got)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		got, err := validateGiteaWebhookSignature(
+			"21c753db9e11403f231f6f7cba7fcb6a17def69f0ea5c8a15e266e2119ca821f",
+			"bZovosSKsJ8QKCG9",
+			[]byte(payload),
+		)
+		assert.True(t, got)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSQLReviewViolationRecord(t *testing.T) {
+	a := assert.New(t)
+	nullable := getSQLReviewViolation("migrations/0001_init.sql", "column-no-null", "Column id is nullable")
+	noIndex := getSQLReviewViolation("migrations/0001_init.sql", "table-require-pk", "Table salary requires PRIMARY KEY")
+
+	first := getSQLReviewViolationFingerprints([]string{noIndex, nullable, nullable})
+	a.Len(first, 2)
+	a.IsIncreasing(first)
+	// The first review of the pull request is notified.
+	a.True(hasNewSQLReviewViolations(nil, first))
+	record := &store.WebhookEventRecordMessage{Key: "sql-review/https://github.com/octocat/db/pull/7", Fingerprints: first}
+
+	// The same violations moved to the other lines by the next push are not notified again.
+	a.False(hasNewSQLReviewViolations(record, getSQLReviewViolationFingerprints([]string{nullable, noIndex})))
+	// The push fixing some violations is not notified.
+	a.False(hasNewSQLReviewViolations(record, getSQLReviewViolationFingerprints([]string{nullable})))
+	// The same rule violated in another file or with another detail is new.
+	a.True(hasNewSQLReviewViolations(record, getSQLReviewViolationFingerprints([]string{nullable, getSQLReviewViolation("migrations/0002_add.sql", "column-no-null", "Column id is nullable")})))
+	a.True(hasNewSQLReviewViolations(record, getSQLReviewViolationFingerprints([]string{getSQLReviewViolation("migrations/0001_init.sql", "column-no-null", "Column name is nullable")})))
+	// The fields don't run into each other.
+	a.NotEqual(getSQLReviewViolation("a", "bc", ""), getSQLReviewViolation("ab", "c", ""))
+}
@@ -0,0 +1,179 @@
+package im
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/slack"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// RegisterIMCallbackRoutes registers the routes for the interactive messages of the IM apps.
+func (s *Service) RegisterIMCallbackRoutes(g *echo.Group) {
+	// Docs: https://api.slack.com/interactivity/handling
+	g.POST("/slack/interactivity", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("failed to read body, error %v", err))
+		}
+		setting, err := s.store.GetAppIMSetting(ctx)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get IM setting, error %v", err))
+		}
+		slackSetting := setting.GetSlack()
+		if slackSetting.GetSigningSecret() == "" {
+			return c.String(http.StatusForbidden, "Slack signing secret is not configured")
+		}
+		if err := slack.VerifyRequest(
+			slackSetting.SigningSecret,
+			c.Request().Header.Get("X-Slack-Request-Timestamp"),
+			c.Request().Header.Get("X-Slack-Signature"),
+			body,
+			time.Now(),
+		); err != nil {
+			return c.String(http.StatusUnauthorized, err.Error())
+		}
+
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("failed to parse body, error %v", err))
+		}
+		var payload slack.InteractionPayload
+		if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("failed to unmarshal payload, error %v", err))
+		}
+		if payload.Type != "block_actions" {
+			return c.String(http.StatusOK, fmt.Sprintf("skip interaction type %q", payload.Type))
+		}
+		for _, action := range payload.Actions {
+			if action.ActionID != slack.ActionApproveIssue && action.ActionID != slack.ActionRejectIssue {
+				continue
+			}
+			message, err := func() (string, error) {
+				email, err := slack.GetUserEmail(ctx, slackSetting.Token, payload.User.ID)
+				if err != nil {
+					return "", errors.Wrapf(err, "failed to get email of Slack user %q", payload.User.ID)
+				}
+				return s.reviewIssue(ctx, email, action.Value, action.ActionID == slack.ActionApproveIssue), nil
+			}()
+			if err != nil {
+				slog.Error("failed to handle Slack interaction", log.BBError(err))
+				message = "Failed to find your Bytebase account, please review the issue in Bytebase."
+			}
+			if err := slack.Respond(ctx, payload.ResponseURL, message); err != nil {
+				slog.Error("failed to respond to Slack interaction", log.BBError(err))
+			}
+		}
+		return c.NoContent(http.StatusOK)
+	})
+
+	// Docs: https://open.feishu.cn/document/uAjLw4CM/ukzMukzMukzM/feishu-cards/card-callback-communication
+	g.POST("/feishu/callback", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return c.String(http.StatusBadRequest, fmt.Sprintf("failed to read body, error %v", err))
+		}
+		setting, err := s.store.GetAppIMSetting(ctx)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, fmt.Sprintf("failed to get IM setting, error %v", err))
+		}
+		feishuSetting := setting.GetFeishu()
+		if feishuSetting.GetVerificationToken() == "" || feishuSetting.GetEncryptKey() == "" {
+			return c.String(http.StatusForbidden, "Feishu verification token or encrypt key is not configured")
+		}
+		request, err := feishu.DecryptRequest(feishuSetting.EncryptKey, body)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+
+		// The URL verification request is not signed, it's verified by the token.
+		if request.Type == feishu.TypeURLVerification {
+			if !isTokenEqual(request.Token, feishuSetting.VerificationToken) {
+				return c.String(http.StatusUnauthorized, "invalid verification token")
+			}
+			return c.JSON(http.StatusOK, &feishu.CallbackResponse{Challenge: request.Challenge})
+		}
+
+		if err := feishu.VerifyRequest(
+			feishuSetting.EncryptKey,
+			c.Request().Header.Get("X-Lark-Request-Timestamp"),
+			c.Request().Header.Get("X-Lark-Request-Nonce"),
+			c.Request().Header.Get("X-Lark-Signature"),
+			body,
+			time.Now(),
+		); err != nil {
+			return c.String(http.StatusUnauthorized, err.Error())
+		}
+		if !isTokenEqual(request.Header.Token, feishuSetting.VerificationToken) {
+			return c.String(http.StatusUnauthorized, "invalid verification token")
+		}
+		if request.Header.EventType != feishu.EventTypeCardActionTrigger {
+			return c.JSON(http.StatusOK, &feishu.CallbackResponse{})
+		}
+		value := request.Event.Action.Value
+		if value.Action != feishu.ActionApproveIssue && value.Action != feishu.ActionRejectIssue {
+			return c.JSON(http.StatusOK, &feishu.CallbackResponse{})
+		}
+
+		email, err := feishu.GetEmailByID(ctx, feishuSetting.AppId, feishuSetting.AppSecret, request.Event.Operator.OpenID)
+		if err != nil {
+			slog.Error("failed to get email of Feishu user", slog.String("openID", request.Event.Operator.OpenID), log.BBError(err))
+			return c.JSON(http.StatusOK, &feishu.CallbackResponse{
+				Toast: &feishu.CallbackToast{Type: "error", Content: "Failed to find your Bytebase account, please review the issue in Bytebase."},
+			})
+		}
+		message := s.reviewIssue(ctx, email, value.Issue, value.Action == feishu.ActionApproveIssue)
+		return c.JSON(http.StatusOK, &feishu.CallbackResponse{
+			Toast: &feishu.CallbackToast{Type: "info", Content: message},
+		})
+	})
+}
+
+// reviewIssue approves or rejects the issue on behalf of the Bytebase user with the email.
+// It returns the result message for the IM user.
+func (s *Service) reviewIssue(ctx context.Context, email, issueName string, approve bool) string {
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		slog.Error("failed to get user by email", slog.String("email", email), log.BBError(err))
+		return "Failed to find your Bytebase account, please review the issue in Bytebase."
+	}
+	if user == nil || user.MemberDeleted || user.Type != api.EndUser {
+		return fmt.Sprintf("No active Bytebase account with email %s.", email)
+	}
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+	ctx = context.WithValue(ctx, common.UserContextKey, user)
+
+	if approve {
+		issue, err := s.issueService.ApproveIssue(ctx, &v1pb.ApproveIssueRequest{Name: issueName})
+		if err != nil {
+			return fmt.Sprintf("Failed to approve the issue: %s", status.Convert(err).Message())
+		}
+		return fmt.Sprintf("You approved the issue %q.", issue.Title)
+	}
+	issue, err := s.issueService.RejectIssue(ctx, &v1pb.RejectIssueRequest{Name: issueName})
+	if err != nil {
+		return fmt.Sprintf("Failed to reject the issue: %s", status.Convert(err).Message())
+	}
+	return fmt.Sprintf("You rejected the issue %q.", issue.Title)
+}
+
+func isTokenEqual(got, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
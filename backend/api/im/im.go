@@ -0,0 +1,24 @@
+// Package im is the package for the callbacks from the IM apps.
+package im
+
+import (
+	v1pb "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Service is the API endpoint for handling the IM callbacks.
+type Service struct {
+	store        *store.Store
+	issueService *v1pb.IssueService
+}
+
+// NewService creates an IM callback service.
+func NewService(
+	store *store.Store,
+	issueService *v1pb.IssueService,
+) *Service {
+	return &Service{
+		store:        store,
+		issueService: issueService,
+	}
+}
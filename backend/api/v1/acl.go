@@ -202,6 +202,11 @@ func doIAMPermissionCheck(ctx context.Context, iamManager *iam.Manager, fullMeth
 		return true, nil, nil
 	}
 	if authContext.AuthMethod != common.AuthMethodIAM {
+		// The custom methods check the permissions in the handlers, which may not consult the access token scope.
+		// A scoped token fails closed unless the permission declared by the method is within the scope.
+		if scope, ok := common.GetAccessTokenScopeFromContext(ctx); ok && !scope.IsUnrestricted() {
+			return authContext.Permission != "" && scope.Allows(authContext.Permission), nil, nil
+		}
 		return true, nil, nil
 	}
 	// Handle GetProject() error status.
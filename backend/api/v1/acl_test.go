@@ -1,12 +1,14 @@
 package v1
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -216,3 +218,77 @@ func TestToSnakeCase(t *testing.T) {
 		require.Equal(t, tt.want, got, tt.input)
 	}
 }
+
+func TestDoIAMPermissionCheckCustomMethodScope(t *testing.T) {
+	readOnly := &common.AccessTokenScope{Permissions: []string{"bb.databases.query", "bb.issues.get", "bb.plans.get"}}
+	tests := []struct {
+		name        string
+		scope       *common.AccessTokenScope
+		fullMethod  string
+		authContext *common.AuthContext
+		want        bool
+	}{
+		{
+			name:        "read-only token approving issue",
+			scope:       readOnly,
+			fullMethod:  "/bytebase.v1.IssueService/ApproveIssue",
+			authContext: &common.AuthContext{AuthMethod: common.AuthMethodCustom},
+			want:        false,
+		},
+		{
+			name:        "read-only token creating access token",
+			scope:       readOnly,
+			fullMethod:  "/bytebase.v1.AuthService/CreateAccessToken",
+			authContext: &common.AuthContext{AuthMethod: common.AuthMethodCustom},
+			want:        false,
+		},
+		{
+			name:        "read-only token updating plan",
+			scope:       readOnly,
+			fullMethod:  "/bytebase.v1.PlanService/UpdatePlan",
+			authContext: &common.AuthContext{Permission: "bb.plans.update", AuthMethod: common.AuthMethodCustom},
+			want:        false,
+		},
+		{
+			name:        "read-only token searching issues",
+			scope:       readOnly,
+			fullMethod:  "/bytebase.v1.IssueService/SearchIssues",
+			authContext: &common.AuthContext{Permission: "bb.issues.get", AuthMethod: common.AuthMethodCustom},
+			want:        true,
+		},
+		{
+			// The custom methods carry no project resource to match the project scope.
+			name:        "project token searching issues",
+			scope:       &common.AccessTokenScope{ProjectID: "hr"},
+			fullMethod:  "/bytebase.v1.IssueService/SearchIssues",
+			authContext: &common.AuthContext{Permission: "bb.issues.get", AuthMethod: common.AuthMethodCustom},
+			want:        false,
+		},
+		{
+			name:        "unrestricted token approving issue",
+			scope:       &common.AccessTokenScope{},
+			fullMethod:  "/bytebase.v1.IssueService/ApproveIssue",
+			authContext: &common.AuthContext{AuthMethod: common.AuthMethodCustom},
+			want:        true,
+		},
+		{
+			name:        "session approving issue",
+			fullMethod:  "/bytebase.v1.IssueService/ApproveIssue",
+			authContext: &common.AuthContext{AuthMethod: common.AuthMethodCustom},
+			want:        true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			ctx := context.Background()
+			if test.scope != nil {
+				ctx = context.WithValue(ctx, common.AccessTokenScopeContextKey, test.scope)
+			}
+			// The custom methods are not checked by the IAM manager.
+			ok, _, err := doIAMPermissionCheck(ctx, nil, test.fullMethod, &store.UserMessage{ID: 101}, test.authContext)
+			a.NoError(err)
+			a.Equal(test.want, ok)
+		})
+	}
+}
@@ -125,9 +125,12 @@ type auditStream struct {
 	grpc.ServerStream
 	needAudit  bool
 	curRequest any
-	ctx        context.Context
-	method     string
-	storage    *store.Store
+	// auditedRequest is the request whose response has been audited.
+	// The request is audited once for the server streaming responses, e.g. the chunks of ExportStream.
+	auditedRequest any
+	ctx            context.Context
+	method         string
+	storage        *store.Store
 }
 
 func (s *auditStream) RecvMsg(request any) error {
@@ -148,10 +151,11 @@ func (s *auditStream) SendMsg(resp any) error {
 		return err
 	}
 	// audit log.
-	if s.needAudit && s.curRequest != nil {
+	if s.needAudit && s.curRequest != nil && s.curRequest != s.auditedRequest {
 		if auditErr := createAuditLog(s.ctx, s.curRequest, resp, s.method, s.storage, nil, nil); auditErr != nil {
 			return auditErr
 		}
+		s.auditedRequest = s.curRequest
 	}
 
 	return nil
@@ -185,6 +189,22 @@ func getRequestResource(request any) string {
 	switch r := request.(type) {
 	case *v1pb.QueryRequest:
 		return r.Name
+	case *v1pb.QueryStreamRequest:
+		return r.Name
+	case *v1pb.CancelQueryRequest:
+		return r.Name
+	case *v1pb.ExplainQueryRequest:
+		return r.Name
+	case *v1pb.CreateEditorSessionRequest:
+		return r.Parent
+	case *v1pb.DeleteEditorSessionRequest:
+		return r.Name
+	case *v1pb.BeginEditorSessionTransactionRequest:
+		return r.Name
+	case *v1pb.CommitEditorSessionTransactionRequest:
+		return r.Name
+	case *v1pb.RollbackEditorSessionTransactionRequest:
+		return r.Name
 	case *v1pb.ExecuteRequest:
 		return r.Name
 	case *v1pb.AdminExecuteRequest:
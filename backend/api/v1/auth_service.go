@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -398,6 +399,23 @@ func (s *AuthService) UpdateUser(ctx context.Context, request *v1pb.UpdateUserRe
 				}
 				patch.MFAConfig = &storepb.MFAConfig{}
 			}
+		case "workload_identity_config":
+			if user.Type != api.ServiceAccount {
+				return nil, status.Errorf(codes.InvalidArgument, "workload identity can be configured for service accounts only")
+			}
+			profile, ok := proto.Clone(user.Profile).(*storepb.UserProfile)
+			if !ok {
+				return nil, status.Errorf(codes.Internal, "failed to clone user profile")
+			}
+			profile.WorkloadIdentityConfig = nil
+			if request.User.WorkloadIdentityConfig != nil {
+				config := convertToStoreWorkloadIdentityConfig(request.User.WorkloadIdentityConfig)
+				if err := auth.ValidateWorkloadIdentityConfig(config); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid workload identity config, error: %v", err)
+				}
+				profile.WorkloadIdentityConfig = config
+			}
+			patch.Profile = profile
 		case "phone":
 			if request.User.Phone != "" {
 				if err := common.ValidatePhone(request.User.Phone); err != nil {
@@ -602,6 +620,17 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 		},
 	}
 
+	if config := user.Profile.WorkloadIdentityConfig; config != nil {
+		convertedUser.WorkloadIdentityConfig = &v1pb.WorkloadIdentityConfig{
+			ProviderType:     v1pb.WorkloadIdentityConfig_ProviderType(config.ProviderType),
+			IssuerUrl:        config.IssuerUrl,
+			AllowedAudiences: config.AllowedAudiences,
+			Repository:       config.Repository,
+			Branch:           config.Branch,
+			Subject:          config.Subject,
+		}
+	}
+
 	if user.MFAConfig != nil {
 		convertedUser.MfaEnabled = user.MFAConfig.OtpSecret != ""
 		convertedUser.MfaSecret = user.MFAConfig.TempOtpSecret
@@ -610,6 +639,17 @@ func convertToUser(user *store.UserMessage) *v1pb.User {
 	return convertedUser
 }
 
+func convertToStoreWorkloadIdentityConfig(config *v1pb.WorkloadIdentityConfig) *storepb.WorkloadIdentityConfig {
+	return &storepb.WorkloadIdentityConfig{
+		ProviderType:     storepb.WorkloadIdentityConfig_ProviderType(config.ProviderType),
+		IssuerUrl:        config.IssuerUrl,
+		AllowedAudiences: config.AllowedAudiences,
+		Repository:       config.Repository,
+		Branch:           config.Branch,
+		Subject:          config.Subject,
+	}
+}
+
 func convertToPrincipalType(userType v1pb.UserType) (api.PrincipalType, error) {
 	var t api.PrincipalType
 	switch userType {
@@ -833,6 +873,36 @@ func (s *AuthService) Logout(ctx context.Context, _ *v1pb.LogoutRequest) (*empty
 	return &emptypb.Empty{}, nil
 }
 
+// ExchangeToken exchanges an OIDC ID token of a CI workload for a short-lived access token of the service account.
+func (s *AuthService) ExchangeToken(ctx context.Context, request *v1pb.ExchangeTokenRequest) (*v1pb.ExchangeTokenResponse, error) {
+	if request.Token == "" || request.Email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token and email must be set")
+	}
+	user, err := s.store.GetUserByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user by email %q: %v", request.Email, err)
+	}
+	// Do not reveal whether the service account exists.
+	if user == nil || user.MemberDeleted || user.Type != api.ServiceAccount || user.Profile.WorkloadIdentityConfig == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "workload identity is not configured for %q", request.Email)
+	}
+	if err := auth.VerifyWorkloadIdentityToken(ctx, user.Profile.WorkloadIdentityConfig, request.Token); err != nil {
+		// The detail is only logged since it reveals the workload identity config of the service account.
+		slog.Warn("failed to verify workload identity token", slog.String("email", request.Email), log.BBError(err))
+		return nil, status.Errorf(codes.Unauthenticated, "invalid workload identity token")
+	}
+
+	expireTime := time.Now().Add(auth.WorkloadIdentityTokenDuration)
+	token, err := auth.GenerateAccessToken(user.Name, user.ID, s.profile.Mode, s.secret, auth.WorkloadIdentityTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token, error: %v", err)
+	}
+	return &v1pb.ExchangeTokenResponse{
+		AccessToken: token,
+		ExpireTime:  timestamppb.New(expireTime),
+	}, nil
+}
+
 func (s *AuthService) getAndVerifyUser(ctx context.Context, request *v1pb.LoginRequest) (*store.UserMessage, error) {
 	user, err := s.store.GetUserByEmail(ctx, request.Email)
 	if err != nil {
@@ -0,0 +1,262 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// maxAccessTokenRotationOverlap is the maximum overlap window of the old token after rotation.
+const maxAccessTokenRotationOverlap = 7 * 24 * time.Hour
+
+// ListAccessTokens lists the access tokens of a user.
+func (s *AuthService) ListAccessTokens(ctx context.Context, request *v1pb.ListAccessTokensRequest) (*v1pb.ListAccessTokensResponse, error) {
+	user, err := s.getAccessTokenOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.store.ListAccessTokens(ctx, &store.FindAccessTokenMessage{
+		PrincipalUID: &user.ID,
+		ShowRevoked:  request.ShowRevoked,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access tokens, error: %v", err)
+	}
+	response := &v1pb.ListAccessTokensResponse{}
+	for _, token := range tokens {
+		response.AccessTokens = append(response.AccessTokens, convertToAccessToken(token))
+	}
+	return response, nil
+}
+
+// CreateAccessToken creates an access token for a user.
+func (s *AuthService) CreateAccessToken(ctx context.Context, request *v1pb.CreateAccessTokenRequest) (*v1pb.AccessToken, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to get caller user")
+	}
+	if request.AccessToken == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "access token must be set")
+	}
+	if request.AccessToken.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access token title must be set")
+	}
+	user, err := s.getAccessTokenOwner(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if !iam.PermissionsExist(request.AccessToken.Permissions...) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid permissions %v", request.AccessToken.Permissions)
+	}
+	payload := &storepb.AccessTokenPayload{
+		Permissions: request.AccessToken.Permissions,
+	}
+	if request.AccessToken.Project != "" {
+		projectID, err := common.GetProjectID(request.AccessToken.Project)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+		}
+		if project == nil {
+			return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+		}
+		payload.Project = projectID
+	}
+	create := &store.AccessTokenMessage{
+		PrincipalUID: user.ID,
+		Name:         request.AccessToken.Title,
+		Payload:      payload,
+	}
+	if request.AccessToken.ExpireTime != nil {
+		expireTime := request.AccessToken.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		create.ExpireTime = &expireTime
+	}
+	token, tokenHash, err := auth.GenerateOpaqueAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token, error: %v", err)
+	}
+	create.TokenHash = tokenHash
+
+	accessToken, err := s.store.CreateAccessToken(ctx, create, callerUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create access token, error: %v", err)
+	}
+	response := convertToAccessToken(accessToken)
+	response.Token = token
+	return response, nil
+}
+
+// RevokeAccessToken revokes an access token.
+func (s *AuthService) RevokeAccessToken(ctx context.Context, request *v1pb.RevokeAccessTokenRequest) (*v1pb.AccessToken, error) {
+	accessToken, err := s.getAccessToken(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if accessToken.Revoked {
+		return nil, status.Errorf(codes.InvalidArgument, "access token %q has been revoked", request.Name)
+	}
+	accessToken, err = s.store.UpdateAccessToken(ctx, &store.UpdateAccessTokenMessage{UID: accessToken.UID, Revoke: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access token, error: %v", err)
+	}
+	return convertToAccessToken(accessToken), nil
+}
+
+// RotateAccessToken rotates an access token.
+func (s *AuthService) RotateAccessToken(ctx context.Context, request *v1pb.RotateAccessTokenRequest) (*v1pb.AccessToken, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to get caller user")
+	}
+	oldToken, err := s.getAccessToken(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if oldToken.Revoked {
+		return nil, status.Errorf(codes.InvalidArgument, "access token %q has been revoked", request.Name)
+	}
+	if oldToken.ExpireTime != nil && oldToken.ExpireTime.Before(now) {
+		return nil, status.Errorf(codes.InvalidArgument, "access token %q has expired", request.Name)
+	}
+	var overlap time.Duration
+	if request.Overlap != nil {
+		overlap = request.Overlap.AsDuration()
+	}
+	if overlap < 0 || overlap > maxAccessTokenRotationOverlap {
+		return nil, status.Errorf(codes.InvalidArgument, "overlap must be between 0 and %v", maxAccessTokenRotationOverlap)
+	}
+
+	create := &store.AccessTokenMessage{
+		PrincipalUID: oldToken.PrincipalUID,
+		Name:         oldToken.Name,
+		Payload: &storepb.AccessTokenPayload{
+			Permissions: oldToken.Payload.Permissions,
+			Project:     oldToken.Payload.Project,
+			RotatedFrom: int32(oldToken.UID),
+		},
+	}
+	if request.ExpireTime != nil {
+		expireTime := request.ExpireTime.AsTime()
+		if !expireTime.After(now) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		create.ExpireTime = &expireTime
+	} else if oldToken.ExpireTime != nil {
+		// Keep the lifetime of the old token.
+		expireTime := now.Add(oldToken.ExpireTime.Sub(oldToken.CreatedTime))
+		create.ExpireTime = &expireTime
+	}
+	token, tokenHash, err := auth.GenerateOpaqueAccessToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate access token, error: %v", err)
+	}
+	create.TokenHash = tokenHash
+
+	accessToken, err := s.store.RotateAccessToken(ctx, oldToken, create, now.Add(overlap), callerUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate access token, error: %v", err)
+	}
+	response := convertToAccessToken(accessToken)
+	response.Token = token
+	return response, nil
+}
+
+// getAccessTokenOwner returns the owner of the access tokens if the caller is allowed to manage them.
+// Only the user itself and the user with bb.users.update permission on the workspace can manage the tokens.
+func (s *AuthService) getAccessTokenOwner(ctx context.Context, parent string) (*store.UserMessage, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to get caller user")
+	}
+	// Prevent a scoped token from minting tokens beyond its own scope.
+	if _, ok := common.GetAccessTokenScopeFromContext(ctx); ok {
+		return nil, status.Errorf(codes.PermissionDenied, "access tokens cannot be managed with an access token")
+	}
+	userID, err := common.GetUserID(parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", userID)
+	}
+	if user.MemberDeleted {
+		return nil, status.Errorf(codes.NotFound, "user %q has been deleted", userID)
+	}
+	if callerUser.ID != userID {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionUsersUpdate, callerUser)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "user does not have permission %q", iam.PermissionUsersUpdate)
+		}
+	}
+	return user, nil
+}
+
+func (s *AuthService) getAccessToken(ctx context.Context, name string) (*store.AccessTokenMessage, error) {
+	userID, tokenID, err := common.GetUserIDAccessTokenID(name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getAccessTokenOwner(ctx, common.FormatUserUID(userID))
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := s.store.GetAccessToken(ctx, &store.FindAccessTokenMessage{
+		UID:          &tokenID,
+		PrincipalUID: &user.ID,
+		ShowRevoked:  true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get access token, error: %v", err)
+	}
+	if accessToken == nil {
+		return nil, status.Errorf(codes.NotFound, "access token %q not found", name)
+	}
+	return accessToken, nil
+}
+
+func convertToAccessToken(token *store.AccessTokenMessage) *v1pb.AccessToken {
+	accessToken := &v1pb.AccessToken{
+		Name:        common.FormatAccessToken(token.PrincipalUID, token.UID),
+		Title:       token.Name,
+		Permissions: token.Payload.Permissions,
+		CreateTime:  timestamppb.New(token.CreatedTime),
+		State:       convertDeletedToState(token.Revoked),
+	}
+	if token.Payload.Project != "" {
+		accessToken.Project = common.FormatProject(token.Payload.Project)
+	}
+	if token.ExpireTime != nil {
+		accessToken.ExpireTime = timestamppb.New(*token.ExpireTime)
+	}
+	if token.LastUsedTime != nil {
+		accessToken.LastUsedTime = timestamppb.New(*token.LastUsedTime)
+	}
+	if token.Payload.RotatedFrom != 0 {
+		accessToken.RotatedFrom = common.FormatAccessToken(token.PrincipalUID, int(token.Payload.RotatedFrom))
+	}
+	return accessToken
+}
@@ -346,6 +346,12 @@ func convertToEngine(engine storepb.Engine) v1pb.Engine {
 		return v1pb.Engine_DATABRICKS
 	case storepb.Engine_COCKROACHDB:
 		return v1pb.Engine_COCKROACHDB
+	case storepb.Engine_DUCKDB:
+		return v1pb.Engine_DUCKDB
+	case storepb.Engine_DB2:
+		return v1pb.Engine_DB2
+	case storepb.Engine_TRINO:
+		return v1pb.Engine_TRINO
 	}
 	return v1pb.Engine_ENGINE_UNSPECIFIED
 }
@@ -402,6 +408,12 @@ func convertEngine(engine v1pb.Engine) storepb.Engine {
 		return storepb.Engine_DATABRICKS
 	case v1pb.Engine_COCKROACHDB:
 		return storepb.Engine_COCKROACHDB
+	case v1pb.Engine_DUCKDB:
+		return storepb.Engine_DUCKDB
+	case v1pb.Engine_DB2:
+		return storepb.Engine_DB2
+	case v1pb.Engine_TRINO:
+		return storepb.Engine_TRINO
 	}
 	return storepb.Engine_ENGINE_UNSPECIFIED
 }
@@ -469,6 +481,10 @@ func convertExportFormat(format storepb.ExportFormat) v1pb.ExportFormat {
 		return v1pb.ExportFormat_SQL
 	case storepb.ExportFormat_XLSX:
 		return v1pb.ExportFormat_XLSX
+	case storepb.ExportFormat_PARQUET:
+		return v1pb.ExportFormat_PARQUET
+	case storepb.ExportFormat_NDJSON:
+		return v1pb.ExportFormat_NDJSON
 	}
 	return v1pb.ExportFormat_FORMAT_UNSPECIFIED
 }
@@ -483,6 +499,10 @@ func convertToExportFormat(format v1pb.ExportFormat) storepb.ExportFormat {
 		return storepb.ExportFormat_SQL
 	case v1pb.ExportFormat_XLSX:
 		return storepb.ExportFormat_XLSX
+	case v1pb.ExportFormat_PARQUET:
+		return storepb.ExportFormat_PARQUET
+	case v1pb.ExportFormat_NDJSON:
+		return storepb.ExportFormat_NDJSON
 	}
 	return storepb.ExportFormat_FORMAT_UNSPECIFIED
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
@@ -65,16 +66,18 @@ type DatabaseService struct {
 	v1pb.UnimplementedDatabaseServiceServer
 	store          *store.Store
 	schemaSyncer   *schemasync.Syncer
+	dbFactory      *dbfactory.DBFactory
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		schemaSyncer:   schemaSyncer,
+		dbFactory:      dbFactory,
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
@@ -1799,8 +1802,10 @@ func isUpperCaseLetter(c rune) bool {
 
 // AdviseIndex advises the index of a table.
 func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest) (*v1pb.AdviseIndexResponse, error) {
-	if err := s.licenseService.IsFeatureEnabled(api.FeatureAIAssistant); err != nil {
-		return nil, status.Error(codes.PermissionDenied, err.Error())
+	if request.Mode != v1pb.AdviseIndexRequest_MODE_RULE_BASED {
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAIAssistant); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 	}
 	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
 	if err != nil {
@@ -1828,6 +1833,9 @@ func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseI
 		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
 	}
 
+	if request.Mode == v1pb.AdviseIndexRequest_MODE_RULE_BASED {
+		return s.ruleBasedAdviseIndex(ctx, request, instance, database)
+	}
 	switch instance.Engine {
 	case storepb.Engine_POSTGRES:
 		return s.pgAdviseIndex(ctx, request, database)
@@ -0,0 +1,213 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/indexadvisor"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxAdviseSlowQueries is the maximum number of the slow query fingerprints advised, ordered by the total query time.
+	maxAdviseSlowQueries = 20
+	// explainTimeout is the timeout of explaining a statement.
+	explainTimeout = 10 * time.Second
+)
+
+// adviseIndexQuery is a statement advised by the rule-based index advisor.
+type adviseIndexQuery struct {
+	// statement is the statement to explain.
+	statement string
+	// name is the statement or the fingerprint of the slow query shown in the advice.
+	name string
+	// totalQueryTime is the total query time of the slow query.
+	totalQueryTime time.Duration
+	// slowQuery is true if the statement is a sample of the slow query, which is skipped if it cannot be advised.
+	slowQuery bool
+}
+
+// ruleBasedAdviseIndex advises the indexes with the query plans and the synced schema, without network access.
+func (s *DatabaseService) ruleBasedAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
+	if !indexadvisor.IsEngineSupported(instance.Engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "rule-based AdviseIndex is not implemented for engine: %v", instance.Engine)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database schema: %v", err)
+	}
+	if dbSchema == nil {
+		return nil, status.Errorf(codes.NotFound, "database schema %q not found", database.DatabaseName)
+	}
+	queries, err := s.getAdviseIndexQueries(ctx, request, instance, database)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+
+	var advices []*v1pb.IndexAdvice
+	adviceMap := make(map[string]*v1pb.IndexAdvice)
+	// adviceColumns are the key columns of the advices.
+	adviceColumns := make(map[*v1pb.IndexAdvice][]string)
+	for _, query := range queries {
+		results, err := s.adviseIndexForQuery(ctx, instance, database, dbSchema.GetDatabaseMetadata(), driver, query.statement)
+		if err != nil {
+			if query.slowQuery {
+				slog.Warn("failed to advise index for slow query", slog.String("database", database.DatabaseName), log.BBError(err))
+				continue
+			}
+			return nil, err
+		}
+		for _, result := range results {
+			table := result.Table
+			if result.Schema != "" {
+				table = fmt.Sprintf("%s.%s", result.Schema, result.Table)
+			}
+			// Merge the advices of the same index from different queries.
+			key := fmt.Sprintf("%s:%s:%s", table, result.CurrentIndex, result.CreateIndexStatement)
+			advice, ok := adviceMap[key]
+			if !ok {
+				advice = &v1pb.IndexAdvice{
+					Table:                table,
+					CurrentIndex:         result.CurrentIndex,
+					CreateIndexStatement: result.CreateIndexStatement,
+					RedundantIndexes:     result.RedundantIndexes,
+					Reason:               result.Reason,
+					RowsExamined:         result.RowsExamined,
+					RowsReturned:         result.RowsReturned,
+					EstimatedBenefit:     result.EstimatedBenefit,
+				}
+				adviceMap[key] = advice
+				adviceColumns[advice] = result.Columns
+				advices = append(advices, advice)
+			}
+			advice.Statements = append(advice.Statements, query.name)
+			advice.EstimatedBenefit = max(advice.EstimatedBenefit, result.EstimatedBenefit)
+			if query.totalQueryTime > 0 {
+				savedTime := time.Duration(result.EstimatedBenefit * float64(query.totalQueryTime))
+				advice.EstimatedSavedTime = durationpb.New(advice.EstimatedSavedTime.AsDuration() + savedTime)
+			}
+		}
+	}
+	sort.SliceStable(advices, func(i, j int) bool {
+		if a, b := advices[i].EstimatedSavedTime.AsDuration(), advices[j].EstimatedSavedTime.AsDuration(); a != b {
+			return a > b
+		}
+		return advices[i].EstimatedBenefit > advices[j].EstimatedBenefit
+	})
+
+	response := &v1pb.AdviseIndexResponse{
+		CurrentIndex: "No usable index",
+		Suggestion:   "N/A",
+		Advices:      advices,
+	}
+	if len(advices) > 0 {
+		if advices[0].CurrentIndex != "" {
+			response.CurrentIndex = advices[0].CurrentIndex
+		}
+		if advices[0].CreateIndexStatement != "" {
+			response.Suggestion = fmt.Sprintf("USING BTREE (%s)", strings.Join(adviceColumns[advices[0]], ", "))
+			response.CreateIndexStatement = advices[0].CreateIndexStatement
+		}
+	}
+	return response, nil
+}
+
+// getAdviseIndexQueries returns the statements in the request, or the samples of the slow queries of the database if the statement is empty.
+func (s *DatabaseService) getAdviseIndexQueries(ctx context.Context, request *v1pb.AdviseIndexRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*adviseIndexQuery, error) {
+	if request.Statement != "" {
+		singleSQLs, err := base.SplitMultiSQL(instance.Engine, request.Statement)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to split statement: %v", err)
+		}
+		var queries []*adviseIndexQuery
+		for _, singleSQL := range base.FilterEmptySQL(singleSQLs) {
+			queries = append(queries, &adviseIndexQuery{
+				statement: singleSQL.Text,
+				name:      strings.TrimSpace(singleSQL.Text),
+			})
+		}
+		return queries, nil
+	}
+
+	logs, err := s.store.ListSlowQuery(ctx, &store.ListSlowQueryMessage{
+		InstanceUID: &instance.UID,
+		DatabaseUID: &database.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list slow queries: %v", err)
+	}
+	var queries []*adviseIndexQuery
+	for _, slowLog := range logs {
+		statistics := slowLog.Statistics
+		// The fingerprint cannot be explained, so the sample is explained instead.
+		if statistics == nil || len(statistics.Samples) == 0 {
+			continue
+		}
+		queries = append(queries, &adviseIndexQuery{
+			statement:      statistics.Samples[0].SqlText,
+			name:           statistics.SqlFingerprint,
+			totalQueryTime: statistics.AverageQueryTime.AsDuration() * time.Duration(statistics.Count),
+			slowQuery:      true,
+		})
+	}
+	sort.SliceStable(queries, func(i, j int) bool {
+		return queries[i].totalQueryTime > queries[j].totalQueryTime
+	})
+	if len(queries) > maxAdviseSlowQueries {
+		queries = queries[:maxAdviseSlowQueries]
+	}
+	return queries, nil
+}
+
+func (s *DatabaseService) adviseIndexForQuery(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *model.DatabaseMetadata, driver db.Driver, statement string) ([]*indexadvisor.Advice, error) {
+	readOnly, _, err := base.ValidateSQLForEditor(instance.Engine, statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to validate statement: %v", err)
+	}
+	if !readOnly {
+		return nil, status.Errorf(codes.InvalidArgument, "only the read-only statements can be advised")
+	}
+	// The query span filters out the identifiers in the plan which are not the columns of the query.
+	// The advisor still works with the synced schema if the query span is unavailable.
+	var sourceColumns base.SourceColumnSet
+	spans, err := getQuerySpans(ctx, s.store, instance, database, statement, "")
+	if err != nil {
+		slog.Debug("failed to get query span", slog.String("database", database.DatabaseName), log.BBError(err))
+	} else if len(spans) == 1 && spans[0].NotFoundError == nil {
+		sourceColumns = spans[0].SourceColumns
+	}
+
+	explainCtx, cancel := context.WithTimeout(ctx, explainTimeout)
+	defer cancel()
+	plan, err := indexadvisor.Explain(explainCtx, instance.Engine, driver.GetDB(), statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to explain statement: %v", err)
+	}
+	advices, err := indexadvisor.Advise(instance.Engine, metadata, &indexadvisor.Query{
+		Plan:          plan,
+		SourceColumns: sourceColumns,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to advise index: %v", err)
+	}
+	return advices, nil
+}
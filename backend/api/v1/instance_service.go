@@ -104,6 +104,9 @@ func (s *InstanceService) CreateInstance(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if !db.IsRegistered(instanceMessage.Engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "engine %v is not supported in this build", instanceMessage.Engine)
+	}
 
 	// Test connection.
 	if request.ValidateOnly {
@@ -1167,6 +1170,27 @@ func convertToStoreDataSourceExternalSecret(externalSecret *v1pb.DataSourceExter
 		if secret.SecretName == "" {
 			return nil, status.Errorf(codes.InvalidArgument, "missing GCP secret name")
 		}
+	case storepb.DataSourceExternalSecret_VAULT_DATABASE:
+		if secret.Url == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing Vault URL")
+		}
+		if secret.EngineName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing Vault database secrets engine mount path")
+		}
+		if secret.SecretName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing Vault database role name")
+		}
+	case storepb.DataSourceExternalSecret_AZURE_KEY_VAULT:
+		if secret.Url == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing Azure Key Vault URL")
+		}
+		if secret.SecretName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing Azure Key Vault secret name")
+		}
+	case storepb.DataSourceExternalSecret_FILE:
+		if secret.SecretName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing secret file name")
+		}
 	}
 
 	switch secret.AuthType {
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -25,6 +26,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
@@ -41,15 +43,17 @@ type ProjectService struct {
 	profile        *config.Profile
 	iamManager     *iam.Manager
 	licenseService enterprise.LicenseService
+	webhookManager *webhook.Manager
 }
 
 // NewProjectService creates a new ProjectService.
-func NewProjectService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, licenseService enterprise.LicenseService) *ProjectService {
+func NewProjectService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, licenseService enterprise.LicenseService, webhookManager *webhook.Manager) *ProjectService {
 	return &ProjectService{
 		store:          store,
 		profile:        profile,
 		iamManager:     iamManager,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -730,6 +734,10 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 	}
 
 	update := &store.UpdateProjectWebhookMessage{}
+	payload, ok := proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+	if !ok || payload == nil {
+		payload = &storepb.ProjectWebhookPayload{}
+	}
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
 		case "type":
@@ -748,9 +756,38 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 			}
 			update.ActivityList = types
 		case "direct_message":
-			update.Payload = &storepb.ProjectWebhookPayload{
-				DirectMessage: request.Webhook.DirectMessage,
+			payload.DirectMessage = request.Webhook.DirectMessage
+			update.Payload = payload
+		case "custom_config":
+			if webhook.Type != customWebhookType {
+				return nil, status.Errorf(codes.InvalidArgument, "custom config is only supported by custom webhooks")
+			}
+			customConfig, err := convertToStoreCustomWebhookConfig(request.Webhook.CustomConfig)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			// The signing secret is only updated with custom_config.signing_secret.
+			customConfig.SigningSecret = payload.GetCustomConfig().GetSigningSecret()
+			if err := restoreCustomWebhookHeaders(customConfig.Headers, payload.GetCustomConfig().GetHeaders()); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			payload.CustomConfig = customConfig
+			update.Payload = payload
+		case "custom_config.signing_secret":
+			if webhook.Type != customWebhookType {
+				return nil, status.Errorf(codes.InvalidArgument, "custom config is only supported by custom webhooks")
+			}
+			if payload.CustomConfig == nil {
+				payload.CustomConfig = &storepb.CustomWebhookConfig{}
 			}
+			payload.CustomConfig.SigningSecret = request.Webhook.GetCustomConfig().GetSigningSecret()
+			update.Payload = payload
+		case "slack_channel_id":
+			if webhook.Type != slackWebhookType && request.Webhook.SlackChannelId != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "slack channel ID is only supported by Slack webhooks")
+			}
+			payload.SlackChannelId = request.Webhook.SlackChannelId
+			update.Payload = payload
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid field %q", path)
 		}
@@ -852,8 +889,48 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// The signing secret and the header values are not returned to the client, so test with the saved ones if they are not given.
+	var existingHeaders map[string]string
+	if request.Webhook.Name != "" && webhook.Payload.CustomConfig != nil {
+		_, webhookID, err := common.GetProjectIDWebhookID(request.Webhook.Name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		webhookUID, err := strconv.Atoi(webhookID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid webhook id %q", webhookID)
+		}
+		existing, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+			ProjectID: &project.UID,
+			ID:        &webhookUID,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if existing != nil {
+			if webhook.Payload.CustomConfig.SigningSecret == "" {
+				webhook.Payload.CustomConfig.SigningSecret = existing.Payload.GetCustomConfig().GetSigningSecret()
+			}
+			existingHeaders = existing.Payload.GetCustomConfig().GetHeaders()
+		}
+	}
+	if webhook.Payload.CustomConfig != nil {
+		if err := restoreCustomWebhookHeaders(webhook.Payload.CustomConfig.Headers, existingHeaders); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// The Slack app of the IM integration posts the message if the Slack channel is set.
+	var imSetting *storepb.AppIMSetting
+	if webhook.Payload.SlackChannelId != "" {
+		imSetting, err = s.store.GetAppIMSetting(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
 
 	resp := &v1pb.TestWebhookResponse{}
+	trace := &webhookplugin.Trace{}
 	err = webhookplugin.Post(
 		webhook.Type,
 		webhookplugin.Context{
@@ -875,12 +952,19 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 				Type:        "bb.issue.database.create",
 				Description: "This is a test issue",
 			},
-			Project: &webhookplugin.Project{Name: project.Title},
+			Project:        &webhookplugin.Project{Name: project.Title},
+			CustomConfig:   webhook.Payload.CustomConfig,
+			SlackChannelID: webhook.Payload.SlackChannelId,
+			IMSetting:      imSetting,
+			Trace:          trace,
 		},
 	)
 	if err != nil {
 		resp.Error = err.Error()
 	}
+	resp.RequestBody = trace.RequestBody
+	resp.ResponseCode = int32(trace.ResponseCode)
+	resp.ResponseBody = trace.ResponseBody
 
 	return resp, nil
 }
@@ -894,17 +978,80 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 	if err != nil {
 		return nil, err
 	}
+	payload := &storepb.ProjectWebhookPayload{
+		DirectMessage: webhook.DirectMessage,
+	}
+	if webhook.CustomConfig != nil {
+		if tp != customWebhookType {
+			return nil, common.Errorf(common.Invalid, "custom config is only supported by custom webhooks")
+		}
+		customConfig, err := convertToStoreCustomWebhookConfig(webhook.CustomConfig)
+		if err != nil {
+			return nil, err
+		}
+		payload.CustomConfig = customConfig
+	}
+	if webhook.SlackChannelId != "" {
+		if tp != slackWebhookType {
+			return nil, common.Errorf(common.Invalid, "slack channel ID is only supported by Slack webhooks")
+		}
+		payload.SlackChannelId = webhook.SlackChannelId
+	}
 	return &store.ProjectWebhookMessage{
 		Type:         tp,
 		URL:          webhook.Url,
 		Title:        webhook.Title,
 		ActivityList: activityTypes,
-		Payload: &storepb.ProjectWebhookPayload{
-			DirectMessage: webhook.DirectMessage,
-		},
+		Payload:      payload,
 	}, nil
 }
 
+func convertToStoreCustomWebhookConfig(config *v1pb.CustomWebhookConfig) (*storepb.CustomWebhookConfig, error) {
+	customConfig := &storepb.CustomWebhookConfig{
+		SigningSecret:   config.GetSigningSecret(),
+		PayloadTemplate: config.GetPayloadTemplate(),
+		Headers:         config.GetHeaders(),
+	}
+	if err := webhookplugin.ValidateCustomConfig(customConfig); err != nil {
+		return nil, common.Wrap(err, common.Invalid)
+	}
+	return customConfig, nil
+}
+
+func convertToV1CustomWebhookConfig(config *storepb.CustomWebhookConfig) *v1pb.CustomWebhookConfig {
+	if config == nil {
+		return nil
+	}
+	// The header values may carry the credentials of the receiver.
+	var headers map[string]string
+	for name := range config.Headers {
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		headers[name] = redactedHeaderValue
+	}
+	return &v1pb.CustomWebhookConfig{
+		HasSigningSecret: config.SigningSecret != "",
+		PayloadTemplate:  config.PayloadTemplate,
+		Headers:          headers,
+	}
+}
+
+// restoreCustomWebhookHeaders replaces the redacted header values sent back by the client with the existing ones.
+func restoreCustomWebhookHeaders(headers, existing map[string]string) error {
+	for name, value := range headers {
+		if value != redactedHeaderValue {
+			continue
+		}
+		existingValue, ok := existing[name]
+		if !ok {
+			return errors.Errorf("header %q has the redacted value but no existing value", name)
+		}
+		headers[name] = existingValue
+	}
+	return nil
+}
+
 func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error) {
 	var result []string
 	for _, tp := range types {
@@ -953,6 +1100,18 @@ func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error)
 			result = append(result, string(api.ActivityNotifyIssueApproved))
 		case v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, string(api.ActivityNotifyPipelineRollout))
+		case v1pb.Activity_TYPE_NOTIFY_ROLE_GRANT_EXPIRING:
+			result = append(result, string(api.ActivityNotifyRoleGrantExpiring))
+		case v1pb.Activity_TYPE_DATABASE_SCHEMA_DRIFT:
+			result = append(result, string(api.ActivityDatabaseSchemaDrift))
+		case v1pb.Activity_TYPE_DATABASE_CONNECTION_ANOMALY:
+			result = append(result, string(api.ActivityDatabaseConnectionAnomaly))
+		case v1pb.Activity_TYPE_DATABASE_DATA_EXPORT_READY:
+			result = append(result, string(api.ActivityDatabaseDataExportReady))
+		case v1pb.Activity_TYPE_PLAN_CHECK_RUN_ERROR:
+			result = append(result, string(api.ActivityPlanCheckRunError))
+		case v1pb.Activity_TYPE_VCS_SQL_REVIEW_VIOLATION:
+			result = append(result, string(api.ActivityVCSSQLReviewViolation))
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -1006,6 +1165,18 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_ISSUE_APPROVED)
 		case string(api.ActivityNotifyPipelineRollout):
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT)
+		case string(api.ActivityNotifyRoleGrantExpiring):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_ROLE_GRANT_EXPIRING)
+		case string(api.ActivityDatabaseSchemaDrift):
+			result = append(result, v1pb.Activity_TYPE_DATABASE_SCHEMA_DRIFT)
+		case string(api.ActivityDatabaseConnectionAnomaly):
+			result = append(result, v1pb.Activity_TYPE_DATABASE_CONNECTION_ANOMALY)
+		case string(api.ActivityDatabaseDataExportReady):
+			result = append(result, v1pb.Activity_TYPE_DATABASE_DATA_EXPORT_READY)
+		case string(api.ActivityPlanCheckRunError):
+			result = append(result, v1pb.Activity_TYPE_PLAN_CHECK_RUN_ERROR)
+		case string(api.ActivityVCSSQLReviewViolation):
+			result = append(result, v1pb.Activity_TYPE_VCS_SQL_REVIEW_VIOLATION)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -1013,13 +1184,23 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 	return result
 }
 
+// customWebhookType is the webhook type of the custom webhook, which supports the custom config.
+const customWebhookType = "bb.plugin.webhook.custom"
+
+// slackWebhookType is the webhook type of Slack, which supports the Slack channel ID.
+const slackWebhookType = "bb.plugin.webhook.slack"
+
+// redactedHeaderValue replaces the values of the custom webhook headers in the response.
+// The client sends it back to keep the existing value.
+const redactedHeaderValue = "******"
+
 func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 	switch tp {
 	case v1pb.Webhook_TYPE_UNSPECIFIED:
 		return "", common.Errorf(common.Invalid, "webhook type must not be unspecified")
 	// TODO(zp): find a better way to place the "bb.plugin.webhook.*".
 	case v1pb.Webhook_TYPE_SLACK:
-		return "bb.plugin.webhook.slack", nil
+		return slackWebhookType, nil
 	case v1pb.Webhook_TYPE_DISCORD:
 		return "bb.plugin.webhook.discord", nil
 	case v1pb.Webhook_TYPE_TEAMS:
@@ -1031,7 +1212,13 @@ func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 	case v1pb.Webhook_TYPE_WECOM:
 		return "bb.plugin.webhook.wecom", nil
 	case v1pb.Webhook_TYPE_CUSTOM:
-		return "bb.plugin.webhook.custom", nil
+		return customWebhookType, nil
+	case v1pb.Webhook_TYPE_GOOGLE_CHAT:
+		return "bb.plugin.webhook.googlechat", nil
+	case v1pb.Webhook_TYPE_MATTERMOST:
+		return "bb.plugin.webhook.mattermost", nil
+	case v1pb.Webhook_TYPE_TELEGRAM:
+		return "bb.plugin.webhook.telegram", nil
 	default:
 		return "", common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -1039,7 +1226,7 @@ func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 
 func convertWebhookTypeString(tp string) v1pb.Webhook_Type {
 	switch tp {
-	case "bb.plugin.webhook.slack":
+	case slackWebhookType:
 		return v1pb.Webhook_TYPE_SLACK
 	case "bb.plugin.webhook.discord":
 		return v1pb.Webhook_TYPE_DISCORD
@@ -1051,8 +1238,14 @@ func convertWebhookTypeString(tp string) v1pb.Webhook_Type {
 		return v1pb.Webhook_TYPE_FEISHU
 	case "bb.plugin.webhook.wecom":
 		return v1pb.Webhook_TYPE_WECOM
-	case "bb.plugin.webhook.custom":
+	case customWebhookType:
 		return v1pb.Webhook_TYPE_CUSTOM
+	case "bb.plugin.webhook.googlechat":
+		return v1pb.Webhook_TYPE_GOOGLE_CHAT
+	case "bb.plugin.webhook.mattermost":
+		return v1pb.Webhook_TYPE_MATTERMOST
+	case "bb.plugin.webhook.telegram":
+		return v1pb.Webhook_TYPE_TELEGRAM
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
@@ -1256,6 +1449,8 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 			Url:               webhook.URL,
 			NotificationTypes: convertNotificationTypeStrings(webhook.ActivityList),
 			DirectMessage:     webhook.Payload.GetDirectMessage(),
+			CustomConfig:      convertToV1CustomWebhookConfig(webhook.Payload.GetCustomConfig()),
+			SlackChannelId:    webhook.Payload.GetSlackChannelId(),
 		})
 	}
 
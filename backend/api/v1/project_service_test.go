@@ -180,7 +180,7 @@ func TestValidateBindings(t *testing.T) {
 
 	a := require.New(t)
 	// Mock an empty project service to test the validateBindings function.
-	projectService := NewProjectService(nil, nil, nil, nil)
+	projectService := NewProjectService(nil, nil, nil, nil, nil)
 	for _, tt := range tests {
 		err := projectService.validateBindings(tt.bindings, tt.roles, nil)
 		if tt.wantErr {
@@ -520,3 +520,38 @@ func TestFindIamPolicyDeltas(t *testing.T) {
 		}
 	}
 }
+
+func TestCustomWebhookHeaders(t *testing.T) {
+	a := require.New(t)
+	config := convertToV1CustomWebhookConfig(&storepb.CustomWebhookConfig{
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	a.Equal(map[string]string{"Authorization": redactedHeaderValue}, config.Headers)
+
+	existing := map[string]string{"Authorization": "Bearer token", "X-Env": "prod"}
+	headers := map[string]string{"Authorization": redactedHeaderValue, "X-Env": "test"}
+	a.NoError(restoreCustomWebhookHeaders(headers, existing))
+	a.Equal(map[string]string{"Authorization": "Bearer token", "X-Env": "test"}, headers)
+
+	a.Error(restoreCustomWebhookHeaders(map[string]string{"X-New": redactedHeaderValue}, existing))
+}
+
+func TestConvertToStoreProjectWebhookMessageSlackChannel(t *testing.T) {
+	a := require.New(t)
+	webhook, err := convertToStoreProjectWebhookMessage(&v1pb.Webhook{
+		Type:              v1pb.Webhook_TYPE_SLACK,
+		Url:               "https://hooks.slack.com/services/T/B/X",
+		NotificationTypes: []v1pb.Activity_Type{v1pb.Activity_TYPE_ISSUE_CREATE},
+		SlackChannelId:    "C0123456789",
+	})
+	a.NoError(err)
+	a.Equal("C0123456789", webhook.Payload.SlackChannelId)
+
+	_, err = convertToStoreProjectWebhookMessage(&v1pb.Webhook{
+		Type:              v1pb.Webhook_TYPE_DISCORD,
+		Url:               "https://discord.com/api/webhooks/1/token",
+		NotificationTypes: []v1pb.Activity_Type{v1pb.Activity_TYPE_ISSUE_CREATE},
+		SlackChannelId:    "C0123456789",
+	})
+	a.Error(err)
+}
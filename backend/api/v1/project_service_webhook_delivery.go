@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	webhookUID, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook id %q", webhookID)
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookUID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := parseLimitAndOffset(request.PageToken, int(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := limit + 1
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		ProjectWebhookID: &webhook.ID,
+		Limit:            &limitPlusOne,
+		Offset:           &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries, error: %v", err)
+	}
+
+	response := &v1pb.ListWebhookDeliveriesResponse{}
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertToWebhookDelivery(projectID, delivery))
+	}
+	return response, nil
+}
+
+// RedeliverWebhookDelivery enqueues the event of a delivery as a new delivery.
+func (s *ProjectService) RedeliverWebhookDelivery(ctx context.Context, request *v1pb.RedeliverWebhookDeliveryRequest) (*v1pb.WebhookDelivery, error) {
+	projectID, webhookUID, deliveryUID, err := common.GetProjectIDWebhookIDDeliveryID(request.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookUID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		UID:              &deliveryUID,
+		ProjectWebhookID: &webhook.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery, error: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery %q not found", request.Name)
+	}
+
+	redeliveries, err := s.store.CreateWebhookDeliveries(ctx, []*store.WebhookDeliveryMessage{
+		{
+			ProjectWebhookID: webhook.ID,
+			Event:            delivery.Event,
+			Status:           store.WebhookDeliveryPending,
+			NextAttemptTime:  time.Now(),
+			Payload: &storepb.WebhookDeliveryPayload{
+				RedeliveredFrom: int32(delivery.UID),
+			},
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook delivery, error: %v", err)
+	}
+	s.webhookManager.NotifyDelivery()
+	return convertToWebhookDelivery(projectID, redeliveries[0]), nil
+}
+
+func (s *ProjectService) getProjectWebhook(ctx context.Context, projectID string, webhookUID int) (*store.ProjectWebhookMessage, error) {
+	project, err := s.getProjectMessage(ctx, common.FormatProject(projectID))
+	if err != nil {
+		return nil, err
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectID)
+	}
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.UID,
+		ID:        &webhookUID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook %d not found in project %q", webhookUID, projectID)
+	}
+	return webhook, nil
+}
+
+func convertToWebhookDelivery(projectID string, delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	webhookDelivery := &v1pb.WebhookDelivery{
+		Name:       common.FormatWebhookDelivery(projectID, delivery.ProjectWebhookID, delivery.UID),
+		CreateTime: timestamppb.New(delivery.CreatedTime),
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		webhookDelivery.Status = v1pb.WebhookDelivery_PENDING
+		webhookDelivery.NextAttemptTime = timestamppb.New(delivery.NextAttemptTime)
+	case store.WebhookDeliverySucceeded:
+		webhookDelivery.Status = v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		webhookDelivery.Status = v1pb.WebhookDelivery_FAILED
+	}
+	// The event is the webhook context, only the fields to identify the event are exposed.
+	var event struct {
+		ActivityType string
+		Title        string
+	}
+	if err := json.Unmarshal(delivery.Event, &event); err == nil {
+		webhookDelivery.Title = event.Title
+		if types := convertNotificationTypeStrings([]string{event.ActivityType}); len(types) > 0 {
+			webhookDelivery.ActivityType = types[0]
+		}
+	}
+	for _, attempt := range delivery.Payload.Attempts {
+		webhookDelivery.Attempts = append(webhookDelivery.Attempts, &v1pb.WebhookDelivery_Attempt{
+			Time:         attempt.Time,
+			RequestBody:  attempt.RequestBody,
+			ResponseCode: attempt.ResponseCode,
+			ResponseBody: attempt.ResponseBody,
+			Latency:      attempt.Latency,
+			Error:        attempt.Error,
+		})
+	}
+	if delivery.Payload.RedeliveredFrom != 0 {
+		webhookDelivery.RedeliveredFrom = common.FormatWebhookDelivery(projectID, delivery.ProjectWebhookID, int(delivery.Payload.RedeliveredFrom))
+	}
+	return webhookDelivery
+}
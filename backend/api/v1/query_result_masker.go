@@ -29,32 +29,11 @@ func NewQueryResultMasker(store *store.Store) *QueryResultMasker {
 
 // MaskResults masks the result in-place based on the dynamic masking policy, query-span, instance and action.
 func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*base.QuerySpan, results []*v1pb.QueryResult, instance *store.InstanceMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) error {
-	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find classification setting")
-	}
-
-	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find masking rule policy")
-	}
-
-	algorithmSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
-	if err != nil {
-		return errors.Wrapf(err, "failed to find masking algorithm setting")
-	}
-
-	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	m, err := s.newMaskingLevelEvaluator(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to find semantic types setting")
+		return err
 	}
 
-	m := newEmptyMaskingLevelEvaluator().
-		withMaskingRulePolicy(maskingRulePolicy).
-		withDataClassificationSetting(classificationSetting).
-		withMaskingAlgorithmSetting(algorithmSetting).
-		withSemanticTypeSetting(semanticTypesSetting)
-
 	// We expect the len(spans) == len(results), but to avoid NPE, we use the min(len(spans), len(results)) here.
 	loopBoundary := min(len(spans), len(results))
 	for i := 0; i < loopBoundary; i++ {
@@ -78,6 +57,46 @@ func (s *QueryResultMasker) MaskResults(ctx context.Context, spans []*base.Query
 	return nil
 }
 
+// GetMaskers returns the maskers of the columns in the query span, which can be applied to the result batches of the query.
+func (s *QueryResultMasker) GetMaskers(ctx context.Context, span *base.QuerySpan, instance *store.InstanceMessage, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, error) {
+	if span.NotFoundError != nil {
+		return nil, errors.Errorf("query span error: %v", span.NotFoundError)
+	}
+	m, err := s.newMaskingLevelEvaluator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.getMaskersForQuerySpan(ctx, m, instance, span, action)
+}
+
+func (s *QueryResultMasker) newMaskingLevelEvaluator(ctx context.Context) (*maskingLevelEvaluator, error) {
+	classificationSetting, err := s.store.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+
+	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+
+	algorithmSetting, err := s.store.GetMaskingAlgorithmSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking algorithm setting")
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+
+	return newEmptyMaskingLevelEvaluator().
+		withMaskingRulePolicy(maskingRulePolicy).
+		withDataClassificationSetting(classificationSetting).
+		withMaskingAlgorithmSetting(algorithmSetting).
+		withSemanticTypeSetting(semanticTypesSetting), nil
+}
+
 // getMaskersForQuerySpan returns the maskers for the query span.
 func (s *QueryResultMasker) getMaskersForQuerySpan(ctx context.Context, m *maskingLevelEvaluator, instance *store.InstanceMessage, span *base.QuerySpan, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, error) {
 	if span == nil {
@@ -559,7 +559,7 @@ func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collat
 		if owner == "" {
 			return errors.Errorf("database owner is required for CockroachDB")
 		}
-	case storepb.Engine_SQLITE, storepb.Engine_MONGODB, storepb.Engine_MSSQL:
+	case storepb.Engine_SQLITE, storepb.Engine_DUCKDB, storepb.Engine_MONGODB, storepb.Engine_MSSQL:
 		// no-op.
 	default:
 		if characterSet == "" {
@@ -613,6 +613,9 @@ func getCreateDatabaseStatement(dbType storepb.Engine, c *storepb.PlanConfig_Cre
 	case storepb.Engine_SQLITE:
 		// This is a fake CREATE DATABASE and USE statement since a single SQLite file represents a database. Engine driver will recognize it and establish a connection to create the sqlite file representing the database.
 		return fmt.Sprintf("CREATE DATABASE '%s';", databaseName), nil
+	case storepb.Engine_DUCKDB:
+		// Like SQLite, a single DuckDB file represents a database, and the engine driver creates the file for the fake CREATE DATABASE statement.
+		return fmt.Sprintf("CREATE DATABASE '%s';", databaseName), nil
 	case storepb.Engine_MONGODB:
 		// We just run createCollection in mongosh instead of execute `use <database>` first, because we execute the
 		// mongodb statement in mongosh with --file flag, and it doesn't support `use <database>` statement in the file.
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// cancelQueryTimeout is the timeout to cancel the query on the database side.
+const cancelQueryTimeout = 10 * time.Second
+
+// runningQuery is the query which can be cancelled by CancelQuery.
+type runningQuery struct {
+	// mu guards the cancel against the end of the query.
+	mu       sync.Mutex
+	finished bool
+
+	userID   int
+	database string
+	// canceler and sessionID are nil and empty if the driver cannot cancel the query on the database side.
+	canceler  db.QueryCanceler
+	sessionID string
+	// cancel cancels the context of the query.
+	cancel context.CancelFunc
+}
+
+// runningQueries are the running queries keyed by the query ID.
+type runningQueries struct {
+	sync.Mutex
+	queries map[string]*runningQuery
+}
+
+func newRunningQueries() *runningQueries {
+	return &runningQueries{queries: make(map[string]*runningQuery)}
+}
+
+func (r *runningQueries) get(queryID string) *runningQuery {
+	r.Lock()
+	defer r.Unlock()
+	return r.queries[queryID]
+}
+
+// registerRunningQuery tracks the query running in the connection until the returned func is called.
+func (s *SQLService) registerRunningQuery(ctx context.Context, queryID string, user *store.UserMessage, database string, driver db.Driver, conn *sql.Conn, cancel context.CancelFunc) (func(), error) {
+	query := &runningQuery{
+		userID:   user.ID,
+		database: database,
+		cancel:   cancel,
+	}
+	if canceler, ok := db.UnwrapDriver(driver).(db.QueryCanceler); ok && conn != nil {
+		sessionID, err := canceler.GetSessionID(ctx, conn)
+		if err != nil {
+			// The query can still be cancelled by the context.
+			slog.Warn("failed to get database session ID", slog.String("database", database), log.BBError(err))
+		} else {
+			query.canceler = canceler
+			query.sessionID = sessionID
+		}
+	}
+	return s.runningQueries.register(queryID, query)
+}
+
+// register tracks the query until the returned func is called.
+func (r *runningQueries) register(queryID string, query *runningQuery) (func(), error) {
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.queries[queryID]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "query %q is already running", queryID)
+	}
+	r.queries[queryID] = query
+	return func() {
+		query.mu.Lock()
+		query.finished = true
+		query.mu.Unlock()
+
+		r.Lock()
+		delete(r.queries, queryID)
+		r.Unlock()
+	}, nil
+}
+
+// CancelQuery cancels the running query on the database side.
+func (s *SQLService) CancelQuery(ctx context.Context, request *v1pb.CancelQueryRequest) (*emptypb.Empty, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if request.QueryId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query ID is required")
+	}
+	if err := s.runningQueries.cancel(ctx, request.Name, request.QueryId, user, func() (bool, error) {
+		return s.isWorkspaceAdmin(ctx, user)
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// cancel cancels the query in the database if the user issued it or is the workspace admin.
+// Cancelling the finished query is a no-op.
+func (r *runningQueries) cancel(ctx context.Context, database, queryID string, user *store.UserMessage, isWorkspaceAdmin func() (bool, error)) error {
+	query := r.get(queryID)
+	if query == nil || query.database != database {
+		return status.Errorf(codes.NotFound, "query %q is not running", queryID)
+	}
+	if query.userID != user.ID {
+		isAdmin, err := isWorkspaceAdmin()
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check workspace admin: %v", err)
+		}
+		if !isAdmin {
+			return status.Errorf(codes.PermissionDenied, "only the user who issued the query or the workspace admin can cancel it")
+		}
+	}
+
+	query.mu.Lock()
+	defer query.mu.Unlock()
+	if query.finished {
+		return nil
+	}
+	// Cancel on the database side first, otherwise the statement might keep running after the connection is released.
+	if query.canceler != nil {
+		cancelCtx, cancel := context.WithTimeout(ctx, cancelQueryTimeout)
+		defer cancel()
+		if err := query.canceler.CancelQuery(cancelCtx, query.sessionID); err != nil {
+			slog.Warn("failed to cancel query on the database side", slog.String("database", query.database), log.BBError(err))
+		}
+	}
+	query.cancel()
+	return nil
+}
+
+func (s *SQLService) isWorkspaceAdmin(ctx context.Context, user *store.UserMessage) (bool, error) {
+	workspacePolicy, err := s.store.GetWorkspaceIamPolicy(ctx)
+	if err != nil {
+		return false, err
+	}
+	roles := utils.GetUserFormattedRolesMap(ctx, s.store, user, workspacePolicy.Policy)
+	return roles[common.FormatRole(api.WorkspaceAdmin.String())], nil
+}
@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// fakeCancelerDriver is the driver cancelling the queries by the session ID.
+// The other driver methods panic through the nil embedded interface.
+type fakeCancelerDriver struct {
+	db.Driver
+
+	sessionErr error
+	// block blocks CancelQuery until it's closed if not nil.
+	block chan struct{}
+
+	mu        sync.Mutex
+	cancelled []string
+}
+
+func (d *fakeCancelerDriver) GetSessionID(context.Context, *sql.Conn) (string, error) {
+	if d.sessionErr != nil {
+		return "", d.sessionErr
+	}
+	return "42", nil
+}
+
+func (d *fakeCancelerDriver) CancelQuery(_ context.Context, sessionID string) error {
+	if d.block != nil {
+		<-d.block
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.cancelled = append(d.cancelled, sessionID)
+	return nil
+}
+
+func (d *fakeCancelerDriver) getCancelled() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.cancelled
+}
+
+const testCancelDatabase = "instances/mysql/databases/employee"
+
+func registerTestQuery(t *testing.T, s *SQLService, queryID string, user *store.UserMessage, driver db.Driver) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	unregister, err := s.registerRunningQuery(context.Background(), queryID, user, testCancelDatabase, driver, &sql.Conn{}, cancel)
+	require.NoError(t, err)
+	return ctx, unregister
+}
+
+func TestCancelQueryByIssuer(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{runningQueries: newRunningQueries()}
+	issuer := &store.UserMessage{ID: 101}
+	driver := &fakeCancelerDriver{}
+	queryCtx, unregister := registerTestQuery(t, s, "query-1", issuer, driver)
+	defer unregister()
+
+	ctx := context.WithValue(context.Background(), common.UserContextKey, issuer)
+	_, err := s.CancelQuery(ctx, &v1pb.CancelQueryRequest{Name: testCancelDatabase})
+	a.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = s.CancelQuery(ctx, &v1pb.CancelQueryRequest{Name: "instances/mysql/databases/other", QueryId: "query-1"})
+	a.Equal(codes.NotFound, status.Code(err))
+	a.NoError(queryCtx.Err())
+
+	_, err = s.CancelQuery(ctx, &v1pb.CancelQueryRequest{Name: testCancelDatabase, QueryId: "query-1"})
+	a.NoError(err)
+	a.Equal([]string{"42"}, driver.getCancelled())
+	a.ErrorIs(queryCtx.Err(), context.Canceled)
+
+	// The query is not found once it ends.
+	unregister()
+	_, err = s.CancelQuery(ctx, &v1pb.CancelQueryRequest{Name: testCancelDatabase, QueryId: "query-1"})
+	a.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestCancelQueryAuthorization(t *testing.T) {
+	issuer := &store.UserMessage{ID: 101}
+	other := &store.UserMessage{ID: 102}
+	tests := []struct {
+		name      string
+		user      *store.UserMessage
+		isAdmin   bool
+		adminErr  error
+		wantCode  codes.Code
+		wantAdmin bool
+	}{
+		{name: "issuer", user: issuer, wantCode: codes.OK},
+		{name: "workspace admin", user: other, isAdmin: true, wantCode: codes.OK, wantAdmin: true},
+		{name: "other user", user: other, wantCode: codes.PermissionDenied, wantAdmin: true},
+		{name: "admin check failure", user: other, adminErr: errors.New("store is down"), wantCode: codes.Internal, wantAdmin: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			s := &SQLService{runningQueries: newRunningQueries()}
+			driver := &fakeCancelerDriver{}
+			queryCtx, unregister := registerTestQuery(t, s, "query-1", issuer, driver)
+			defer unregister()
+
+			checkedAdmin := false
+			err := s.runningQueries.cancel(context.Background(), testCancelDatabase, "query-1", test.user, func() (bool, error) {
+				checkedAdmin = true
+				return test.isAdmin, test.adminErr
+			})
+			a.Equal(test.wantCode, status.Code(err))
+			a.Equal(test.wantAdmin, checkedAdmin)
+			if test.wantCode == codes.OK {
+				a.Equal([]string{"42"}, driver.getCancelled())
+				a.Error(queryCtx.Err())
+			} else {
+				a.Empty(driver.getCancelled())
+				a.NoError(queryCtx.Err())
+			}
+		})
+	}
+}
+
+func TestRegisterRunningQueryDuplicateID(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{runningQueries: newRunningQueries()}
+	user := &store.UserMessage{ID: 101}
+	_, unregister := registerTestQuery(t, s, "query-1", user, &fakeCancelerDriver{})
+
+	_, err := s.registerRunningQuery(context.Background(), "query-1", user, testCancelDatabase, &fakeCancelerDriver{}, &sql.Conn{}, func() {})
+	a.Equal(codes.AlreadyExists, status.Code(err))
+
+	// The query ID can be reused once the query ends.
+	unregister()
+	_, unregister = registerTestQuery(t, s, "query-1", user, &fakeCancelerDriver{})
+	unregister()
+}
+
+func TestCancelQueryWithoutSession(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{runningQueries: newRunningQueries()}
+	user := &store.UserMessage{ID: 101}
+	// The query is cancelled by the context only if the session ID is unavailable.
+	driver := &fakeCancelerDriver{sessionErr: errors.New("permission denied")}
+	queryCtx, unregister := registerTestQuery(t, s, "query-1", user, driver)
+	defer unregister()
+
+	a.NoError(s.runningQueries.cancel(context.Background(), testCancelDatabase, "query-1", user, nil))
+	a.Empty(driver.getCancelled())
+	a.ErrorIs(queryCtx.Err(), context.Canceled)
+}
+
+func TestCancelFinishedQuery(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{runningQueries: newRunningQueries()}
+	user := &store.UserMessage{ID: 101}
+	driver := &fakeCancelerDriver{}
+	queryCtx, unregister := registerTestQuery(t, s, "query-1", user, driver)
+	defer unregister()
+
+	// The query finishes after CancelQuery looks it up but before it cancels.
+	query := s.runningQueries.get("query-1")
+	query.mu.Lock()
+	query.finished = true
+	query.mu.Unlock()
+
+	a.NoError(s.runningQueries.cancel(context.Background(), testCancelDatabase, "query-1", user, nil))
+	// The session may be running the next query of the connection, so it must not be cancelled.
+	a.Empty(driver.getCancelled())
+	a.NoError(queryCtx.Err())
+}
+
+func TestQueryEndsWhileCancelling(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{runningQueries: newRunningQueries()}
+	user := &store.UserMessage{ID: 101}
+	driver := &fakeCancelerDriver{block: make(chan struct{})}
+	_, unregister := registerTestQuery(t, s, "query-1", user, driver)
+
+	cancelled := make(chan error, 1)
+	go func() {
+		cancelled <- s.runningQueries.cancel(context.Background(), testCancelDatabase, "query-1", user, nil)
+	}()
+	a.Eventually(func() bool {
+		query := s.runningQueries.get("query-1")
+		if !query.mu.TryLock() {
+			return true
+		}
+		query.mu.Unlock()
+		return false
+	}, 5*time.Second, time.Millisecond)
+
+	// The query doesn't end, i.e. release the connection, until the cancel on the database side returns.
+	unregistered := make(chan struct{})
+	go func() {
+		unregister()
+		close(unregistered)
+	}()
+	select {
+	case <-unregistered:
+		a.Fail("the query ends while it's being cancelled")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(driver.block)
+	a.NoError(<-cancelled)
+	<-unregistered
+	a.Equal([]string{"42"}, driver.getCancelled())
+	a.Nil(s.runningQueries.get("query-1"))
+}
@@ -0,0 +1,534 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultEditorSessionIdleTimeout = 10 * time.Minute
+	maxEditorSessionIdleTimeout     = time.Hour
+	maxEditorSessionsPerUser        = 10
+	// editorSessionRollbackTimeout is the timeout to roll back the open transaction of the closing session.
+	editorSessionRollbackTimeout = 10 * time.Second
+)
+
+// editorSessionTransactionStatements are the statements to control the transaction in the session connection.
+type editorSessionTransactionStatements struct {
+	begin    string
+	commit   string
+	rollback string
+	// inTransaction queries whether the connection has an open transaction, which may be begun by the statements
+	// run in AdminExecute. It's empty if the engine has no way to tell, and only the transactions begun by
+	// BeginEditorSessionTransaction are tracked.
+	inTransaction string
+}
+
+var editorSessionTransactions = map[storepb.Engine]editorSessionTransactionStatements{
+	// now() is the start time of the transaction, which differs from the statement start time in a transaction block.
+	storepb.Engine_POSTGRES: {begin: "BEGIN", commit: "COMMIT", rollback: "ROLLBACK", inTransaction: "SELECT now() <> statement_timestamp()"},
+	// The transaction is listed once it reads or writes the InnoDB tables.
+	storepb.Engine_MYSQL:     {begin: "START TRANSACTION", commit: "COMMIT", rollback: "ROLLBACK", inTransaction: "SELECT COUNT(*) > 0 FROM information_schema.innodb_trx WHERE trx_mysql_thread_id = CONNECTION_ID()"},
+	storepb.Engine_MARIADB:   {begin: "START TRANSACTION", commit: "COMMIT", rollback: "ROLLBACK", inTransaction: "SELECT @@in_transaction"},
+	storepb.Engine_TIDB:      {begin: "START TRANSACTION", commit: "COMMIT", rollback: "ROLLBACK"},
+	storepb.Engine_OCEANBASE: {begin: "START TRANSACTION", commit: "COMMIT", rollback: "ROLLBACK"},
+	// ROLLBACK fails without an open transaction in SQL Server.
+	storepb.Engine_MSSQL: {begin: "BEGIN TRANSACTION", commit: "COMMIT TRANSACTION", rollback: "IF @@TRANCOUNT > 0 ROLLBACK TRANSACTION", inTransaction: "SELECT CASE WHEN @@TRANCOUNT > 0 THEN 1 ELSE 0 END"},
+}
+
+type editorSessionTransactionAction int
+
+const (
+	editorSessionTransactionBegin editorSessionTransactionAction = iota
+	editorSessionTransactionCommit
+	editorSessionTransactionRollback
+)
+
+// editorSession is the SQL Editor session pinned to a dedicated database connection.
+// The sessions are ephemeral and per replica. They're kept in the memory of the server that creates them,
+// and are closed with their open transactions rolled back after the idle timeout or on the server shutdown.
+type editorSession struct {
+	// mu serializes the statements in the session.
+	mu sync.Mutex
+	// closed is guarded by mu.
+	closed bool
+
+	name         string
+	user         *store.UserMessage
+	instance     *store.InstanceMessage
+	database     *store.DatabaseMessage
+	dataSourceID string
+	admin        bool
+	idleTimeout  time.Duration
+	createTime   time.Time
+	driver       db.Driver
+	conn         *sql.Conn
+	idleTimer    *time.Timer
+
+	// stateMu guards the state below, which is read without waiting for the running statement.
+	stateMu        sync.Mutex
+	lastActiveTime time.Time
+	// transactionStartTime is zero if there is no open transaction.
+	transactionStartTime time.Time
+}
+
+func (s *editorSession) inTransaction() bool {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	return !s.transactionStartTime.IsZero()
+}
+
+func (s *editorSession) setTransactionStartTime(t time.Time) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	s.transactionStartTime = t
+}
+
+// detectTransaction queries whether the session connection has an open transaction.
+// ok is false if the engine has no way to tell. The failed query is treated as an open transaction,
+// e.g. Postgres rejects the statements in the aborted transaction until it's rolled back.
+// The caller must hold the lock of the session.
+func (s *editorSession) detectTransaction(ctx context.Context) (inTransaction bool, ok bool) {
+	query := editorSessionTransactions[s.instance.Engine].inTransaction
+	if query == "" {
+		return false, false
+	}
+	if err := s.conn.QueryRowContext(ctx, query).Scan(&inTransaction); err != nil {
+		slog.Debug("failed to detect the transaction of SQL Editor session", slog.String("session", s.name), log.BBError(err))
+		return true, true
+	}
+	return inTransaction, true
+}
+
+// syncTransactionState records the transaction begun by the statement started at start.
+// The caller must hold the lock of the session.
+func (s *editorSession) syncTransactionState(ctx context.Context, start time.Time) {
+	if s.inTransaction() {
+		return
+	}
+	if inTransaction, ok := s.detectTransaction(ctx); ok && inTransaction {
+		s.setTransactionStartTime(start)
+	}
+}
+
+func (s *editorSession) toProto() *v1pb.EditorSession {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	editorSession := &v1pb.EditorSession{
+		Name:           s.name,
+		DataSourceId:   s.dataSourceID,
+		Admin:          s.admin,
+		IdleTimeout:    durationpb.New(s.idleTimeout),
+		Creator:        common.FormatUserEmail(s.user.Email),
+		CreateTime:     timestamppb.New(s.createTime),
+		LastActiveTime: timestamppb.New(s.lastActiveTime),
+	}
+	if !s.transactionStartTime.IsZero() {
+		editorSession.TransactionStartTime = timestamppb.New(s.transactionStartTime)
+	}
+	return editorSession
+}
+
+// editorSessions are the live SQL Editor sessions keyed by the session name.
+type editorSessions struct {
+	sync.Mutex
+	sessions map[string]*editorSession
+}
+
+func newEditorSessions() *editorSessions {
+	return &editorSessions{sessions: make(map[string]*editorSession)}
+}
+
+func (m *editorSessions) add(session *editorSession, onIdle func()) error {
+	m.Lock()
+	defer m.Unlock()
+	count := 0
+	for _, s := range m.sessions {
+		if s.user.ID == session.user.ID {
+			count++
+		}
+	}
+	if count >= maxEditorSessionsPerUser {
+		return status.Errorf(codes.ResourceExhausted, "too many SQL Editor sessions, delete the unused sessions or wait for them to expire")
+	}
+	m.sessions[session.name] = session
+	session.idleTimer = time.AfterFunc(session.idleTimeout, onIdle)
+	return nil
+}
+
+func (m *editorSessions) get(name string, userID int) *editorSession {
+	m.Lock()
+	defer m.Unlock()
+	session, ok := m.sessions[name]
+	if !ok || session.user.ID != userID {
+		return nil
+	}
+	return session
+}
+
+func (m *editorSessions) list(database string, userID int) []*editorSession {
+	m.Lock()
+	defer m.Unlock()
+	var sessions []*editorSession
+	for name, session := range m.sessions {
+		if session.user.ID == userID && strings.HasPrefix(name, database+"/") {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
+
+// removeAll removes all sessions.
+func (m *editorSessions) removeAll() []*editorSession {
+	m.Lock()
+	defer m.Unlock()
+	var sessions []*editorSession
+	for _, session := range m.sessions {
+		sessions = append(sessions, session)
+	}
+	m.sessions = make(map[string]*editorSession)
+	return sessions
+}
+
+func (m *editorSessions) remove(name string) *editorSession {
+	m.Lock()
+	defer m.Unlock()
+	session, ok := m.sessions[name]
+	if !ok {
+		return nil
+	}
+	delete(m.sessions, name)
+	return session
+}
+
+// acquireEditorSession locks the session of the user for running statements until the returned func is called.
+// The idle timer of the session is paused in the meantime.
+func (s *SQLService) acquireEditorSession(name string, user *store.UserMessage, database *store.DatabaseMessage, admin bool) (*editorSession, func(), error) {
+	session := s.editorSessions.get(name, user.ID)
+	if session == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "SQL Editor session %q not found, it might be idle for too long or created by another server replica", name)
+	}
+	if database != nil && session.database.UID != database.UID {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "SQL Editor session %q does not belong to database %q", name, database.DatabaseName)
+	}
+	if session.admin != admin {
+		if admin {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "SQL Editor session %q is not an admin session", name)
+		}
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "the admin SQL Editor session %q only runs the AdminExecute requests", name)
+	}
+
+	session.mu.Lock()
+	if session.closed {
+		session.mu.Unlock()
+		return nil, nil, status.Errorf(codes.NotFound, "SQL Editor session %q not found, it might be idle for too long", name)
+	}
+	session.idleTimer.Stop()
+	return session, func() {
+		session.stateMu.Lock()
+		session.lastActiveTime = time.Now()
+		session.stateMu.Unlock()
+		session.idleTimer.Reset(session.idleTimeout)
+		session.mu.Unlock()
+	}, nil
+}
+
+// expireEditorSession closes the session which is idle for the idle timeout.
+func (s *SQLService) expireEditorSession(name string) {
+	session := s.editorSessions.remove(name)
+	if session == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), editorSessionRollbackTimeout)
+	defer cancel()
+	// Wait for the running statement.
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	rolledBack, rollbackErr := closeEditorSession(ctx, session)
+	if rolledBack == nil {
+		return
+	}
+	if err := s.store.CreateAuditLog(ctx, getEditorSessionExpireAuditLog(session, rolledBack, rollbackErr)); err != nil {
+		slog.Error("failed to create audit log for expired SQL Editor session", slog.String("session", session.name), log.BBError(err))
+	}
+}
+
+// CloseEditorSessions closes all SQL Editor sessions on the server shutdown and rolls back their open transactions.
+// The running statements are canceled with their requests by the server shutdown, so it doesn't wait for long.
+func (s *SQLService) CloseEditorSessions(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, session := range s.editorSessions.removeAll() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Wait for the running statement.
+			session.mu.Lock()
+			defer session.mu.Unlock()
+			closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), editorSessionRollbackTimeout)
+			defer cancel()
+			// The rollback failure is logged by closeEditorSession.
+			if rolledBack, err := closeEditorSession(closeCtx, session); rolledBack != nil && err == nil {
+				slog.Warn("rolled back the open transaction of SQL Editor session on shutdown", slog.String("session", session.name))
+			}
+		}()
+	}
+	closed := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-ctx.Done():
+		// The database rolls back the transactions when the connections are closed on exit anyway.
+		slog.Warn("failed to close SQL Editor sessions before shutdown", log.BBError(ctx.Err()))
+	}
+}
+
+// closeEditorSession rolls back the open transaction of the session and releases its connection.
+// It returns the session before the rollback if there was an open transaction, with the rollback error.
+// The caller must hold the lock of the session.
+func closeEditorSession(ctx context.Context, session *editorSession) (*v1pb.EditorSession, error) {
+	if session.closed {
+		return nil, nil
+	}
+	session.closed = true
+	session.idleTimer.Stop()
+
+	var rolledBack *v1pb.EditorSession
+	var rollbackErr error
+	inTransaction := session.inTransaction()
+	if detected, ok := session.detectTransaction(ctx); ok {
+		inTransaction = detected
+	}
+	if inTransaction {
+		if statements, ok := editorSessionTransactions[session.instance.Engine]; ok {
+			_, rollbackErr = session.conn.ExecContext(ctx, statements.rollback)
+		}
+		if rollbackErr != nil {
+			// The database rolls back the transaction when the connection is closed anyway.
+			slog.Warn("failed to roll back the transaction of SQL Editor session", slog.String("session", session.name), log.BBError(rollbackErr))
+		}
+		rolledBack = session.toProto()
+		session.setTransactionStartTime(time.Time{})
+	}
+	if err := session.conn.Close(); err != nil {
+		slog.Debug("failed to close SQL Editor session connection", log.BBError(err))
+	}
+	session.driver.Close(ctx)
+	return rolledBack, rollbackErr
+}
+
+// getEditorSessionExpireAuditLog returns the audit log for the transaction rolled back by the session expiration.
+func getEditorSessionExpireAuditLog(session *editorSession, rolledBack *v1pb.EditorSession, rollbackErr error) *storepb.AuditLog {
+	request, err := protojson.Marshal(rolledBack)
+	if err != nil {
+		slog.Warn("failed to marshal SQL Editor session", log.BBError(err))
+	}
+	st, _ := status.FromError(rollbackErr)
+	return &storepb.AuditLog{
+		Parent:   common.FormatProject(session.database.ProjectID),
+		Method:   store.AuditLogMethodEditorSessionExpire.String(),
+		Resource: session.name,
+		User:     common.FormatUserUID(session.user.ID),
+		Severity: storepb.AuditLog_WARNING,
+		Request:  string(request),
+		Status:   st.Proto(),
+	}
+}
+
+// CreateEditorSession creates a SQL Editor session pinned to a dedicated database connection.
+func (s *SQLService) CreateEditorSession(ctx context.Context, request *v1pb.CreateEditorSessionRequest) (*v1pb.EditorSession, error) {
+	if request.EditorSession == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "editor session is required")
+	}
+	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	idleTimeout := defaultEditorSessionIdleTimeout
+	if request.EditorSession.IdleTimeout != nil {
+		idleTimeout = request.EditorSession.IdleTimeout.AsDuration()
+		if idleTimeout <= 0 || idleTimeout > maxEditorSessionIdleTimeout {
+			return nil, status.Errorf(codes.InvalidArgument, "idle timeout must be positive and at most %v", maxEditorSessionIdleTimeout)
+		}
+	}
+
+	var driver db.Driver
+	if request.EditorSession.Admin {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionInstancesAdminExecute, user, database.ProjectID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "permission %q is required to create the admin session", iam.PermissionInstancesAdminExecute)
+		}
+		driver, err = s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+		}
+	} else {
+		dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, database, request.EditorSession.DataSourceId)
+		if err != nil {
+			return nil, err
+		}
+		driver, err = s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+		}
+	}
+	if driver.GetDB() == nil {
+		driver.Close(ctx)
+		return nil, status.Errorf(codes.Unimplemented, "SQL Editor session is not supported for engine %s", instance.Engine.String())
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		driver.Close(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+	}
+
+	now := time.Now()
+	session := &editorSession{
+		name:           common.FormatEditorSession(instance.ResourceID, database.DatabaseName, uuid.NewString()),
+		user:           user,
+		instance:       instance,
+		database:       database,
+		dataSourceID:   request.EditorSession.DataSourceId,
+		admin:          request.EditorSession.Admin,
+		idleTimeout:    idleTimeout,
+		createTime:     now,
+		lastActiveTime: now,
+		driver:         driver,
+		conn:           conn,
+	}
+	if session.admin {
+		session.dataSourceID = ""
+	}
+	if err := s.editorSessions.add(session, func() {
+		s.expireEditorSession(session.name)
+	}); err != nil {
+		_ = conn.Close()
+		driver.Close(ctx)
+		return nil, err
+	}
+	return session.toProto(), nil
+}
+
+// ListEditorSessions lists the SQL Editor sessions of the caller in the database.
+func (s *SQLService) ListEditorSessions(ctx context.Context, request *v1pb.ListEditorSessionsRequest) (*v1pb.ListEditorSessionsResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response := &v1pb.ListEditorSessionsResponse{}
+	for _, session := range s.editorSessions.list(request.Parent, user.ID) {
+		response.EditorSessions = append(response.EditorSessions, session.toProto())
+	}
+	return response, nil
+}
+
+// DeleteEditorSession closes the SQL Editor session and rolls back its open transaction.
+func (s *SQLService) DeleteEditorSession(ctx context.Context, request *v1pb.DeleteEditorSessionRequest) (*emptypb.Empty, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if s.editorSessions.get(request.Name, user.ID) == nil {
+		return nil, status.Errorf(codes.NotFound, "SQL Editor session %q not found", request.Name)
+	}
+	session := s.editorSessions.remove(request.Name)
+	if session == nil {
+		return &emptypb.Empty{}, nil
+	}
+	// Wait for the running statement.
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), editorSessionRollbackTimeout)
+	defer cancel()
+	_, _ = closeEditorSession(closeCtx, session)
+	return &emptypb.Empty{}, nil
+}
+
+// BeginEditorSessionTransaction begins a transaction in the admin SQL Editor session.
+func (s *SQLService) BeginEditorSessionTransaction(ctx context.Context, request *v1pb.BeginEditorSessionTransactionRequest) (*v1pb.EditorSession, error) {
+	return s.controlEditorSessionTransaction(ctx, request.Name, editorSessionTransactionBegin)
+}
+
+// CommitEditorSessionTransaction commits the open transaction of the admin SQL Editor session.
+func (s *SQLService) CommitEditorSessionTransaction(ctx context.Context, request *v1pb.CommitEditorSessionTransactionRequest) (*v1pb.EditorSession, error) {
+	return s.controlEditorSessionTransaction(ctx, request.Name, editorSessionTransactionCommit)
+}
+
+// RollbackEditorSessionTransaction rolls back the open transaction of the admin SQL Editor session.
+func (s *SQLService) RollbackEditorSessionTransaction(ctx context.Context, request *v1pb.RollbackEditorSessionTransactionRequest) (*v1pb.EditorSession, error) {
+	return s.controlEditorSessionTransaction(ctx, request.Name, editorSessionTransactionRollback)
+}
+
+func (s *SQLService) controlEditorSessionTransaction(ctx context.Context, name string, action editorSessionTransactionAction) (*v1pb.EditorSession, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	session, release, err := s.acquireEditorSession(name, user, nil, true /* admin */)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	statements, ok := editorSessionTransactions[session.instance.Engine]
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "transaction control is not supported for engine %s", session.instance.Engine.String())
+	}
+
+	var statement string
+	inTransaction := session.inTransaction()
+	switch action {
+	case editorSessionTransactionBegin:
+		if inTransaction {
+			return nil, status.Errorf(codes.FailedPrecondition, "SQL Editor session %q already has an open transaction", name)
+		}
+		statement = statements.begin
+	case editorSessionTransactionCommit, editorSessionTransactionRollback:
+		if !inTransaction {
+			return nil, status.Errorf(codes.FailedPrecondition, "SQL Editor session %q has no open transaction", name)
+		}
+		statement = statements.commit
+		if action == editorSessionTransactionRollback {
+			statement = statements.rollback
+		}
+	}
+
+	start := time.Now()
+	_, execErr := session.conn.ExecContext(ctx, statement)
+	if err := s.createQueryHistory(ctx, session.database, store.QueryHistoryTypeQuery, statement, user.ID, time.Since(start), execErr); err != nil {
+		slog.Error("failed to create query history", log.BBError(err))
+	}
+	if execErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run %q: %v", statement, execErr)
+	}
+	if action == editorSessionTransactionBegin {
+		session.setTransactionStartTime(start)
+	} else {
+		session.setTransactionStartTime(time.Time{})
+	}
+	return session.toProto(), nil
+}
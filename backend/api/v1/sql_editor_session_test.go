@@ -0,0 +1,338 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// fakeSessionDB is a database/sql driver recording the statements, which tracks the transaction like Postgres.
+type fakeSessionDB struct {
+	mu            sync.Mutex
+	statements    []string
+	inTransaction bool
+}
+
+func (d *fakeSessionDB) Connect(context.Context) (driver.Conn, error) {
+	return &fakeSessionConn{d: d}, nil
+}
+
+func (d *fakeSessionDB) Driver() driver.Driver {
+	return nil
+}
+
+func (d *fakeSessionDB) getStatements() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string{}, d.statements...)
+}
+
+type fakeSessionConn struct {
+	d *fakeSessionDB
+}
+
+func (c *fakeSessionConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeSessionStmt{d: c.d, query: query}, nil
+}
+
+func (*fakeSessionConn) Close() error {
+	return nil
+}
+
+func (*fakeSessionConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+type fakeSessionStmt struct {
+	d     *fakeSessionDB
+	query string
+}
+
+func (*fakeSessionStmt) Close() error {
+	return nil
+}
+
+func (*fakeSessionStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeSessionStmt) Exec([]driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.statements = append(s.d.statements, s.query)
+	switch strings.ToUpper(s.query) {
+	case "BEGIN":
+		s.d.inTransaction = true
+	case "COMMIT", "ROLLBACK":
+		s.d.inTransaction = false
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (s *fakeSessionStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.statements = append(s.d.statements, s.query)
+	return &fakeSessionRows{value: s.d.inTransaction}, nil
+}
+
+type fakeSessionRows struct {
+	value bool
+	done  bool
+}
+
+func (*fakeSessionRows) Columns() []string {
+	return []string{"in_transaction"}
+}
+
+func (*fakeSessionRows) Close() error {
+	return nil
+}
+
+func (r *fakeSessionRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+// fakeSessionDriver is the database driver of the session, only GetDB and Close are implemented.
+type fakeSessionDriver struct {
+	db.Driver
+	db     *sql.DB
+	mu     sync.Mutex
+	closed bool
+}
+
+func (d *fakeSessionDriver) GetDB() *sql.DB {
+	return d.db
+}
+
+func (d *fakeSessionDriver) Close(context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.closed = true
+	return d.db.Close()
+}
+
+func (d *fakeSessionDriver) isClosed() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.closed
+}
+
+func newFakeEditorSession(t *testing.T, userID int, admin bool) (*editorSession, *fakeSessionDB, *fakeSessionDriver) {
+	fakeDB := &fakeSessionDB{}
+	sqlDB := sql.OpenDB(fakeDB)
+	conn, err := sqlDB.Conn(context.Background())
+	require.NoError(t, err)
+	fakeDriver := &fakeSessionDriver{db: sqlDB}
+	now := time.Now()
+	return &editorSession{
+		name:           common.FormatEditorSession("prod", "db", "session"),
+		user:           &store.UserMessage{ID: userID, Email: "u@example.com"},
+		instance:       &store.InstanceMessage{ResourceID: "prod", Engine: storepb.Engine_POSTGRES},
+		database:       &store.DatabaseMessage{UID: 1, ProjectID: "p", InstanceID: "prod", DatabaseName: "db"},
+		admin:          admin,
+		idleTimeout:    time.Hour,
+		createTime:     now,
+		lastActiveTime: now,
+		driver:         fakeDriver,
+		conn:           conn,
+	}, fakeDB, fakeDriver
+}
+
+func TestEditorSessionsLimit(t *testing.T) {
+	a := require.New(t)
+	sessions := newEditorSessions()
+	newSession := func(name string, userID int) *editorSession {
+		return &editorSession{
+			name:        name,
+			user:        &store.UserMessage{ID: userID},
+			idleTimeout: time.Hour,
+		}
+	}
+	for i := 0; i < maxEditorSessionsPerUser; i++ {
+		session := newSession(common.FormatEditorSession("prod", "db", string(rune('a'+i))), 1)
+		a.NoError(sessions.add(session, func() {}))
+		t.Cleanup(func() { session.idleTimer.Stop() })
+	}
+	err := sessions.add(newSession(common.FormatEditorSession("prod", "db", "full"), 1), func() {})
+	a.Equal(codes.ResourceExhausted, status.Code(err))
+
+	// The limit is per user.
+	other := newSession(common.FormatEditorSession("prod", "db", "other"), 2)
+	a.NoError(sessions.add(other, func() {}))
+	defer other.idleTimer.Stop()
+	a.Len(sessions.list(common.FormatDatabase("prod", "db"), 1), maxEditorSessionsPerUser)
+	a.Len(sessions.list(common.FormatDatabase("prod", "db"), 2), 1)
+}
+
+func TestAcquireEditorSession(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{editorSessions: newEditorSessions()}
+	session, _, _ := newFakeEditorSession(t, 1, true /* admin */)
+	a.NoError(s.editorSessions.add(session, func() {}))
+	defer session.idleTimer.Stop()
+	owner := session.user
+
+	// Only the owner can use the session.
+	_, _, err := s.acquireEditorSession(session.name, &store.UserMessage{ID: 2}, nil, true /* admin */)
+	a.Equal(codes.NotFound, status.Code(err))
+	_, _, err = s.acquireEditorSession(session.name, owner, &store.DatabaseMessage{UID: 2, DatabaseName: "other"}, true /* admin */)
+	a.Equal(codes.InvalidArgument, status.Code(err))
+	// The admin session only runs AdminExecute.
+	_, _, err = s.acquireEditorSession(session.name, owner, session.database, false /* admin */)
+	a.Equal(codes.FailedPrecondition, status.Code(err))
+
+	acquired, release, err := s.acquireEditorSession(session.name, owner, session.database, true /* admin */)
+	a.NoError(err)
+	a.Same(session, acquired)
+	release()
+
+	querySession, _, _ := newFakeEditorSession(t, 1, false /* admin */)
+	querySession.name = common.FormatEditorSession("prod", "db", "query")
+	a.NoError(s.editorSessions.add(querySession, func() {}))
+	defer querySession.idleTimer.Stop()
+	_, _, err = s.acquireEditorSession(querySession.name, owner, nil, true /* admin */)
+	a.Equal(codes.FailedPrecondition, status.Code(err))
+
+	// The closed session is not found.
+	session.closed = true
+	_, _, err = s.acquireEditorSession(session.name, owner, nil, true /* admin */)
+	a.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestCloseEditorSessionRollsBackAdminExecuteTransaction(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	session, fakeDB, fakeDriver := newFakeEditorSession(t, 1, true /* admin */)
+	session.idleTimer = time.NewTimer(time.Hour)
+
+	// The transaction is begun by AdminExecute instead of BeginEditorSessionTransaction.
+	start := time.Now()
+	_, err := session.conn.ExecContext(ctx, "BEGIN")
+	a.NoError(err)
+	session.syncTransactionState(ctx, start)
+	a.True(session.inTransaction())
+
+	rolledBack, rollbackErr := closeEditorSession(ctx, session)
+	a.NoError(rollbackErr)
+	a.NotNil(rolledBack)
+	a.Equal(start.Unix(), rolledBack.TransactionStartTime.AsTime().Unix())
+	a.Contains(fakeDB.getStatements(), "ROLLBACK")
+	a.False(session.inTransaction())
+	a.True(fakeDriver.isClosed())
+
+	auditLog := getEditorSessionExpireAuditLog(session, rolledBack, rollbackErr)
+	a.Equal(store.AuditLogMethodEditorSessionExpire.String(), auditLog.Method)
+	a.Equal("projects/p", auditLog.Parent)
+	a.Equal(session.name, auditLog.Resource)
+	a.Equal(storepb.AuditLog_WARNING, auditLog.Severity)
+	a.Contains(auditLog.Request, "transactionStartTime")
+
+	// The closed session is skipped.
+	rolledBack, _ = closeEditorSession(ctx, session)
+	a.Nil(rolledBack)
+}
+
+func TestExpireEditorSession(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{editorSessions: newEditorSessions()}
+	session, fakeDB, fakeDriver := newFakeEditorSession(t, 1, true /* admin */)
+	session.idleTimeout = 10 * time.Millisecond
+	a.NoError(s.editorSessions.add(session, func() {
+		s.expireEditorSession(session.name)
+	}))
+
+	a.Eventually(fakeDriver.isClosed, 5*time.Second, 10*time.Millisecond)
+	a.Nil(s.editorSessions.get(session.name, session.user.ID))
+	// There is no open transaction to roll back.
+	a.NotContains(fakeDB.getStatements(), "ROLLBACK")
+}
+
+func TestCloseEditorSessions(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	s := &SQLService{editorSessions: newEditorSessions()}
+	session, fakeDB, fakeDriver := newFakeEditorSession(t, 1, true /* admin */)
+	a.NoError(s.editorSessions.add(session, func() {
+		s.expireEditorSession(session.name)
+	}))
+	idle, idleDB, idleDriver := newFakeEditorSession(t, 2, false /* admin */)
+	idle.name = common.FormatEditorSession("prod", "db", "idle")
+	a.NoError(s.editorSessions.add(idle, func() {
+		s.expireEditorSession(idle.name)
+	}))
+
+	acquired, release, err := s.acquireEditorSession(session.name, session.user, nil, true /* admin */)
+	a.NoError(err)
+	start := time.Now()
+	_, err = acquired.conn.ExecContext(ctx, "BEGIN")
+	a.NoError(err)
+	acquired.syncTransactionState(ctx, start)
+
+	// The server shuts down while the statement is running.
+	closed := make(chan struct{})
+	go func() {
+		s.CloseEditorSessions(ctx)
+		close(closed)
+	}()
+	select {
+	case <-closed:
+		a.FailNow("the session is closed before the running statement ends")
+	case <-time.After(100 * time.Millisecond):
+	}
+	release()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		a.FailNow("the sessions are not closed")
+	}
+
+	// The open transaction is rolled back and the pinned connections are released.
+	a.Contains(fakeDB.getStatements(), "ROLLBACK")
+	a.NotContains(idleDB.getStatements(), "ROLLBACK")
+	a.ErrorIs(session.conn.PingContext(ctx), sql.ErrConnDone)
+	a.ErrorIs(idle.conn.PingContext(ctx), sql.ErrConnDone)
+	a.True(fakeDriver.isClosed())
+	a.True(idleDriver.isClosed())
+	_, _, err = s.acquireEditorSession(session.name, session.user, nil, true /* admin */)
+	a.Equal(codes.NotFound, status.Code(err))
+	a.Empty(s.editorSessions.list(common.FormatDatabase("prod", "db"), 2))
+}
+
+func TestCloseEditorSessionsTimeout(t *testing.T) {
+	a := require.New(t)
+	s := &SQLService{editorSessions: newEditorSessions()}
+	session, _, fakeDriver := newFakeEditorSession(t, 1, true /* admin */)
+	a.NoError(s.editorSessions.add(session, func() {}))
+	_, release, err := s.acquireEditorSession(session.name, session.user, nil, true /* admin */)
+	a.NoError(err)
+
+	// The shutdown doesn't wait for the statement running beyond the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	s.CloseEditorSessions(ctx)
+	a.False(fakeDriver.isClosed())
+
+	// The session is still closed once the statement ends.
+	release()
+	a.Eventually(fakeDriver.isClosed, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/queryplan"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ExplainQuery explains a single query statement and returns the normalized query plan with the findings.
+func (s *SQLService) ExplainQuery(ctx context.Context, request *v1pb.ExplainQueryRequest) (*v1pb.ExplainQueryResponse, error) {
+	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !queryplan.IsEngineSupported(instance.Engine) {
+		return nil, status.Errorf(codes.Unimplemented, "explaining query is not supported for engine %s", instance.Engine.String())
+	}
+	statement := request.Statement
+	// In Redshift datashare, Rewrite query used for parser.
+	if database.DataShare {
+		statement = strings.ReplaceAll(statement, fmt.Sprintf("%s.", database.DatabaseName), "")
+	}
+	if err := validateQueryRequest(instance, statement); err != nil {
+		return nil, err
+	}
+	queryContext := db.QueryContext{Schema: request.GetSchema(), Explain: true}
+
+	spans, err := getQuerySpans(ctx, s.store, instance, database, statement, queryContext.Schema)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
+	}
+	if len(spans) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "explaining query supports a single statement, but got %d", len(spans))
+	}
+	// The statement is run if it's analyzed, so the access is checked as the query.
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		if err := s.accessCheck(ctx, instance, user, spans, false /* isExport */); err != nil {
+			return nil, err
+		}
+	}
+
+	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, database, request.DataSourceId)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+	explainer, ok := db.UnwrapDriver(driver).(db.QueryPlanExplainer)
+	if !ok || driver.GetDB() == nil {
+		return nil, status.Errorf(codes.Unimplemented, "explaining query is not supported for engine %s", instance.Engine.String())
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+	}
+	defer conn.Close()
+
+	timeout := defaultTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+	explainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	start := time.Now()
+	rawPlan, explainErr := explainer.ExplainQueryPlan(explainCtx, conn, statement, queryContext, request.Analyze)
+	if explainErr != nil && explainCtx.Err() == context.DeadlineExceeded {
+		explainErr = errors.Errorf("timeout reached: %v", timeout)
+	}
+	if err := s.createQueryHistory(ctx, database, store.QueryHistoryTypeQuery, statement, user.ID, time.Since(start), explainErr); err != nil {
+		slog.Error("failed to create query history", log.BBError(err))
+	}
+	if explainErr != nil {
+		return nil, status.Error(codes.InvalidArgument, explainErr.Error())
+	}
+
+	plan, err := queryplan.Parse(instance.Engine, rawPlan)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var metadata *model.DatabaseMetadata
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		slog.Warn("failed to get database schema", slog.String("database", database.DatabaseName), log.BBError(err))
+	} else if dbSchema != nil {
+		metadata = dbSchema.GetDatabaseMetadata()
+	}
+	findings := queryplan.Analyze(plan, func(schema, table string) int64 {
+		if metadata == nil {
+			return 0
+		}
+		if schema == "" {
+			schema = getExplainDefaultSchema(instance.Engine, queryContext.Schema)
+		}
+		schemaMetadata := metadata.GetSchema(schema)
+		if schemaMetadata == nil {
+			return 0
+		}
+		tableMetadata := schemaMetadata.GetTable(table)
+		if tableMetadata == nil {
+			return 0
+		}
+		return tableMetadata.GetRowCount()
+	})
+	return &v1pb.ExplainQueryResponse{
+		Plan:     plan,
+		Findings: findings,
+		RawPlan:  rawPlan,
+	}, nil
+}
+
+// getExplainDefaultSchema returns the schema of the tables without schema in the query plan.
+func getExplainDefaultSchema(engine storepb.Engine, schema string) string {
+	if schema != "" {
+		return schema
+	}
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return "public"
+	case storepb.Engine_MSSQL:
+		return "dbo"
+	default:
+		return ""
+	}
+}
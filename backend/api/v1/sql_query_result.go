@@ -0,0 +1,277 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// maxCachedQueryResults is the number of the latest results cached per user, database and worksheet.
+	maxCachedQueryResults = 10
+	// maxCachedQueryResultSize is the maximum size of the cached query response, 10 MB.
+	maxCachedQueryResultSize = 10 * 1024 * 1024
+)
+
+// cacheQueryResults caches the masked query results encrypted by the workspace secret.
+// It returns the name of the cached result, or empty if the results are too large.
+func (s *SQLService) cacheQueryResults(ctx context.Context, user *store.UserMessage, database *store.DatabaseMessage, request *v1pb.QueryRequest, results []*v1pb.QueryResult) (string, error) {
+	data, err := proto.Marshal(&v1pb.QueryResponse{Results: results})
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxCachedQueryResultSize {
+		return "", nil
+	}
+	encrypted, err := common.Encrypt(data, s.secret)
+	if err != nil {
+		return "", err
+	}
+	cache, err := s.store.CreateQueryResultCache(ctx, &store.QueryResultCacheMessage{
+		CreatorUID: user.ID,
+		Database:   common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		Worksheet:  request.Worksheet,
+		Statement:  request.Statement,
+		Result:     encrypted,
+	}, maxCachedQueryResults)
+	if err != nil {
+		return "", err
+	}
+	return common.FormatQueryResult(database.InstanceID, database.DatabaseName, cache.UID), nil
+}
+
+// ListQueryResults lists the cached query results of the caller in the database.
+func (s *SQLService) ListQueryResults(ctx context.Context, request *v1pb.ListQueryResultsRequest) (*v1pb.ListQueryResultsResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	database := common.FormatDatabase(instanceID, databaseName)
+	find := &store.FindQueryResultCacheMessage{
+		CreatorUID: &user.ID,
+		Database:   &database,
+	}
+	if request.Worksheet != "" {
+		find.Worksheet = &request.Worksheet
+	}
+	caches, err := s.store.ListQueryResultCaches(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list cached query results: %v", err)
+	}
+	response := &v1pb.ListQueryResultsResponse{}
+	for _, cache := range caches {
+		response.QueryResults = append(response.QueryResults, &v1pb.CachedQueryResult{
+			Name:       common.FormatQueryResult(instanceID, databaseName, cache.UID),
+			Worksheet:  cache.Worksheet,
+			Statement:  cache.Statement,
+			CreateTime: timestamppb.New(cache.CreatedTime),
+		})
+	}
+	return response, nil
+}
+
+// CompareQueryResults compares two cached results of the same query by the key columns.
+func (s *SQLService) CompareQueryResults(ctx context.Context, request *v1pb.CompareQueryResultsRequest) (*v1pb.CompareQueryResultsResponse, error) {
+	user, err := s.getUser(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(request.KeyColumns) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "key columns are required")
+	}
+	baseStatement, baseResult, err := s.getCachedQueryResult(ctx, user, request.Parent, request.Base, int(request.ResultIndex))
+	if err != nil {
+		return nil, err
+	}
+	targetStatement, targetResult, err := s.getCachedQueryResult(ctx, user, request.Parent, request.Target, int(request.ResultIndex))
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(baseStatement) != strings.TrimSpace(targetStatement) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot compare the results of different queries")
+	}
+	response, err := diffQueryResults(baseResult, targetResult, request.KeyColumns)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return response, nil
+}
+
+// getCachedQueryResult gets the statement and the result at the index of the cached query result of the user.
+func (s *SQLService) getCachedQueryResult(ctx context.Context, user *store.UserMessage, parent, name string, index int) (string, *v1pb.QueryResult, error) {
+	instanceID, databaseName, uid, err := common.GetInstanceDatabaseIDQueryResultUID(name)
+	if err != nil {
+		return "", nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	database := common.FormatDatabase(instanceID, databaseName)
+	if database != parent {
+		return "", nil, status.Errorf(codes.InvalidArgument, "query result %q is not in %q", name, parent)
+	}
+	cache, err := s.store.GetQueryResultCache(ctx, &store.FindQueryResultCacheMessage{
+		UID:           &uid,
+		CreatorUID:    &user.ID,
+		Database:      &database,
+		IncludeResult: true,
+	})
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to get cached query result: %v", err)
+	}
+	if cache == nil {
+		return "", nil, status.Errorf(codes.NotFound, "query result %q not found", name)
+	}
+	data, err := common.Decrypt(cache.Result, s.secret)
+	if err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to decrypt cached query result: %v", err)
+	}
+	response := &v1pb.QueryResponse{}
+	if err := proto.Unmarshal(data, response); err != nil {
+		return "", nil, status.Errorf(codes.Internal, "failed to unmarshal cached query result: %v", err)
+	}
+	if index < 0 || index >= len(response.Results) {
+		return "", nil, status.Errorf(codes.InvalidArgument, "result index %d is out of range, query result %q has %d results", index, name, len(response.Results))
+	}
+	result := response.Results[index]
+	if result.Error != "" {
+		return "", nil, status.Errorf(codes.InvalidArgument, "query result %q failed with error: %s", name, result.Error)
+	}
+	return cache.Statement, result, nil
+}
+
+// diffQueryResults compares the rows of the base and target results matched by the values of the key columns.
+func diffQueryResults(base, target *v1pb.QueryResult, keyColumns []string) (*v1pb.CompareQueryResultsResponse, error) {
+	if !slices.Equal(base.ColumnNames, target.ColumnNames) {
+		return nil, errors.Errorf("the columns of the results are different, %v and %v", base.ColumnNames, target.ColumnNames)
+	}
+	var keyIndexes []int
+	for _, keyColumn := range keyColumns {
+		index := -1
+		for i, column := range base.ColumnNames {
+			if column != keyColumn {
+				continue
+			}
+			if index >= 0 {
+				return nil, errors.Errorf("key column %q is ambiguous", keyColumn)
+			}
+			index = i
+		}
+		if index < 0 {
+			return nil, errors.Errorf("key column %q not found", keyColumn)
+		}
+		// The masked values cannot identify the rows.
+		if isMaskedColumn(base, index) || isMaskedColumn(target, index) {
+			return nil, errors.Errorf("key column %q is masked", keyColumn)
+		}
+		keyIndexes = append(keyIndexes, index)
+	}
+
+	baseRows, err := getRowsByKey(base, keyIndexes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid base result")
+	}
+	targetRows, err := getRowsByKey(target, keyIndexes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid target result")
+	}
+
+	response := &v1pb.CompareQueryResultsResponse{
+		ColumnNames: base.ColumnNames,
+	}
+	for _, row := range target.Rows {
+		key, err := getRowKey(row, keyIndexes)
+		if err != nil {
+			return nil, err
+		}
+		baseRow, ok := baseRows[key]
+		if !ok {
+			response.AddedRows = append(response.AddedRows, row)
+			continue
+		}
+		var changedColumnIndexes []int32
+		for i := range base.ColumnNames {
+			if !proto.Equal(getRowValue(baseRow, i), getRowValue(row, i)) {
+				changedColumnIndexes = append(changedColumnIndexes, int32(i))
+			}
+		}
+		if len(changedColumnIndexes) == 0 {
+			response.UnchangedRowCount++
+			continue
+		}
+		response.ChangedRows = append(response.ChangedRows, &v1pb.CompareQueryResultsResponse_ChangedRow{
+			BaseRow:              baseRow,
+			TargetRow:            row,
+			ChangedColumnIndexes: changedColumnIndexes,
+		})
+	}
+	for _, row := range base.Rows {
+		key, err := getRowKey(row, keyIndexes)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := targetRows[key]; !ok {
+			response.RemovedRows = append(response.RemovedRows, row)
+		}
+	}
+	return response, nil
+}
+
+func getRowsByKey(result *v1pb.QueryResult, keyIndexes []int) (map[string]*v1pb.QueryRow, error) {
+	rows := make(map[string]*v1pb.QueryRow)
+	for _, row := range result.Rows {
+		key, err := getRowKey(row, keyIndexes)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := rows[key]; ok {
+			return nil, errors.Errorf("duplicate key %v", keyValuesString(row, keyIndexes))
+		}
+		rows[key] = row
+	}
+	return rows, nil
+}
+
+// getRowKey encodes the values of the key columns with the length prefixes.
+func getRowKey(row *v1pb.QueryRow, keyIndexes []int) (string, error) {
+	var buf strings.Builder
+	for _, index := range keyIndexes {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(getRowValue(row, index))
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(&buf, "%d:%s", len(data), data)
+	}
+	return buf.String(), nil
+}
+
+func keyValuesString(row *v1pb.QueryRow, keyIndexes []int) string {
+	var values []string
+	for _, index := range keyIndexes {
+		values = append(values, getRowValue(row, index).String())
+	}
+	return fmt.Sprintf("(%s)", strings.Join(values, ", "))
+}
+
+func getRowValue(row *v1pb.QueryRow, index int) *v1pb.RowValue {
+	if index < len(row.Values) {
+		return row.Values[index]
+	}
+	return &v1pb.RowValue{}
+}
+
+func isMaskedColumn(result *v1pb.QueryResult, index int) bool {
+	return index < len(result.Masked) && result.Masked[index]
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestDiffQueryResults(t *testing.T) {
+	row := func(id int64, name string) *v1pb.QueryRow {
+		return &v1pb.QueryRow{Values: []*v1pb.RowValue{
+			{Kind: &v1pb.RowValue_Int64Value{Int64Value: id}},
+			{Kind: &v1pb.RowValue_StringValue{StringValue: name}},
+		}}
+	}
+	base := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Rows:        []*v1pb.QueryRow{row(1, "a"), row(2, "b"), row(3, "c")},
+	}
+	target := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Rows:        []*v1pb.QueryRow{row(1, "a"), row(3, "cc"), row(4, "d")},
+	}
+
+	a := require.New(t)
+	diff, err := diffQueryResults(base, target, []string{"id"})
+	a.NoError(err)
+	want := &v1pb.CompareQueryResultsResponse{
+		ColumnNames: []string{"id", "name"},
+		AddedRows:   []*v1pb.QueryRow{row(4, "d")},
+		RemovedRows: []*v1pb.QueryRow{row(2, "b")},
+		ChangedRows: []*v1pb.CompareQueryResultsResponse_ChangedRow{
+			{BaseRow: row(3, "c"), TargetRow: row(3, "cc"), ChangedColumnIndexes: []int32{1}},
+		},
+		UnchangedRowCount: 1,
+	}
+	a.Empty(cmp.Diff(want, diff, protocmp.Transform()))
+
+	// The rows are identified by all the key columns.
+	diff, err = diffQueryResults(base, target, []string{"id", "name"})
+	a.NoError(err)
+	a.Len(diff.AddedRows, 2)
+	a.Len(diff.RemovedRows, 2)
+	a.Empty(diff.ChangedRows)
+
+	_, err = diffQueryResults(base, target, []string{"email"})
+	a.ErrorContains(err, "not found")
+
+	masked := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Masked:      []bool{false, true},
+		Rows:        target.Rows,
+	}
+	_, err = diffQueryResults(base, masked, []string{"name"})
+	a.ErrorContains(err, "masked")
+
+	duplicate := &v1pb.QueryResult{
+		ColumnNames: []string{"id", "name"},
+		Rows:        []*v1pb.QueryRow{row(1, "a"), row(1, "b")},
+	}
+	_, err = diffQueryResults(base, duplicate, []string{"id"})
+	a.ErrorContains(err, "duplicate key")
+
+	other := &v1pb.QueryResult{ColumnNames: []string{"id"}}
+	_, err = diffQueryResults(base, other, []string{"id"})
+	a.ErrorContains(err, "columns")
+}
@@ -0,0 +1,323 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// queryStreamIdleTimeout is how long a query cursor is kept without fetching.
+	// The connection of the cursor is released after the timeout.
+	queryStreamIdleTimeout       = 5 * time.Minute
+	defaultQueryStreamPageSize   = 1000
+	maxQueryStreamPageSize       = 10000
+	defaultQueryStreamBatchSize  = 100
+	maxQueryStreamCursorsPerUser = 5
+)
+
+// queryStreamSession is the live query cursor with its connection.
+type queryStreamSession struct {
+	// mu serializes the fetches of the session.
+	mu sync.Mutex
+
+	id       string
+	userID   int
+	database string
+	driver   db.Driver
+	conn     *sql.Conn
+	cursor   db.QueryCursor
+	// maskers are applied to every batch if the sensitive data feature is enabled.
+	maskers []masker.Masker
+	// cancel cancels the context of the cursor.
+	cancel    context.CancelFunc
+	idleTimer *time.Timer
+}
+
+// close releases the cursor, the connection and the driver of the session.
+func (s *queryStreamSession) close() {
+	if s.idleTimer != nil {
+		s.idleTimer.Stop()
+	}
+	if err := s.cursor.Close(); err != nil {
+		slog.Debug("failed to close query cursor", log.BBError(err))
+	}
+	s.cancel()
+	if err := s.conn.Close(); err != nil {
+		slog.Debug("failed to close query connection", log.BBError(err))
+	}
+	s.driver.Close(context.Background())
+}
+
+// queryStreamSessions are the live query cursors keyed by the cursor.
+type queryStreamSessions struct {
+	sync.Mutex
+	sessions map[string]*queryStreamSession
+}
+
+func newQueryStreamSessions() *queryStreamSessions {
+	return &queryStreamSessions{sessions: make(map[string]*queryStreamSession)}
+}
+
+func (m *queryStreamSessions) add(session *queryStreamSession) error {
+	m.Lock()
+	defer m.Unlock()
+	count := 0
+	for _, s := range m.sessions {
+		if s.userID == session.userID {
+			count++
+		}
+	}
+	if count >= maxQueryStreamCursorsPerUser {
+		return status.Errorf(codes.ResourceExhausted, "too many open query cursors, fetch them to the end or wait for them to expire")
+	}
+	m.sessions[session.id] = session
+	session.idleTimer = time.AfterFunc(queryStreamIdleTimeout, func() {
+		m.remove(session.id)
+	})
+	return nil
+}
+
+func (m *queryStreamSessions) get(id string, userID int, database string) *queryStreamSession {
+	m.Lock()
+	defer m.Unlock()
+	session, ok := m.sessions[id]
+	if !ok || session.userID != userID || session.database != database {
+		return nil
+	}
+	return session
+}
+
+// remove closes the session and removes it from the live sessions.
+func (m *queryStreamSessions) remove(id string) {
+	m.Lock()
+	session, ok := m.sessions[id]
+	delete(m.sessions, id)
+	m.Unlock()
+	if !ok {
+		return
+	}
+	// Wait for the running fetch.
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.close()
+}
+
+// QueryStream streams the result of a single query statement in batches from a live database cursor.
+func (s *SQLService) QueryStream(request *v1pb.QueryStreamRequest, stream v1pb.SQLService_QueryStreamServer) error {
+	ctx := stream.Context()
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultQueryStreamPageSize
+	}
+	if pageSize > maxQueryStreamPageSize {
+		return status.Errorf(codes.InvalidArgument, "page size %d exceeds the maximum %d", pageSize, maxQueryStreamPageSize)
+	}
+	batchSize := int(request.BatchSize)
+	if batchSize <= 0 {
+		batchSize = defaultQueryStreamBatchSize
+	}
+
+	var session *queryStreamSession
+	if request.Cursor != "" {
+		user, err := s.getUser(ctx)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		session = s.queryStreamSessions.get(request.Cursor, user.ID, request.Name)
+		if session == nil {
+			return status.Errorf(codes.NotFound, "query cursor %q not found, it might be fully read or idle for too long", request.Cursor)
+		}
+	} else {
+		var err error
+		session, err = s.openQueryStreamSession(ctx, request)
+		if err != nil {
+			return err
+		}
+	}
+
+	done, err := s.streamQueryPage(stream, session, pageSize, batchSize)
+	if done || err != nil {
+		s.queryStreamSessions.remove(session.id)
+	}
+	return err
+}
+
+// streamQueryPage sends at most pageSize rows of the session in batches.
+// It returns true if all rows have been read.
+func (*SQLService) streamQueryPage(stream v1pb.SQLService_QueryStreamServer, session *queryStreamSession, pageSize, batchSize int) (bool, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	// The idle timer is reset after the page.
+	session.idleTimer.Stop()
+	defer session.idleTimer.Reset(queryStreamIdleTimeout)
+
+	for sent := 0; ; {
+		result, done, err := session.cursor.Next(min(batchSize, pageSize-sent))
+		if err != nil {
+			return false, status.Errorf(codes.Internal, "failed to read query result: %v", err)
+		}
+		sanitizeResults([]*v1pb.QueryResult{result})
+		if session.maskers != nil {
+			doMaskResult(session.maskers, result)
+		}
+		sent += len(result.Rows)
+		response := &v1pb.QueryStreamResponse{Result: result}
+		if !done {
+			response.Cursor = session.id
+		}
+		if err := stream.Send(response); err != nil {
+			return false, err
+		}
+		if done || sent >= pageSize {
+			return done, nil
+		}
+	}
+}
+
+// openQueryStreamSession runs the statement of the request and keeps its cursor in a new session.
+func (s *SQLService) openQueryStreamSession(ctx context.Context, request *v1pb.QueryStreamRequest) (*queryStreamSession, error) {
+	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	statement := request.Statement
+	// In Redshift datashare, Rewrite query used for parser.
+	if database.DataShare {
+		statement = strings.ReplaceAll(statement, fmt.Sprintf("%s.", database.DatabaseName), "")
+	}
+	if err := validateQueryRequest(instance, statement); err != nil {
+		return nil, err
+	}
+	queryContext := db.QueryContext{Schema: request.GetSchema()}
+
+	spans, err := getQuerySpans(ctx, s.store, instance, database, statement, queryContext.Schema)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
+	}
+	if len(spans) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "streaming query supports a single statement, but got %d", len(spans))
+	}
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		if err := s.accessCheck(ctx, instance, user, spans, false /* isExport */); err != nil {
+			return nil, err
+		}
+	}
+
+	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, database, request.DataSourceId)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	querier, ok := db.UnwrapDriver(driver).(db.CursorQuerier)
+	if !ok || driver.GetDB() == nil {
+		driver.Close(ctx)
+		return nil, status.Errorf(codes.Unimplemented, "streaming query is not supported for engine %s", instance.Engine.String())
+	}
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		driver.Close(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+	}
+
+	// The cursor outlives the request, so it reads with its own context.
+	cursorCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	timeout := defaultTimeout
+	if request.Timeout != nil {
+		timeout = request.Timeout.AsDuration()
+	}
+	timeoutTimer := time.AfterFunc(timeout, cancel)
+	start := time.Now()
+	cursor, queryErr := querier.QueryCursor(cursorCtx, conn, statement, queryContext)
+	timeoutTimer.Stop()
+	if queryErr == nil && cursorCtx.Err() != nil {
+		queryErr = errors.Errorf("timeout reached: %v", timeout)
+	}
+	if err := s.createQueryHistory(ctx, database, store.QueryHistoryTypeQuery, statement, user.ID, time.Since(start), queryErr); err != nil {
+		slog.Error("failed to create query history", log.BBError(err))
+	}
+	if queryErr != nil {
+		if cursor != nil {
+			_ = cursor.Close()
+		}
+		cancel()
+		_ = conn.Close()
+		driver.Close(ctx)
+		return nil, status.Error(codes.InvalidArgument, queryErr.Error())
+	}
+
+	session := &queryStreamSession{
+		id:       uuid.NewString(),
+		userID:   user.ID,
+		database: request.Name,
+		driver:   driver,
+		conn:     conn,
+		cursor:   cursor,
+		cancel:   cancel,
+	}
+	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
+		maskers, err := getQueryMaskers(ctx, s.store, s.schemaSyncer, instance, database, statement, queryContext.Schema, spans[0], storepb.MaskingExceptionPolicy_MaskingException_QUERY)
+		if err != nil {
+			session.close()
+			return nil, err
+		}
+		session.maskers = maskers
+	}
+	if err := s.queryStreamSessions.add(session); err != nil {
+		session.close()
+		return nil, err
+	}
+	return session, nil
+}
+
+// getQueryMaskers returns the maskers of the query for masking the result batches.
+// Like queryRetry, the database schema is synced once if the query span refers to unknown columns.
+func getQueryMaskers(ctx context.Context, stores *store.Store, schemaSyncer *schemasync.Syncer, instance *store.InstanceMessage, database *store.DatabaseMessage, statement, schema string, span *base.QuerySpan, action storepb.MaskingExceptionPolicy_MaskingException_Action) ([]masker.Masker, error) {
+	if span.NotFoundError != nil {
+		syncDatabaseMap := make(map[string]bool)
+		for k := range span.SourceColumns {
+			syncDatabaseMap[k.Database] = true
+		}
+		for accessDatabaseName := range syncDatabaseMap {
+			d, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &accessDatabaseName})
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if err := schemaSyncer.SyncDatabaseSchema(ctx, d, false /* force */); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to sync database schema for database %q: %v", accessDatabaseName, err)
+			}
+		}
+		spans, err := getQuerySpans(ctx, stores, instance, database, statement, schema)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
+		}
+		span = spans[0]
+	}
+	maskers, err := NewQueryResultMasker(stores).GetMaskers(ctx, span, instance, action)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return maskers, nil
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"database/sql"
@@ -12,7 +13,6 @@ import (
 
 	"log/slog"
 
-	"github.com/alexmullins/zip"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,6 +57,12 @@ type SQLService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	// secret is the workspace secret used to encrypt the cached query results.
+	secret string
+
+	queryStreamSessions *queryStreamSessions
+	runningQueries      *runningQueries
+	editorSessions      *editorSessions
 }
 
 // NewSQLService creates a SQLService.
@@ -68,6 +74,7 @@ func NewSQLService(
 	licenseService enterprise.LicenseService,
 	profile *config.Profile,
 	iamManager *iam.Manager,
+	secret string,
 ) *SQLService {
 	return &SQLService{
 		store:          store,
@@ -77,6 +84,11 @@ func NewSQLService(
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		secret:         secret,
+
+		queryStreamSessions: newQueryStreamSessions(),
+		runningQueries:      newRunningQueries(),
+		editorSessions:      newEditorSessions(),
 	}
 }
 
@@ -109,26 +121,46 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 			return err
 		}
 
-		// We only need to get the driver and connection once.
-		if driver == nil {
-			driver, err = s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+		// The statement runs in the connection of the editor session if any.
+		var execDriver db.Driver
+		var execConn *sql.Conn
+		releaseSession := func() {}
+		if request.EditorSession != "" {
+			session, release, err := s.acquireEditorSession(request.EditorSession, user, database, true /* admin */)
 			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+				return err
+			}
+			start := time.Now()
+			execDriver, execConn = session.driver, session.conn
+			releaseSession = func() {
+				// The statement may begin a transaction without the transaction control methods.
+				session.syncTransactionState(ctx, start)
+				release()
 			}
-			sqlDB := driver.GetDB()
-			if sqlDB != nil {
-				conn, err = sqlDB.Conn(ctx)
+		} else {
+			// We only need to get the driver and connection once.
+			if driver == nil {
+				driver, err = s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
 				if err != nil {
-					return status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+					return status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+				}
+				sqlDB := driver.GetDB()
+				if sqlDB != nil {
+					conn, err = sqlDB.Conn(ctx)
+					if err != nil {
+						return status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+					}
 				}
 			}
+			execDriver, execConn = driver, conn
 		}
 
 		queryContext := db.QueryContext{}
 		if request.Schema != nil {
 			queryContext.Schema = *request.Schema
 		}
-		result, duration, queryErr := executeWithTimeout(ctx, driver, conn, request.Statement, request.Timeout, queryContext)
+		result, duration, queryErr := executeWithTimeout(ctx, execDriver, execConn, request.Statement, request.Timeout, queryContext)
+		releaseSession()
 
 		if err := s.createQueryHistory(ctx, database, store.QueryHistoryTypeQuery, request.Statement, user.ID, duration, queryErr); err != nil {
 			slog.Error("failed to post admin execute activity", log.BBError(err))
@@ -216,25 +248,52 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 			return nil, err
 		}
 	}
-
-	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, database, request.DataSourceId)
-	if err != nil {
-		return nil, err
-	}
-	driver, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	if request.Worksheet != "" {
+		if _, err := common.GetWorksheetUID(request.Worksheet); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid worksheet %q: %v", request.Worksheet, err)
+		}
 	}
-	defer driver.Close(ctx)
 
-	sqlDB := driver.GetDB()
+	var driver db.Driver
 	var conn *sql.Conn
-	if sqlDB != nil {
-		conn, err = sqlDB.Conn(ctx)
+	if request.EditorSession != "" {
+		// The statement runs in the connection of the editor session.
+		session, release, err := s.acquireEditorSession(request.EditorSession, user, database, false /* admin */)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+			return nil, err
 		}
-		defer conn.Close()
+		defer release()
+		driver, conn = session.driver, session.conn
+	} else {
+		dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, database, request.DataSourceId)
+		if err != nil {
+			return nil, err
+		}
+		driver, err = s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+		}
+		defer driver.Close(ctx)
+
+		sqlDB := driver.GetDB()
+		if sqlDB != nil {
+			conn, err = sqlDB.Conn(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get database connection: %v", err)
+			}
+			defer conn.Close()
+		}
+	}
+
+	if request.QueryId != "" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		unregister, err := s.registerRunningQuery(ctx, request.QueryId, user, request.Name, driver, conn, cancel)
+		if err != nil {
+			return nil, err
+		}
+		defer unregister()
 	}
 
 	queryContext := db.QueryContext{Explain: request.Explain, Limit: int(request.Limit)}
@@ -266,6 +325,14 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		Results:     results,
 		AllowExport: allowExport,
 	}
+	// The results are cached after masking.
+	if request.CacheResult && !request.Explain {
+		queryResult, err := s.cacheQueryResults(ctx, user, database, request, results)
+		if err != nil {
+			slog.Error("failed to cache query results", log.BBError(err))
+		}
+		response.QueryResult = queryResult
+	}
 
 	return response, nil
 }
@@ -291,20 +358,7 @@ func queryRetry(
 	var spans []*base.QuerySpan
 	var err error
 	if !queryContext.Explain {
-		spans, err = base.GetQuerySpan(
-			ctx,
-			base.GetQuerySpanContext{
-				InstanceID:                    instance.ResourceID,
-				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
-				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
-				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Engine),
-			},
-			instance.Engine,
-			statement,
-			database.DatabaseName,
-			queryContext.Schema,
-			store.IgnoreDatabaseAndTableCaseSensitive(instance),
-		)
+		spans, err = getQuerySpans(ctx, stores, instance, database, statement, queryContext.Schema)
 		if err != nil {
 			return nil, nil, time.Duration(0), status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
 		}
@@ -347,20 +401,7 @@ func queryRetry(
 
 	// Retry getting query span.
 	if len(syncDatabaseMap) > 0 {
-		spans, err = base.GetQuerySpan(
-			ctx,
-			base.GetQuerySpanContext{
-				InstanceID:                    instance.ResourceID,
-				GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
-				ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
-				GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Engine),
-			},
-			instance.Engine,
-			statement,
-			database.DatabaseName,
-			queryContext.Schema,
-			store.IgnoreDatabaseAndTableCaseSensitive(instance),
-		)
+		spans, err = getQuerySpans(ctx, stores, instance, database, statement, queryContext.Schema)
 		if err != nil {
 			return nil, nil, duration, status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
 		}
@@ -381,6 +422,23 @@ func queryRetry(
 	return results, spans, duration, nil
 }
 
+func getQuerySpans(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, statement, schema string) ([]*base.QuerySpan, error) {
+	return base.GetQuerySpan(
+		ctx,
+		base.GetQuerySpanContext{
+			InstanceID:                    instance.ResourceID,
+			GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(stores),
+			ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(stores),
+			GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(stores, instance.Engine),
+		},
+		instance.Engine,
+		statement,
+		database.DatabaseName,
+		schema,
+		store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	)
+}
+
 func executeWithTimeout(ctx context.Context, driver db.Driver, conn *sql.Conn, statement string, timeout *durationpb.Duration, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
 	ctxTimeout := defaultTimeout
 	if timeout != nil {
@@ -393,6 +451,9 @@ func executeWithTimeout(ctx context.Context, driver db.Driver, conn *sql.Conn, s
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return nil, time.Since(start), errors.Errorf("query canceled")
+		}
 		return nil, time.Since(start), errors.Errorf("timeout reached: %v", timeout)
 	default:
 		// So the select will not block
@@ -403,14 +464,37 @@ func executeWithTimeout(ctx context.Context, driver db.Driver, conn *sql.Conn, s
 
 // Export exports the SQL query result.
 func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*v1pb.ExportResponse, error) {
+	var buf bytes.Buffer
+	if err := s.export(ctx, request, &buf); err != nil {
+		return nil, err
+	}
+	return &v1pb.ExportResponse{
+		Content: buf.Bytes(),
+	}, nil
+}
+
+// ExportStream streams the export file content in chunks.
+func (s *SQLService) ExportStream(request *v1pb.ExportRequest, stream v1pb.SQLService_ExportStreamServer) error {
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportStreamChunkSize)
+	if err := s.export(stream.Context(), request, w); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to send export content: %v", err)
+	}
+	return nil
+}
+
+// export writes the export file of the request to w.
+func (s *SQLService) export(ctx context.Context, request *v1pb.ExportRequest, w io.Writer) error {
 	// Prehandle export from issue.
 	if strings.HasPrefix(request.Name, common.ProjectNamePrefix) {
-		return s.doExportFromIssue(ctx, request.Name)
+		return s.doExportFromIssue(ctx, request.Name, w)
 	}
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	statement := request.Statement
@@ -421,84 +505,83 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 
 	// Validate the request.
 	if err := validateQueryRequest(instance, statement); err != nil {
-		return nil, err
+		return err
 	}
 
-	bytes, duration, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer)
+	duration, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, w)
 
 	if err := s.createQueryHistory(ctx, database, store.QueryHistoryTypeExport, statement, user.ID, duration, exportErr); err != nil {
-		return nil, err
+		return err
 	}
 
 	if exportErr != nil {
-		return nil, status.Error(codes.Internal, exportErr.Error())
+		return status.Error(codes.Internal, exportErr.Error())
 	}
-
-	return &v1pb.ExportResponse{
-		Content: bytes,
-	}, nil
+	return nil
 }
 
-func (s *SQLService) doExportFromIssue(ctx context.Context, issueName string) (*v1pb.ExportResponse, error) {
+func (s *SQLService) doExportFromIssue(ctx context.Context, issueName string, w io.Writer) error {
 	issueUID, err := common.GetIssueID(issueName)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to get issue ID: %v", err)
+		return status.Errorf(codes.InvalidArgument, "failed to get issue ID: %v", err)
 	}
 	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
+		return status.Errorf(codes.Internal, "failed to get issue: %v", err)
 	}
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
 	if !ok {
-		return nil, status.Errorf(codes.Internal, "user not found")
+		return status.Errorf(codes.Internal, "user not found")
 	}
 	if user.ID != issue.Creator.ID {
-		return nil, status.Errorf(codes.PermissionDenied, "only the issue creator can download")
+		return status.Errorf(codes.PermissionDenied, "only the issue creator can download")
 	}
 	if issue.PipelineUID == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "issue %s has no pipeline", issueName)
+		return status.Errorf(codes.InvalidArgument, "issue %s has no pipeline", issueName)
 	}
 	rollout, err := s.store.GetRollout(ctx, *issue.PipelineUID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get rollout: %v", err)
+		return status.Errorf(codes.Internal, "failed to get rollout: %v", err)
 	}
 	tasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: &rollout.ID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get tasks: %v", err)
+		return status.Errorf(codes.Internal, "failed to get tasks: %v", err)
 	}
 	if len(tasks) != 1 {
-		return nil, status.Errorf(codes.InvalidArgument, "issue %s has unmatched tasks", issueName)
+		return status.Errorf(codes.InvalidArgument, "issue %s has unmatched tasks", issueName)
 	}
 	task := tasks[0]
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{TaskUID: &task.ID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get task run: %v", err)
+		return status.Errorf(codes.Internal, "failed to get task run: %v", err)
 	}
 	if len(taskRuns) == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "issue %s has no task run", issueName)
+		return status.Errorf(codes.InvalidArgument, "issue %s has no task run", issueName)
 	}
 	taskRun := taskRuns[len(taskRuns)-1]
 	exportArchiveUID := int(taskRun.ResultProto.ExportArchiveUid)
 	if exportArchiveUID == 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "issue %s has no export archive", issueName)
+		return status.Errorf(codes.InvalidArgument, "issue %s has no export archive", issueName)
 	}
 	exportArchive, err := s.store.GetExportArchive(ctx, &store.FindExportArchiveMessage{UID: &exportArchiveUID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get export archive: %v", err)
+		return status.Errorf(codes.Internal, "failed to get export archive: %v", err)
 	}
 	if exportArchive == nil {
-		return nil, status.Errorf(codes.NotFound, "export archive %d not found", exportArchiveUID)
+		return status.Errorf(codes.NotFound, "export archive %d not found", exportArchiveUID)
+	}
+	if err := writeExportArchive(ctx, s.store, exportArchive, w); err != nil {
+		return status.Errorf(codes.Internal, "failed to read export archive: %v", err)
 	}
 	// Delete the export archive after it's fetched.
 	if err := s.store.DeleteExportArchive(ctx, exportArchiveUID); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete export archive: %v", err)
+		return status.Errorf(codes.Internal, "failed to delete export archive: %v", err)
 	}
-	return &v1pb.ExportResponse{
-		Content: exportArchive.Bytes,
-	}, nil
+	return nil
 }
 
-// DoExport does the export.
+// DoExport exports the result of each statement to w.
+// The results are read and encoded in batches, and the files are zipped if there are multiple statements or the password is set.
 func DoExport(
 	ctx context.Context,
 	storeInstance *store.Store,
@@ -510,14 +593,15 @@ func DoExport(
 	database *store.DatabaseMessage,
 	optionalAccessCheck accessCheckFunc,
 	schemaSyncer *schemasync.Syncer,
-) ([]byte, time.Duration, error) {
+	w io.Writer,
+) (time.Duration, error) {
 	dataSource, err := checkAndGetDataSourceQueriable(ctx, storeInstance, database, request.DataSourceId)
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
 	driver, err := dbFactory.GetDataSourceDriver(ctx, instance, dataSource, database.DatabaseName, database.DataShare, true /* readOnly */, db.ConnectionContext{})
 	if err != nil {
-		return nil, 0, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+		return 0, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
 	}
 	defer driver.Close(ctx)
 
@@ -526,100 +610,28 @@ func DoExport(
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		defer conn.Close()
 	}
-	results, spans, duration, queryErr := queryRetry(ctx, storeInstance, user, instance, database, driver, conn, request.Statement, nil /* timeDuration */, db.QueryContext{Limit: int(request.Limit)}, true, licenseService, optionalAccessCheck, schemaSyncer)
-	if queryErr != nil {
-		return nil, duration, err
-	}
-	// only return the last result
-	if len(results) > 1 {
-		results = results[len(results)-1:]
-	}
-	if results[0].GetError() != "" {
-		return nil, duration, errors.New(results[0].GetError())
-	}
-
-	if licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil {
-		masker := NewQueryResultMasker(storeInstance)
-		if err := masker.MaskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_EXPORT); err != nil {
-			return nil, duration, err
-		}
-	}
-
-	result := results[0]
-	var content []byte
-	switch request.Format {
-	case v1pb.ExportFormat_CSV:
-		content, err = exportCSV(result)
-		if err != nil {
-			return nil, duration, err
-		}
-	case v1pb.ExportFormat_JSON:
-		content, err = exportJSON(result)
-		if err != nil {
-			return nil, duration, err
-		}
-	case v1pb.ExportFormat_SQL:
-		resourceList, err := extractResourceList(ctx, storeInstance, instance.Engine, database.DatabaseName, request.Statement, instance)
-		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
-		}
-		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result.ColumnNames)
-		if err != nil {
-			return nil, 0, err
-		}
-		content, err = exportSQL(instance.Engine, statementPrefix, result)
-		if err != nil {
-			return nil, duration, err
-		}
-	case v1pb.ExportFormat_XLSX:
-		content, err = exportXLSX(result)
-		if err != nil {
-			return nil, duration, err
-		}
-	default:
-		return nil, duration, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
-	}
 
-	encryptedBytes, err := doEncrypt(content, request)
-	if err != nil {
-		return nil, 0, errors.Wrap(err, "failed to encrypt data")
-	}
-	return encryptedBytes, duration, nil
-}
-
-func doEncrypt(data []byte, request *v1pb.ExportRequest) ([]byte, error) {
-	if request.Password == "" {
-		return data, nil
-	}
-	var b bytes.Buffer
-	fzip := io.Writer(&b)
-
-	zipw := zip.NewWriter(fzip)
-	defer zipw.Close()
-
-	fh := &zip.FileHeader{
-		Name:   fmt.Sprintf("export.%s", strings.ToLower(request.Format.String())),
-		Method: zip.Deflate,
+	exporter := &dataExporter{
+		stores:              storeInstance,
+		licenseService:      licenseService,
+		schemaSyncer:        schemaSyncer,
+		optionalAccessCheck: optionalAccessCheck,
+		user:                user,
+		instance:            instance,
+		database:            database,
+		driver:              driver,
+		conn:                conn,
+		request:             request,
 	}
-	fh.ModifiedDate, fh.ModifiedTime = timeToMsDosTime(time.Now())
-	fh.SetPassword(request.Password)
-	writer, err := zipw.CreateHeader(fh)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create encrypt export file")
-	}
-
-	if _, err := io.Copy(writer, bytes.NewReader(data)); err != nil {
-		return nil, errors.Wrapf(err, "failed to write export file")
-	}
-	if err := zipw.Close(); err != nil {
-		return nil, errors.Wrap(err, "failed to close zip writer")
+	start := time.Now()
+	if err := exporter.export(ctx, w); err != nil {
+		return time.Since(start), err
 	}
-
-	return b.Bytes(), nil
+	return time.Since(start), nil
 }
 
 // timeToMsDosTime converts a time.Time to an MS-DOS date and time.
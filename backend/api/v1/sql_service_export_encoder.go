@@ -0,0 +1,489 @@
+package v1
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/compress"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// excelMaxRow is the maximum number of rows in a worksheet, including the header row.
+const excelMaxRow = 1048576
+
+// exportEncoder encodes the query result of a statement into an export file batch by batch.
+// The column names of all batches are the same.
+type exportEncoder interface {
+	// Encode writes the rows of the batch.
+	Encode(result *v1pb.QueryResult) error
+	// Close writes the end of the file. It doesn't close the underlying writer.
+	Close() error
+}
+
+// newExportEncoder returns the encoder of the format writing to w.
+// The resourceList is used to build the INSERT statements for the SQL format.
+func newExportEncoder(w io.Writer, format v1pb.ExportFormat, engine storepb.Engine, resourceList []base.SchemaResource) (exportEncoder, error) {
+	switch format {
+	case v1pb.ExportFormat_CSV:
+		return &csvExportEncoder{w: w}, nil
+	case v1pb.ExportFormat_JSON:
+		return &jsonExportEncoder{w: w}, nil
+	case v1pb.ExportFormat_NDJSON:
+		return &ndjsonExportEncoder{w: w}, nil
+	case v1pb.ExportFormat_SQL:
+		return &sqlExportEncoder{w: w, engine: engine, resourceList: resourceList}, nil
+	case v1pb.ExportFormat_XLSX:
+		return &xlsxExportEncoder{w: w}, nil
+	case v1pb.ExportFormat_PARQUET:
+		return &parquetExportEncoder{w: w}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", format.String())
+	}
+}
+
+type csvExportEncoder struct {
+	w             io.Writer
+	buf           bytes.Buffer
+	headerWritten bool
+}
+
+func (e *csvExportEncoder) Encode(result *v1pb.QueryResult) error {
+	if !e.headerWritten {
+		e.headerWritten = true
+		if _, err := io.WriteString(e.w, strings.Join(result.ColumnNames, ",")); err != nil {
+			return err
+		}
+	}
+	for _, row := range result.Rows {
+		e.buf.Reset()
+		e.buf.WriteByte('\n')
+		for i, value := range row.Values {
+			if i != 0 {
+				e.buf.WriteByte(',')
+			}
+			e.buf.Write(convertValueToBytesInCSV(value))
+		}
+		if _, err := e.w.Write(e.buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*csvExportEncoder) Close() error {
+	return nil
+}
+
+type jsonExportEncoder struct {
+	w    io.Writer
+	buf  bytes.Buffer
+	rows int
+}
+
+func (e *jsonExportEncoder) Encode(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		e.buf.Reset()
+		if e.rows == 0 {
+			e.buf.WriteByte('[')
+		} else {
+			e.buf.WriteByte(',')
+		}
+		writeJSONObject(&e.buf, result.ColumnNames, row)
+		if _, err := e.w.Write(e.buf.Bytes()); err != nil {
+			return err
+		}
+		e.rows++
+	}
+	return nil
+}
+
+func (e *jsonExportEncoder) Close() error {
+	end := "]"
+	if e.rows == 0 {
+		end = "[]"
+	}
+	_, err := io.WriteString(e.w, end)
+	return err
+}
+
+type ndjsonExportEncoder struct {
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+func (e *ndjsonExportEncoder) Encode(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		e.buf.Reset()
+		writeJSONObject(&e.buf, result.ColumnNames, row)
+		e.buf.WriteByte('\n')
+		if _, err := e.w.Write(e.buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*ndjsonExportEncoder) Close() error {
+	return nil
+}
+
+func writeJSONObject(buf *bytes.Buffer, columnNames []string, row *v1pb.QueryRow) {
+	buf.WriteByte('{')
+	for i, value := range row.Values {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`"` + escapeJSONString(columnNames[i]) + `":`)
+		buf.WriteString(convertValueToStringInJSON(value))
+	}
+	buf.WriteByte('}')
+}
+
+type sqlExportEncoder struct {
+	w            io.Writer
+	buf          bytes.Buffer
+	engine       storepb.Engine
+	resourceList []base.SchemaResource
+	// statementPrefix is built from the columns of the first batch if it's empty.
+	statementPrefix string
+	rows            int
+}
+
+func (e *sqlExportEncoder) Encode(result *v1pb.QueryResult) error {
+	if e.statementPrefix == "" {
+		prefix, err := getSQLStatementPrefix(e.engine, e.resourceList, result.ColumnNames)
+		if err != nil {
+			return err
+		}
+		e.statementPrefix = prefix
+	}
+	for _, row := range result.Rows {
+		e.buf.Reset()
+		if e.rows != 0 {
+			e.buf.WriteString("\n")
+		}
+		e.buf.WriteString(e.statementPrefix)
+		for i, value := range row.Values {
+			if i != 0 {
+				e.buf.WriteByte(',')
+			}
+			e.buf.Write(convertValueToBytesInSQL(e.engine, value))
+		}
+		e.buf.WriteString(");")
+		if _, err := e.w.Write(e.buf.Bytes()); err != nil {
+			return err
+		}
+		e.rows++
+	}
+	return nil
+}
+
+func (*sqlExportEncoder) Close() error {
+	return nil
+}
+
+// xlsxExportEncoder writes the rows with the stream writer of excelize, which flushes the rows to a temporary file.
+type xlsxExportEncoder struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rows   int
+}
+
+func (e *xlsxExportEncoder) Encode(result *v1pb.QueryResult) error {
+	if e.file == nil {
+		if len(result.ColumnNames) > excelMaxColumn {
+			return errors.Errorf("the number of columns %d exceeds the maximum %d of XLSX", len(result.ColumnNames), excelMaxColumn)
+		}
+		e.file = excelize.NewFile()
+		stream, err := e.file.NewStreamWriter(sheet1Name)
+		if err != nil {
+			return err
+		}
+		e.stream = stream
+		if err := e.writeRow(toAnySlice(result.ColumnNames)); err != nil {
+			return err
+		}
+	}
+	for _, row := range result.Rows {
+		values := make([]any, 0, len(row.Values))
+		for _, value := range row.Values {
+			values = append(values, convertValueToStringInXLSX(value))
+		}
+		if err := e.writeRow(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *xlsxExportEncoder) writeRow(values []any) error {
+	if e.rows >= excelMaxRow {
+		return errors.Errorf("the number of rows exceeds the maximum %d of XLSX", excelMaxRow-1)
+	}
+	e.rows++
+	cell, err := excelize.CoordinatesToCellName(1, e.rows)
+	if err != nil {
+		return err
+	}
+	return e.stream.SetRow(cell, values)
+}
+
+func (e *xlsxExportEncoder) Close() error {
+	if e.file == nil {
+		return nil
+	}
+	defer e.file.Close()
+	if err := e.stream.Flush(); err != nil {
+		return err
+	}
+	_, err := e.file.WriteTo(e.w)
+	return err
+}
+
+func toAnySlice(values []string) []any {
+	var result []any
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
+
+// parquetExportEncoder writes a row group for each batch.
+// The column types are inferred from the first non-null values of the first batch,
+// or from the column type names if all values of the column are null in the first batch.
+type parquetExportEncoder struct {
+	w       io.Writer
+	writer  *pqarrow.FileWriter
+	builder *array.RecordBuilder
+}
+
+func (e *parquetExportEncoder) Encode(result *v1pb.QueryResult) error {
+	if e.writer == nil {
+		schema := getParquetSchema(result)
+		writer, err := pqarrow.NewFileWriter(
+			schema,
+			e.w,
+			parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)),
+			pqarrow.DefaultWriterProps(),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create parquet writer")
+		}
+		e.writer = writer
+		e.builder = array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	}
+	if len(result.Rows) == 0 {
+		return nil
+	}
+
+	for _, row := range result.Rows {
+		for i, value := range row.Values {
+			if i >= len(e.builder.Fields()) {
+				break
+			}
+			if err := appendParquetValue(e.builder.Field(i), value); err != nil {
+				return errors.Wrapf(err, "failed to write column %q", result.ColumnNames[i])
+			}
+		}
+	}
+	record := e.builder.NewRecord()
+	defer record.Release()
+	return e.writer.Write(record)
+}
+
+func (e *parquetExportEncoder) Close() error {
+	if e.writer == nil {
+		return nil
+	}
+	defer e.builder.Release()
+	return e.writer.Close()
+}
+
+func getParquetSchema(result *v1pb.QueryResult) *arrow.Schema {
+	var fields []arrow.Field
+	for i, columnName := range result.ColumnNames {
+		var dataType arrow.DataType
+		for _, row := range result.Rows {
+			if i >= len(row.Values) {
+				continue
+			}
+			if t := getParquetDataType(row.Values[i]); t != nil {
+				dataType = t
+				break
+			}
+		}
+		if dataType == nil {
+			var columnTypeName string
+			if i < len(result.ColumnTypeNames) {
+				columnTypeName = result.ColumnTypeNames[i]
+			}
+			dataType = getParquetDataTypeByName(columnTypeName)
+		}
+		fields = append(fields, arrow.Field{Name: columnName, Type: dataType, Nullable: true})
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// getParquetDataType returns nil for the null value.
+func getParquetDataType(value *v1pb.RowValue) arrow.DataType {
+	switch value.GetKind().(type) {
+	case *v1pb.RowValue_Int32Value, *v1pb.RowValue_Int64Value, *v1pb.RowValue_Uint32Value:
+		return arrow.PrimitiveTypes.Int64
+	case *v1pb.RowValue_Uint64Value:
+		return arrow.PrimitiveTypes.Uint64
+	case *v1pb.RowValue_FloatValue, *v1pb.RowValue_DoubleValue:
+		return arrow.PrimitiveTypes.Float64
+	case *v1pb.RowValue_BoolValue:
+		return arrow.FixedWidthTypes.Boolean
+	case *v1pb.RowValue_BytesValue:
+		return arrow.BinaryTypes.Binary
+	case *v1pb.RowValue_StringValue, *v1pb.RowValue_ValueValue:
+		return arrow.BinaryTypes.String
+	default:
+		return nil
+	}
+}
+
+// getParquetDataTypeByName returns the type of the column type name reported by the driver.
+// The unknown types, e.g. the decimals which are returned as strings, fall back to string.
+func getParquetDataTypeByName(columnTypeName string) arrow.DataType {
+	switch strings.ToUpper(columnTypeName) {
+	case "INT", "INTEGER", "INT2", "INT4", "INT8", "TINYINT", "SMALLINT", "MEDIUMINT", "BIGINT", "SERIAL", "BIGSERIAL",
+		"UNSIGNED TINYINT", "UNSIGNED SMALLINT", "UNSIGNED MEDIUMINT", "UNSIGNED INT":
+		return arrow.PrimitiveTypes.Int64
+	case "UNSIGNED BIGINT":
+		return arrow.PrimitiveTypes.Uint64
+	case "FLOAT", "FLOAT4", "FLOAT8", "REAL", "DOUBLE", "DOUBLE PRECISION":
+		return arrow.PrimitiveTypes.Float64
+	case "BOOL", "BOOLEAN":
+		return arrow.FixedWidthTypes.Boolean
+	case "BYTEA", "BINARY", "VARBINARY", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB":
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// appendParquetValue appends the value to the column builder.
+// The value of a different kind is converted to the column type if possible, since the drivers may return
+// the values of a column in different kinds, e.g. the integers as strings.
+func appendParquetValue(builder array.Builder, value *v1pb.RowValue) error {
+	if getParquetDataType(value) == nil {
+		builder.AppendNull()
+		return nil
+	}
+	switch b := builder.(type) {
+	case *array.StringBuilder:
+		b.Append(convertValueToStringInXLSX(value))
+		return nil
+	case *array.Int64Builder:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_Int32Value:
+			b.Append(int64(v.Int32Value))
+			return nil
+		case *v1pb.RowValue_Int64Value:
+			b.Append(v.Int64Value)
+			return nil
+		case *v1pb.RowValue_Uint32Value:
+			b.Append(int64(v.Uint32Value))
+			return nil
+		case *v1pb.RowValue_Uint64Value:
+			if v.Uint64Value <= math.MaxInt64 {
+				b.Append(int64(v.Uint64Value))
+				return nil
+			}
+		case *v1pb.RowValue_StringValue:
+			if i, err := strconv.ParseInt(v.StringValue, 10, 64); err == nil {
+				b.Append(i)
+				return nil
+			}
+		}
+	case *array.Uint64Builder:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_Uint64Value:
+			b.Append(v.Uint64Value)
+			return nil
+		case *v1pb.RowValue_Uint32Value:
+			b.Append(uint64(v.Uint32Value))
+			return nil
+		case *v1pb.RowValue_Int32Value:
+			if v.Int32Value >= 0 {
+				b.Append(uint64(v.Int32Value))
+				return nil
+			}
+		case *v1pb.RowValue_Int64Value:
+			if v.Int64Value >= 0 {
+				b.Append(uint64(v.Int64Value))
+				return nil
+			}
+		case *v1pb.RowValue_StringValue:
+			if i, err := strconv.ParseUint(v.StringValue, 10, 64); err == nil {
+				b.Append(i)
+				return nil
+			}
+		}
+	case *array.Float64Builder:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_FloatValue:
+			b.Append(float64(v.FloatValue))
+			return nil
+		case *v1pb.RowValue_DoubleValue:
+			b.Append(v.DoubleValue)
+			return nil
+		case *v1pb.RowValue_Int32Value:
+			b.Append(float64(v.Int32Value))
+			return nil
+		case *v1pb.RowValue_Int64Value:
+			b.Append(float64(v.Int64Value))
+			return nil
+		case *v1pb.RowValue_Uint32Value:
+			b.Append(float64(v.Uint32Value))
+			return nil
+		case *v1pb.RowValue_Uint64Value:
+			b.Append(float64(v.Uint64Value))
+			return nil
+		case *v1pb.RowValue_StringValue:
+			if f, err := strconv.ParseFloat(v.StringValue, 64); err == nil {
+				b.Append(f)
+				return nil
+			}
+		}
+	case *array.BooleanBuilder:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_BoolValue:
+			b.Append(v.BoolValue)
+			return nil
+		case *v1pb.RowValue_StringValue:
+			if t, err := strconv.ParseBool(v.StringValue); err == nil {
+				b.Append(t)
+				return nil
+			}
+		}
+	case *array.BinaryBuilder:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_BytesValue:
+			b.Append(v.BytesValue)
+			return nil
+		case *v1pb.RowValue_StringValue:
+			b.Append([]byte(v.StringValue))
+			return nil
+		}
+	}
+	return errors.Errorf("unexpected value type %T for parquet type %s", value.Kind, builder.Type())
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/alexmullins/zip"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func newTestExportBatches() []*v1pb.QueryResult {
+	columnNames := []string{"id", "name"}
+	return []*v1pb.QueryResult{
+		{
+			ColumnNames: columnNames,
+			Rows: []*v1pb.QueryRow{
+				{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}, {Kind: &v1pb.RowValue_StringValue{StringValue: "Alice"}}}},
+				{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 2}}, {Kind: &v1pb.RowValue_NullValue{}}}},
+			},
+		},
+		{
+			ColumnNames: columnNames,
+			Rows: []*v1pb.QueryRow{
+				{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 3}}, {Kind: &v1pb.RowValue_StringValue{StringValue: "Bob"}}}},
+			},
+		},
+	}
+}
+
+func TestExportEncoder(t *testing.T) {
+	tests := []struct {
+		format v1pb.ExportFormat
+		want   string
+	}{
+		{
+			format: v1pb.ExportFormat_CSV,
+			want:   "id,name\n1,\"Alice\"\n2,\n3,\"Bob\"",
+		},
+		{
+			format: v1pb.ExportFormat_JSON,
+			want:   `[{"id":1,"name":"Alice"},{"id":2,"name":null},{"id":3,"name":"Bob"}]`,
+		},
+		{
+			format: v1pb.ExportFormat_NDJSON,
+			want:   "{\"id\":1,\"name\":\"Alice\"}\n{\"id\":2,\"name\":null}\n{\"id\":3,\"name\":\"Bob\"}\n",
+		},
+		{
+			format: v1pb.ExportFormat_SQL,
+			want:   "INSERT INTO `<table_name>` (`id`,`name`) VALUES (1,'Alice');\nINSERT INTO `<table_name>` (`id`,`name`) VALUES (2,NULL);\nINSERT INTO `<table_name>` (`id`,`name`) VALUES (3,'Bob');",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		var buf bytes.Buffer
+		encoder, err := newExportEncoder(&buf, test.format, storepb.Engine_MYSQL, nil)
+		a.NoError(err)
+		for _, batch := range newTestExportBatches() {
+			a.NoError(encoder.Encode(batch))
+		}
+		a.NoError(encoder.Close())
+		a.Equal(test.want, buf.String(), test.format)
+	}
+}
+
+func TestExportEncoderParquet(t *testing.T) {
+	a := require.New(t)
+	var buf bytes.Buffer
+	encoder, err := newExportEncoder(&buf, v1pb.ExportFormat_PARQUET, storepb.Engine_POSTGRES, nil)
+	a.NoError(err)
+	for _, batch := range newTestExportBatches() {
+		a.NoError(encoder.Encode(batch))
+	}
+	a.NoError(encoder.Close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	a.NoError(err)
+	defer reader.Close()
+	a.Equal(int64(3), reader.NumRows())
+	a.Equal(2, reader.NumRowGroups())
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	table, err := fileReader.ReadTable(context.Background())
+	a.NoError(err)
+	defer table.Release()
+	a.Equal("int64", table.Schema().Field(0).Type.String())
+	a.Equal("utf8", table.Schema().Field(1).Type.String())
+}
+
+func TestExportEncoderParquetNullFirstBatch(t *testing.T) {
+	a := require.New(t)
+	var buf bytes.Buffer
+	encoder, err := newExportEncoder(&buf, v1pb.ExportFormat_PARQUET, storepb.Engine_POSTGRES, nil)
+	a.NoError(err)
+	null := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+	batches := []*v1pb.QueryResult{
+		{
+			ColumnNames:     []string{"id", "score", "note"},
+			ColumnTypeNames: []string{"INT8", "NUMERIC", "TEXT"},
+			Rows:            []*v1pb.QueryRow{{Values: []*v1pb.RowValue{null, null, null}}},
+		},
+		{
+			ColumnNames:     []string{"id", "score", "note"},
+			ColumnTypeNames: []string{"INT8", "NUMERIC", "TEXT"},
+			Rows: []*v1pb.QueryRow{{Values: []*v1pb.RowValue{
+				{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "1.50"}},
+				{Kind: &v1pb.RowValue_StringValue{StringValue: "a"}},
+			}}},
+		},
+	}
+	for _, batch := range batches {
+		a.NoError(encoder.Encode(batch))
+	}
+	a.NoError(encoder.Close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	a.NoError(err)
+	defer reader.Close()
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	a.NoError(err)
+	table, err := fileReader.ReadTable(context.Background())
+	a.NoError(err)
+	defer table.Release()
+	a.Equal(int64(2), table.NumRows())
+	a.Equal("int64", table.Schema().Field(0).Type.String())
+	a.Equal("utf8", table.Schema().Field(1).Type.String())
+	a.Equal("utf8", table.Schema().Field(2).Type.String())
+}
+
+func TestAppendParquetValueConversion(t *testing.T) {
+	a := require.New(t)
+	builder := array.NewInt64Builder(memory.DefaultAllocator)
+	defer builder.Release()
+	a.NoError(appendParquetValue(builder, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "42"}}))
+	a.NoError(appendParquetValue(builder, &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 7}}))
+	a.Error(appendParquetValue(builder, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "x"}}))
+	values := builder.NewInt64Array()
+	defer values.Release()
+	a.Equal([]int64{42, 7}, values.Int64Values())
+}
+
+func TestExportArchive(t *testing.T) {
+	a := require.New(t)
+	var buf bytes.Buffer
+	archive := newExportArchive(&buf, 2, "")
+	for i, content := range []string{"a", "b"} {
+		w, err := archive.create(getExportFileName(v1pb.ExportFormat_CSV, i, 2))
+		a.NoError(err)
+		_, err = io.WriteString(w, content)
+		a.NoError(err)
+	}
+	a.NoError(archive.close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	a.NoError(err)
+	a.Len(reader.File, 2)
+	a.Equal("export-1.csv", reader.File[0].Name)
+	a.Equal("export-2.csv", reader.File[1].Name)
+
+	// A single file without the password is not zipped.
+	buf.Reset()
+	archive = newExportArchive(&buf, 1, "")
+	w, err := archive.create(getExportFileName(v1pb.ExportFormat_CSV, 0, 1))
+	a.NoError(err)
+	_, err = io.WriteString(w, "a")
+	a.NoError(err)
+	a.NoError(archive.close())
+	a.Equal("a", buf.String())
+}
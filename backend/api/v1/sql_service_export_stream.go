@@ -0,0 +1,300 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/alexmullins/zip"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/component/masker"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// exportBatchSize is the number of rows read from the cursor and encoded at a time.
+	exportBatchSize = 10000
+	// exportBufferSize is the buffer size of the export file writer.
+	exportBufferSize = 64 * 1024
+	// exportStreamChunkSize is the max size of the export file content in a response of ExportStream.
+	exportStreamChunkSize = 1024 * 1024
+)
+
+// exportStreamWriter sends each write as the responses of ExportStream.
+type exportStreamWriter struct {
+	stream v1pb.SQLService_ExportStreamServer
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	for written := 0; written < len(p); {
+		n := min(len(p)-written, exportStreamChunkSize)
+		// The content is copied because the stream may hold the message after sending it.
+		if err := w.stream.Send(&v1pb.ExportResponse{Content: bytes.Clone(p[written : written+n])}); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return len(p), nil
+}
+
+// writeExportArchive writes the content of the export archive, which is its bytes followed by its chunks.
+func writeExportArchive(ctx context.Context, stores *store.Store, exportArchive *store.ExportArchiveMessage, w io.Writer) error {
+	if _, err := w.Write(exportArchive.Bytes); err != nil {
+		return err
+	}
+	for seq := 0; ; seq++ {
+		chunk, err := stores.GetExportArchiveChunk(ctx, exportArchive.UID, seq)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return nil
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// exportArchive writes the export files to the output.
+// The files are put in a ZIP archive if there are multiple files or the password is set.
+type exportArchive struct {
+	w        io.Writer
+	zipw     *zip.Writer
+	password string
+}
+
+func newExportArchive(w io.Writer, fileCount int, password string) *exportArchive {
+	a := &exportArchive{w: w, password: password}
+	if fileCount > 1 || password != "" {
+		a.zipw = zip.NewWriter(w)
+	}
+	return a
+}
+
+// create returns the writer of the next file, which is valid until the next call.
+func (a *exportArchive) create(name string) (io.Writer, error) {
+	if a.zipw == nil {
+		return a.w, nil
+	}
+	fh := &zip.FileHeader{
+		Name:   name,
+		Method: zip.Deflate,
+	}
+	fh.ModifiedDate, fh.ModifiedTime = timeToMsDosTime(time.Now())
+	if a.password != "" {
+		fh.SetPassword(a.password)
+	}
+	w, err := a.zipw.CreateHeader(fh)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create export file %q", name)
+	}
+	return w, nil
+}
+
+func (a *exportArchive) close() error {
+	if a.zipw == nil {
+		return nil
+	}
+	if err := a.zipw.Close(); err != nil {
+		return errors.Wrap(err, "failed to close zip writer")
+	}
+	return nil
+}
+
+// getExportFileName returns the name of the export file of the i-th statement.
+func getExportFileName(format v1pb.ExportFormat, i, fileCount int) string {
+	extension := strings.ToLower(format.String())
+	if fileCount == 1 {
+		return fmt.Sprintf("export.%s", extension)
+	}
+	return fmt.Sprintf("export-%d.%s", i+1, extension)
+}
+
+// resultCursor is the query cursor over a materialized query result.
+// It's used for the drivers which cannot read the query result from a live cursor.
+type resultCursor struct {
+	result *v1pb.QueryResult
+	offset int
+}
+
+func (c *resultCursor) Next(batchSize int) (*v1pb.QueryResult, bool, error) {
+	end := min(c.offset+batchSize, len(c.result.Rows))
+	batch := &v1pb.QueryResult{
+		ColumnNames:     c.result.ColumnNames,
+		ColumnTypeNames: c.result.ColumnTypeNames,
+		Rows:            c.result.Rows[c.offset:end],
+	}
+	c.offset = end
+	return batch, c.offset >= len(c.result.Rows), nil
+}
+
+func (*resultCursor) Close() error {
+	return nil
+}
+
+// encodeResult encodes the materialized query result as a single batch.
+func encodeResult(encoder exportEncoder, result *v1pb.QueryResult) error {
+	if err := encoder.Encode(result); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// dataExporter exports the query results of the statements in a connection.
+type dataExporter struct {
+	stores              *store.Store
+	licenseService      enterprise.LicenseService
+	schemaSyncer        *schemasync.Syncer
+	optionalAccessCheck accessCheckFunc
+	user                *store.UserMessage
+	instance            *store.InstanceMessage
+	database            *store.DatabaseMessage
+	driver              db.Driver
+	conn                *sql.Conn
+	request             *v1pb.ExportRequest
+}
+
+// export writes the result of each statement to its own file in the archive.
+func (e *dataExporter) export(ctx context.Context, w io.Writer) error {
+	statements := splitExportStatement(e.instance.Engine, e.request.Statement)
+	if len(statements) == 0 {
+		return status.Errorf(codes.InvalidArgument, "no statement to export")
+	}
+	archive := newExportArchive(w, len(statements), e.request.Password)
+	for i, statement := range statements {
+		fw, err := archive.create(getExportFileName(e.request.Format, i, len(statements)))
+		if err != nil {
+			return err
+		}
+		bw := bufio.NewWriterSize(fw, exportBufferSize)
+		if err := e.exportStatement(ctx, statement, bw); err != nil {
+			if len(statements) > 1 {
+				return errors.Wrapf(err, "failed to export statement %d", i+1)
+			}
+			return err
+		}
+		if err := bw.Flush(); err != nil {
+			return errors.Wrapf(err, "failed to write export file")
+		}
+	}
+	return archive.close()
+}
+
+// exportStatement encodes the result of the statement batch by batch, so the full result is never held in memory.
+func (e *dataExporter) exportStatement(ctx context.Context, statement string, w io.Writer) error {
+	spans, err := getQuerySpans(ctx, e.stores, e.instance, e.database, statement, "")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
+	}
+	if len(spans) != 1 {
+		return status.Errorf(codes.InvalidArgument, "expect a single statement, but got %d", len(spans))
+	}
+	if e.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil && e.optionalAccessCheck != nil {
+		if err := e.optionalAccessCheck(ctx, e.instance, e.user, spans, true /* isExport */); err != nil {
+			return err
+		}
+	}
+	var maskers []masker.Masker
+	mask := e.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, e.instance) == nil
+	if mask {
+		maskers, err = getQueryMaskers(ctx, e.stores, e.schemaSyncer, e.instance, e.database, statement, "", spans[0], storepb.MaskingExceptionPolicy_MaskingException_EXPORT)
+		if err != nil {
+			return err
+		}
+	}
+
+	var resourceList []base.SchemaResource
+	if e.request.Format == v1pb.ExportFormat_SQL {
+		resourceList, err = extractResourceList(ctx, e.stores, e.instance.Engine, e.database.DatabaseName, statement, e.instance)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+		}
+	}
+	encoder, err := newExportEncoder(w, e.request.Format, e.instance.Engine, resourceList)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := e.openCursor(ctx, statement)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	limit := int(e.request.Limit)
+	for rows := 0; ; {
+		batchSize := exportBatchSize
+		if limit > 0 {
+			batchSize = min(batchSize, limit-rows)
+		}
+		result, done, err := cursor.Next(batchSize)
+		if err != nil {
+			return err
+		}
+		if mask {
+			doMaskResult(maskers, result)
+		}
+		if err := encoder.Encode(result); err != nil {
+			return errors.Wrapf(err, "failed to encode export file")
+		}
+		rows += len(result.Rows)
+		if done || (limit > 0 && rows >= limit) {
+			break
+		}
+	}
+	return encoder.Close()
+}
+
+// openCursor runs the statement with the live cursor if the driver supports it.
+// Otherwise, the statement is run with QueryConn and its result is capped by the maximum SQL result size.
+func (e *dataExporter) openCursor(ctx context.Context, statement string) (db.QueryCursor, error) {
+	if querier, ok := db.UnwrapDriver(e.driver).(db.CursorQuerier); ok && e.conn != nil {
+		return querier.QueryCursor(ctx, e.conn, statement, db.QueryContext{})
+	}
+
+	results, _, err := executeWithTimeout(ctx, e.driver, e.conn, statement, nil /* timeout */, db.QueryContext{Limit: int(e.request.Limit)})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, errors.Errorf("no result for statement")
+	}
+	// Only export the last result like the SQL Editor.
+	result := results[len(results)-1]
+	if result.GetError() != "" {
+		return nil, errors.New(result.GetError())
+	}
+	return &resultCursor{result: result}, nil
+}
+
+// splitExportStatement splits the statement for exporting each statement to a file.
+// The statement is exported as a whole if the engine cannot split it.
+func splitExportStatement(engine storepb.Engine, statement string) []string {
+	singleSQLs, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		if strings.TrimSpace(statement) == "" {
+			return nil
+		}
+		return []string{statement}
+	}
+	var statements []string
+	for _, singleSQL := range base.FilterEmptySQL(singleSQLs) {
+		statements = append(statements, singleSQL.Text)
+	}
+	return statements
+}
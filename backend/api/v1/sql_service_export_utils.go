@@ -11,7 +11,6 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
-	"github.com/xuri/excelize/v2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -26,29 +25,9 @@ import (
 
 func exportCSV(result *v1pb.QueryResult) ([]byte, error) {
 	var buf bytes.Buffer
-	if _, err := buf.WriteString(strings.Join(result.ColumnNames, ",")); err != nil {
+	if err := encodeResult(&csvExportEncoder{w: &buf}, result); err != nil {
 		return nil, err
 	}
-	if err := buf.WriteByte('\n'); err != nil {
-		return nil, err
-	}
-	for i, row := range result.Rows {
-		for i, value := range row.Values {
-			if i != 0 {
-				if err := buf.WriteByte(','); err != nil {
-					return nil, err
-				}
-			}
-			if _, err := buf.Write(convertValueToBytesInCSV(value)); err != nil {
-				return nil, err
-			}
-		}
-		if i != len(result.Rows)-1 {
-			if err := buf.WriteByte('\n'); err != nil {
-				return nil, err
-			}
-		}
-	}
 	return buf.Bytes(), nil
 }
 
@@ -103,7 +82,7 @@ func getSQLStatementPrefix(engine storepb.Engine, resourceList []base.SchemaReso
 	switch engine {
 	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE, storepb.Engine_SPANNER:
 		escapeQuote = "`"
-	case storepb.Engine_CLICKHOUSE, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM, storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SQLITE, storepb.Engine_SNOWFLAKE:
+	case storepb.Engine_CLICKHOUSE, storepb.Engine_MSSQL, storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_DM, storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_SQLITE, storepb.Engine_DUCKDB, storepb.Engine_DB2, storepb.Engine_TRINO, storepb.Engine_SNOWFLAKE:
 		// ClickHouse takes both double-quotes or backticks.
 		escapeQuote = "\""
 	default:
@@ -131,29 +110,8 @@ func getSQLStatementPrefix(engine storepb.Engine, resourceList []base.SchemaReso
 
 func exportSQL(engine storepb.Engine, statementPrefix string, result *v1pb.QueryResult) ([]byte, error) {
 	var buf bytes.Buffer
-	for i, row := range result.Rows {
-		if _, err := buf.WriteString(statementPrefix); err != nil {
-			return nil, err
-		}
-		for i, value := range row.Values {
-			if i != 0 {
-				if err := buf.WriteByte(','); err != nil {
-					return nil, err
-				}
-			}
-			if _, err := buf.Write(convertValueToBytesInSQL(engine, value)); err != nil {
-				return nil, err
-			}
-		}
-		if i != len(result.Rows)-1 {
-			if _, err := buf.WriteString(");\n"); err != nil {
-				return nil, err
-			}
-		} else {
-			if _, err := buf.WriteString(");"); err != nil {
-				return nil, err
-			}
-		}
+	if err := encodeResult(&sqlExportEncoder{w: &buf, engine: engine, statementPrefix: statementPrefix}, result); err != nil {
+		return nil, err
 	}
 	return buf.Bytes(), nil
 }
@@ -588,39 +546,7 @@ func extractResourceList(ctx context.Context, storeInstance *store.Store, engine
 
 func exportJSON(result *v1pb.QueryResult) ([]byte, error) {
 	var buf bytes.Buffer
-	if _, err := buf.WriteString("["); err != nil {
-		return nil, err
-	}
-
-	for rowIndex, row := range result.Rows {
-		if _, err := buf.WriteString("{"); err != nil {
-			return nil, err
-		}
-		for i, value := range row.Values {
-			if _, err := buf.WriteString(fmt.Sprintf(`"%s":`, result.ColumnNames[i])); err != nil {
-				return nil, err
-			}
-			if _, err := buf.WriteString(convertValueToStringInJSON(value)); err != nil {
-				return nil, err
-			}
-			if i != len(row.Values)-1 {
-				if _, err := buf.WriteString(","); err != nil {
-					return nil, err
-				}
-			}
-		}
-		if _, err := buf.WriteString("}"); err != nil {
-			return nil, err
-		}
-
-		if rowIndex != len(result.Rows)-1 {
-			if _, err := buf.WriteString(","); err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	if _, err := buf.WriteString("]"); err != nil {
+	if err := encodeResult(&jsonExportEncoder{w: &buf}, result); err != nil {
 		return nil, err
 	}
 	return buf.Bytes(), nil
@@ -689,37 +615,11 @@ const (
 )
 
 func exportXLSX(result *v1pb.QueryResult) ([]byte, error) {
-	f := excelize.NewFile()
-	defer f.Close()
-	index, err := f.NewSheet("Sheet1")
-	if err != nil {
-		return nil, err
-	}
-	var columnPrefixes []string
-	for i, columnName := range result.ColumnNames {
-		columnPrefix, err := getExcelColumnName(i)
-		if err != nil {
-			return nil, err
-		}
-		columnPrefixes = append(columnPrefixes, columnPrefix)
-		if err := f.SetCellValue(sheet1Name, fmt.Sprintf("%s1", columnPrefix), columnName); err != nil {
-			return nil, err
-		}
-	}
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
-			columnName := fmt.Sprintf("%s%d", columnPrefixes[j], i+2)
-			if err := f.SetCellValue("Sheet1", columnName, convertValueToStringInXLSX(value)); err != nil {
-				return nil, err
-			}
-		}
-	}
-	f.SetActiveSheet(index)
-	excelBytes, err := f.WriteToBuffer()
-	if err != nil {
+	var buf bytes.Buffer
+	if err := encodeResult(&xlsxExportEncoder{w: &buf}, result); err != nil {
 		return nil, err
 	}
-	return excelBytes.Bytes(), nil
+	return buf.Bytes(), nil
 }
 
 func getExcelColumnName(index int) (string, error) {
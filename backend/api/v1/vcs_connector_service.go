@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"path"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -19,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	"github.com/bytebase/bytebase/backend/store"
@@ -112,6 +114,14 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 		return nil, status.Errorf(codes.InvalidArgument, `base directory should not end with "/"`)
 	}
 
+	if err := validateTagPattern(request.GetVcsConnector().TagPattern); err != nil {
+		return nil, err
+	}
+	schemaWriteBack, err := convertToStoreSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack, request.GetVcsConnector().Branch)
+	if err != nil {
+		return nil, err
+	}
+
 	workspaceID, err := s.store.GetWorkspaceID(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to find workspace id with error: %v", err.Error())
@@ -135,6 +145,9 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 			ExternalId:         request.GetVcsConnector().ExternalId,
 			WebhookSecretToken: secretToken,
 			DatabaseGroup:      request.GetVcsConnector().DatabaseGroup,
+			Trigger:            storepb.VCSConnector_Trigger(request.GetVcsConnector().Trigger),
+			TagPattern:         request.GetVcsConnector().TagPattern,
+			SchemaWriteBack:    schemaWriteBack,
 		},
 	}
 
@@ -151,6 +164,7 @@ func (s *VCSConnectorService) CreateVCSConnector(ctx context.Context, request *v
 		secretToken,
 		vcsConnectorCreate.Payload.ExternalId,
 		bytebaseEndpointURL,
+		vcsConnectorCreate.Payload.Trigger,
 	)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create webhook for project %s with error: %v", vcsConnectorCreate.ProjectID, err.Error())
@@ -291,6 +305,28 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 			update.BaseDirectory = &baseDir
 		case "database_group":
 			update.DatabaseGroup = &request.GetVcsConnector().DatabaseGroup
+		case "trigger":
+			trigger := storepb.VCSConnector_Trigger(request.GetVcsConnector().Trigger)
+			update.Trigger = &trigger
+		case "tag_pattern":
+			tagPattern := request.GetVcsConnector().TagPattern
+			if err := validateTagPattern(tagPattern); err != nil {
+				return nil, err
+			}
+			update.TagPattern = &tagPattern
+		case "schema_write_back":
+			branch := vcsConnector.Payload.Branch
+			if update.Branch != nil {
+				branch = *update.Branch
+			}
+			schemaWriteBack, err := convertToStoreSchemaWriteBack(request.GetVcsConnector().SchemaWriteBack, branch)
+			if err != nil {
+				return nil, err
+			}
+			if schemaWriteBack == nil {
+				schemaWriteBack = &storepb.VCSConnector_SchemaWriteBack{}
+			}
+			update.SchemaWriteBack = schemaWriteBack
 		}
 	}
 
@@ -306,9 +342,23 @@ func (s *VCSConnectorService) UpdateVCSConnector(ctx context.Context, request *v
 		}
 	}
 
+	// The webhook events depend on the trigger, so the webhooks are recreated for the new trigger.
+	var staleWebhookID string
+	if update.Trigger != nil && !equalWebhookEvents(*update.Trigger, vcsConnector.Payload.Trigger) {
+		webhookID, err := s.recreateVCSWebhook(ctx, project, vcsProvider, vcsConnector, *update.Trigger)
+		if err != nil {
+			return nil, err
+		}
+		update.ExternalWebhookID = &webhookID
+		staleWebhookID = vcsConnector.Payload.ExternalWebhookId
+	}
+
 	if err := s.store.UpdateVCSConnector(ctx, update); err != nil {
 		return nil, err
 	}
+	if staleWebhookID != "" {
+		deleteVCSWebhook(ctx, vcsProvider, vcsConnector, staleWebhookID)
+	}
 	vcsConnector, err = s.store.GetVCSConnector(ctx, &store.FindVCSConnectorMessage{ProjectID: &project.ResourceID, ResourceID: &vcsConnectorID})
 	if err != nil {
 		return nil, err
@@ -357,23 +407,56 @@ func (s *VCSConnectorService) DeleteVCSConnector(ctx context.Context, request *v
 		return nil, err
 	}
 
-	// Delete the webhook, and fail-open.
-	webhookIDs := strings.Split(vcsConnector.Payload.ExternalWebhookId, ",")
+	deleteVCSWebhook(ctx, vcsProvider, vcsConnector, vcsConnector.Payload.ExternalWebhookId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// recreateVCSWebhook creates the webhooks with the events of the trigger for the VCS connector.
+func (s *VCSConnectorService) recreateVCSWebhook(ctx context.Context, project *store.ProjectMessage, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, trigger storepb.VCSConnector_Trigger) (string, error) {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to find workspace setting: %v", err)
+	}
+	bytebaseEndpointURL := setting.GitopsWebhookUrl
+	if bytebaseEndpointURL == "" {
+		bytebaseEndpointURL = setting.ExternalUrl
+	}
+	workspaceID, err := s.store.GetWorkspaceID(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to find workspace id with error: %v", err.Error())
+	}
+	webhookEndpointID := fmt.Sprintf("workspaces/%s/projects/%s/vcsConnectors/%s", workspaceID, project.ResourceID, vcsConnector.ResourceID)
+	webhookID, err := createVCSWebhook(
+		ctx,
+		vcsProvider,
+		webhookEndpointID,
+		vcsConnector.Payload.WebhookSecretToken,
+		vcsConnector.Payload.ExternalId,
+		bytebaseEndpointURL,
+		trigger,
+	)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create webhook for project %s with error: %v", project.ResourceID, err.Error())
+	}
+	return webhookID, nil
+}
+
+// deleteVCSWebhook deletes the comma separated webhooks of the VCS connector, and fail-open.
+func deleteVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage, vcsConnector *store.VCSConnectorMessage, externalWebhookID string) {
 	vcsPlugin := vcs.Get(
 		vcsProvider.Type,
 		vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken},
 	)
-	for _, webhookID := range webhookIDs {
-		if err = vcsPlugin.DeleteWebhook(
+	for _, webhookID := range strings.Split(externalWebhookID, ",") {
+		if err := vcsPlugin.DeleteWebhook(
 			ctx,
 			vcsConnector.Payload.ExternalId,
 			webhookID,
 		); err != nil {
-			slog.Error("failed to delete webhook for VCS connector", slog.String("project", projectID), slog.String("VCS connector", vcsConnector.ResourceID), log.BBError(err))
+			slog.Error("failed to delete webhook for VCS connector", slog.String("project", vcsConnector.ProjectID), slog.String("VCS connector", vcsConnector.ResourceID), log.BBError(err))
 		}
 	}
-
-	return &emptypb.Empty{}, nil
 }
 
 func convertStoreVCSConnector(ctx context.Context, stores *store.Store, vcsConnector *store.VCSConnectorMessage) (*v1pb.VCSConnector, error) {
@@ -406,10 +489,61 @@ func convertStoreVCSConnector(ctx context.Context, stores *store.Store, vcsConne
 		FullPath:      vcsConnector.Payload.FullPath,
 		WebUrl:        vcsConnector.Payload.WebUrl,
 		DatabaseGroup: vcsConnector.Payload.DatabaseGroup,
+		// The enum values are the same in store and v1.
+		Trigger:             v1pb.VCSConnector_Trigger(vcsConnector.Payload.Trigger),
+		TagPattern:          vcsConnector.Payload.TagPattern,
+		LastProcessedCommit: vcsConnector.Payload.LastProcessedCommit,
+	}
+	if v := vcsConnector.Payload.SchemaWriteBack; v.GetFilePathTemplate() != "" {
+		v1VCSConnector.SchemaWriteBack = &v1pb.VCSConnector_SchemaWriteBack{
+			Branch:           v.Branch,
+			FilePathTemplate: v.FilePathTemplate,
+		}
 	}
 	return v1VCSConnector, nil
 }
 
+// defaultSchemaWriteBackBranch is the default branch to commit the latest schema to.
+const defaultSchemaWriteBackBranch = "bytebase/schema-write-back"
+
+// convertToStoreSchemaWriteBack validates the schema write-back setting. It returns nil if the setting is not set.
+func convertToStoreSchemaWriteBack(schemaWriteBack *v1pb.VCSConnector_SchemaWriteBack, connectorBranch string) (*storepb.VCSConnector_SchemaWriteBack, error) {
+	if schemaWriteBack == nil {
+		return nil, nil
+	}
+	filePathTemplate := strings.TrimSpace(schemaWriteBack.FilePathTemplate)
+	if filePathTemplate == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "schema write-back file path template is required")
+	}
+	// Every database is written to its own file. The database names are only unique within the instance.
+	for _, placeholder := range []string{"{{INSTANCE_ID}}", "{{DB_NAME}}"} {
+		if !strings.Contains(filePathTemplate, placeholder) {
+			return nil, status.Errorf(codes.InvalidArgument, "schema write-back file path template %q must contain %s", filePathTemplate, placeholder)
+		}
+	}
+	if strings.HasSuffix(filePathTemplate, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, `schema write-back file path template should not end with "/"`)
+	}
+	branch := schemaWriteBack.Branch
+	if branch == "" {
+		branch = defaultSchemaWriteBackBranch
+	}
+	if branch == connectorBranch {
+		return nil, status.Errorf(codes.InvalidArgument, "schema write-back branch must differ from the connector branch %q", connectorBranch)
+	}
+	return &storepb.VCSConnector_SchemaWriteBack{
+		Branch:           branch,
+		FilePathTemplate: filePathTemplate,
+	}, nil
+}
+
+func validateTagPattern(tagPattern string) error {
+	if _, err := path.Match(tagPattern, ""); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid tag pattern %q: %v", tagPattern, err)
+	}
+	return nil
+}
+
 func checkBranchExistence(ctx context.Context, vcsProvider *store.VCSProviderMessage, externalID, branch string) error {
 	if branch == "" {
 		return status.Errorf(codes.InvalidArgument, "branch name is required")
@@ -423,7 +557,30 @@ func checkBranchExistence(ctx context.Context, vcsProvider *store.VCSProviderMes
 	return nil
 }
 
-func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage, webhookEndpointID, webhookSecretToken, externalRepoID, bytebaseEndpointURL string) (string, error) {
+// getPushWebhookEvents returns whether the webhooks subscribe to the branch push and the tag push events for the trigger.
+// The pull request events are always subscribed for the SQL review.
+func getPushWebhookEvents(trigger storepb.VCSConnector_Trigger) (push bool, tagPush bool) {
+	switch trigger {
+	case storepb.VCSConnector_PUSH:
+		return true, false
+	case storepb.VCSConnector_TAG:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// equalWebhookEvents returns true if the webhooks of the triggers subscribe to the same events.
+func equalWebhookEvents(a, b storepb.VCSConnector_Trigger) bool {
+	aPush, aTagPush := getPushWebhookEvents(a)
+	bPush, bTagPush := getPushWebhookEvents(b)
+	return aPush == bPush && aTagPush == bTagPush
+}
+
+func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage, webhookEndpointID, webhookSecretToken, externalRepoID, bytebaseEndpointURL string, trigger storepb.VCSConnector_Trigger) (string, error) {
+	push, tagPush := getPushWebhookEvents(trigger)
+	// Except for GitLab, the push event of the VCS covers both the branches and the tags.
+	anyPush := push || tagPush
 	// Create a new webhook and retrieve the created webhook ID
 	var webhookCreatePayloads [][]byte
 	switch vcsProvider.Type {
@@ -434,6 +591,8 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 			SecretToken:           webhookSecretToken,
 			MergeRequestsEvents:   true,
 			NoteEvents:            true,
+			PushEvents:            push,
+			TagPushEvents:         tagPush,
 			EnableSSLVerification: false,
 		}
 		createPayload, err := json.Marshal(webhookCreate)
@@ -449,14 +608,37 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 				Secret:      webhookSecretToken,
 				InsecureSSL: 1,
 			},
-			// https://docs.github.com/en/webhooks/webhook-events-and-payloads
-			Events: []string{"pull_request", "pull_request_review_comment"},
+		}
+		// https://docs.github.com/en/webhooks/webhook-events-and-payloads
+		webhookPost.Events = []string{"pull_request", "pull_request_review_comment"}
+		if anyPush {
+			webhookPost.Events = append(webhookPost.Events, "push")
 		}
 		createPayload, err := json.Marshal(webhookPost)
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
 		webhookCreatePayloads = append(webhookCreatePayloads, createPayload)
+	case storepb.VCSType_GITEA:
+		webhookCreate := gitea.WebhookCreate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/%s", bytebaseEndpointURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      webhookSecretToken,
+			},
+			Active: true,
+		}
+		// https://docs.gitea.com/usage/webhooks#event-information
+		webhookCreate.Events = []string{"pull_request"}
+		if anyPush {
+			webhookCreate.Events = append(webhookCreate.Events, "push")
+		}
+		createPayload, err := json.Marshal(webhookCreate)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
+		webhookCreatePayloads = append(webhookCreatePayloads, createPayload)
 	case storepb.VCSType_BITBUCKET:
 		webhookPost := bitbucket.WebhookCreateOrUpdate{
 			Description: "Bytebase GitOps",
@@ -470,6 +652,9 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 				"pullrequest:comment_created",
 			},
 		}
+		if anyPush {
+			webhookPost.Events = append(webhookPost.Events, bitbucket.RepoPushEvent)
+		}
 		createPayload, err := json.Marshal(webhookPost)
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
@@ -487,10 +672,14 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 		// - git.pullrequest.merged: A merge commit was created on a pull request.
 		// - git.pullrequest.created: A pull request is created in a Git repository.
 		// - git.pullrequest.updated: A pull request is updated; status, review list, reviewer vote changed, or the source branch is updated with a push.
-		events := []azure.PullRequestEventType{
-			azure.PullRequestEventCreated,
-			azure.PullRequestEventUpdated,
-			azure.PullRequestEventMerged,
+		// - git.push: Code is pushed to a Git repository, for the push and tag triggers.
+		events := []string{
+			string(azure.PullRequestEventCreated),
+			string(azure.PullRequestEventUpdated),
+			string(azure.PullRequestEventMerged),
+		}
+		if anyPush {
+			events = append(events, azure.PushEventType)
 		}
 		for _, event := range events {
 			publisherInputs := azure.WebhookCreatePublisherInputs{
@@ -498,7 +687,7 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 				Branch:     "", /* Any branches */
 				ProjectID:  projectID,
 			}
-			if event == azure.PullRequestEventMerged {
+			if event == string(azure.PullRequestEventMerged) {
 				publisherInputs.MergeResult = azure.WebhookMergeResultSucceeded
 			}
 			webhookPost := azure.WebhookCreateOrUpdate{
@@ -509,7 +698,7 @@ func createVCSWebhook(ctx context.Context, vcsProvider *store.VCSProviderMessage
 					AcceptUntrustedCerts: true,
 					HTTPHeaders:          fmt.Sprintf("X-Azure-Token: %s", webhookSecretToken),
 				},
-				EventType:       string(event),
+				EventType:       event,
 				PublisherID:     "tfs",
 				PublisherInputs: publisherInputs,
 			}
@@ -33,5 +33,6 @@ func getBaseProfile(dataDir string) *config.Profile {
 		DeployID:           uuid.NewString()[:8],
 		LastActiveTs:       time.Now().Unix(),
 		Lsp:                flags.lsp,
+		SecretDir:          flags.secretDir,
 	}
 }
@@ -80,6 +80,8 @@ var (
 		// disableSample is the flag to disable the sample instance.
 		disableSample bool
 		lsp           bool
+		// secretDir is the directory of the mounted secrets referenced by the file external secrets.
+		secretDir string
 	}
 
 	rootCmd = &cobra.Command{
@@ -124,6 +126,8 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&flags.lsp, "lsp", true, "whether to enable lsp in SQL Editor")
 	rootCmd.PersistentFlags().BoolVar(&flags.disableMetric, "disable-metric", false, "disable the metric collector")
 	rootCmd.PersistentFlags().BoolVar(&flags.disableSample, "disable-sample", false, "disable the sample instance")
+	// The directory is usually a Kubernetes secret volume or a CSI secret store mount.
+	rootCmd.PersistentFlags().StringVar(&flags.secretDir, "secret-dir", os.Getenv("BB_SECRET_DIR"), "optional directory of the mounted secrets that data sources can reference as file secrets")
 }
 
 // -----------------------------------Command Line Config END--------------------------------------
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"slices"
 
 	"google.golang.org/protobuf/types/known/anypb"
 )
@@ -16,6 +17,8 @@ const (
 	UserContextKey
 	AuthContextKey
 	ServiceDataKey
+	// AccessTokenScopeContextKey is the key name used to store the access token scope in the context.
+	AccessTokenScopeContextKey
 )
 
 func WithSetServiceData(ctx context.Context, setServiceData func(a *anypb.Any)) context.Context {
@@ -77,3 +80,41 @@ func (c *AuthContext) GetProjectResources() []string {
 	}
 	return projectIDs
 }
+
+// AccessTokenScope is the scope of the personal access token or service account key used by the request.
+type AccessTokenScope struct {
+	// Permissions is the permission subset of the token. All permissions of the owner are allowed if empty.
+	Permissions []string
+	// ProjectID is the project resource ID that the token is restricted to.
+	ProjectID string
+}
+
+func GetAccessTokenScopeFromContext(ctx context.Context) (*AccessTokenScope, bool) {
+	scope, ok := ctx.Value(AccessTokenScopeContextKey).(*AccessTokenScope)
+	return scope, ok && scope != nil
+}
+
+// IsUnrestricted returns whether the scope allows all permissions of the owner on all projects.
+func (s *AccessTokenScope) IsUnrestricted() bool {
+	return len(s.Permissions) == 0 && s.ProjectID == ""
+}
+
+// Allows returns whether the scope allows the permission on the given projects.
+// Workspace-level checks, which carry no project, are denied for project-scoped tokens.
+func (s *AccessTokenScope) Allows(permission string, projectIDs ...string) bool {
+	if len(s.Permissions) > 0 && !slices.Contains(s.Permissions, permission) {
+		return false
+	}
+	if s.ProjectID == "" {
+		return true
+	}
+	if len(projectIDs) == 0 {
+		return false
+	}
+	for _, projectID := range projectIDs {
+		if projectID != s.ProjectID {
+			return false
+		}
+	}
+	return true
+}
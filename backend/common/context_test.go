@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenScopeAllows(t *testing.T) {
+	tests := []struct {
+		scope      *AccessTokenScope
+		permission string
+		projectIDs []string
+		want       bool
+	}{
+		{
+			scope:      &AccessTokenScope{},
+			permission: "bb.databases.query",
+			want:       true,
+		},
+		{
+			scope:      &AccessTokenScope{Permissions: []string{"bb.databases.query"}},
+			permission: "bb.databases.query",
+			projectIDs: []string{"p1"},
+			want:       true,
+		},
+		{
+			scope:      &AccessTokenScope{Permissions: []string{"bb.databases.query"}},
+			permission: "bb.databases.export",
+			want:       false,
+		},
+		{
+			scope:      &AccessTokenScope{ProjectID: "p1"},
+			permission: "bb.databases.query",
+			projectIDs: []string{"p1"},
+			want:       true,
+		},
+		{
+			scope:      &AccessTokenScope{ProjectID: "p1"},
+			permission: "bb.databases.query",
+			projectIDs: []string{"p1", "p2"},
+			want:       false,
+		},
+		{
+			scope:      &AccessTokenScope{ProjectID: "p1"},
+			permission: "bb.instances.list",
+			want:       false,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got := test.scope.Allows(test.permission, test.projectIDs...)
+		a.Equal(test.want, got, "scope %+v, permission %s, projects %v", test.scope, test.permission, test.projectIDs)
+	}
+}
+
+func TestAccessTokenScopeIsUnrestricted(t *testing.T) {
+	a := require.New(t)
+	a.True((&AccessTokenScope{}).IsUnrestricted())
+	a.False((&AccessTokenScope{Permissions: []string{"bb.databases.query"}}).IsUnrestricted())
+	a.False((&AccessTokenScope{ProjectID: "p1"}).IsUnrestricted())
+}
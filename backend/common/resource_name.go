@@ -49,6 +49,10 @@ const (
 	AuditLogPrefix             = "auditLogs/"
 	GroupPrefix                = "groups/"
 	ReviewConfigPrefix         = "reviewConfigs/"
+	AccessTokenPrefix          = "accessTokens/"
+	WebhookDeliveryPrefix      = "deliveries/"
+	EditorSessionPrefix        = "editorSessions/"
+	QueryResultPrefix          = "queryResults/"
 
 	SchemaSuffix     = "/schema"
 	MetadataSuffix   = "/metadata"
@@ -97,6 +101,23 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryID returns the project ID, webhook ID and delivery ID from a resource name.
+func GetProjectIDWebhookIDDeliveryID(name string) (string, int, int, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryPrefix)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	webhookID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid webhook ID %q", tokens[1])
+	}
+	deliveryID, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", 0, 0, errors.Errorf("invalid delivery ID %q", tokens[2])
+	}
+	return tokens[0], webhookID, deliveryID, nil
+}
+
 func GetProjectIDDeploymentConfigID(name string) (string, string, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, DeploymentConfigPrefix)
 	if err != nil {
@@ -183,6 +204,20 @@ func GetInstanceDatabaseID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetInstanceDatabaseIDQueryResultUID returns the instance ID, database ID, and cached query result UID from a resource name.
+func GetInstanceDatabaseIDQueryResultUID(name string) (string, string, int, error) {
+	// the name should be instances/{instance-id}/databases/{database-id}/queryResults/{queryResult-uid}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, QueryResultPrefix)
+	if err != nil {
+		return "", "", 0, err
+	}
+	uid, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", "", 0, errors.Wrapf(err, "failed to convert query result uid %q to int", tokens[2])
+	}
+	return tokens[0], tokens[1], uid, nil
+}
+
 // GetInstanceDatabaseIDChangeHistory returns the instance ID, database ID, and change history ID from a resource name.
 func GetInstanceDatabaseIDChangeHistory(name string) (string, string, string, error) {
 	// the name should be instances/{instance-id}/databases/{database-id}/changeHistories/{changeHistory-id}
@@ -208,6 +243,23 @@ func GetUserID(name string) (int, error) {
 	return GetUIDFromName(name, UserNamePrefix)
 }
 
+// GetUserIDAccessTokenID returns the user ID and access token ID from a resource name.
+func GetUserIDAccessTokenID(name string) (int, int, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, AccessTokenPrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	tokenID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid access token ID %q", tokens[1])
+	}
+	return userID, tokenID, nil
+}
+
 // GetUserEmail returns the user email from a resource name.
 func GetUserEmail(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix)
@@ -593,6 +645,11 @@ func FormatProject(id string) string {
 	return fmt.Sprintf("%s%s", ProjectNamePrefix, id)
 }
 
+// FormatWebhookDelivery formats the resource name of a webhook delivery.
+func FormatWebhookDelivery(projectID string, webhookID, deliveryID int) string {
+	return fmt.Sprintf("%s%s/%s%d/%s%d", ProjectNamePrefix, projectID, WebhookIDPrefix, webhookID, WebhookDeliveryPrefix, deliveryID)
+}
+
 func FormatDeploymentConfig(parent string) string {
 	return fmt.Sprintf("%s/%s%s", parent, DeploymentConfigPrefix, "default")
 }
@@ -605,6 +662,10 @@ func FormatUserUID(uid int) string {
 	return fmt.Sprintf("%s%d", UserNamePrefix, uid)
 }
 
+func FormatAccessToken(userUID int, tokenUID int) string {
+	return fmt.Sprintf("%s/%s%d", FormatUserUID(userUID), AccessTokenPrefix, tokenUID)
+}
+
 func FormatGroupEmail(email string) string {
 	return fmt.Sprintf("%s%s", GroupPrefix, email)
 }
@@ -625,6 +686,14 @@ func FormatDatabase(instance string, database string) string {
 	return fmt.Sprintf("%s/%s%s", FormatInstance(instance), DatabaseIDPrefix, database)
 }
 
+func FormatEditorSession(instance, database, editorSession string) string {
+	return fmt.Sprintf("%s/%s%s", FormatDatabase(instance, database), EditorSessionPrefix, editorSession)
+}
+
+func FormatQueryResult(instance, database string, queryResultUID int) string {
+	return fmt.Sprintf("%s/%s%d", FormatDatabase(instance, database), QueryResultPrefix, queryResultUID)
+}
+
 func FormatRole(role string) string {
 	return fmt.Sprintf("%s%s", RolePrefix, role)
 }
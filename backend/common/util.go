@@ -1,7 +1,10 @@
 package common
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"math/big"
@@ -53,6 +56,7 @@ var (
 		storepb.Engine_MSSQL:            true,
 		storepb.Engine_DYNAMODB:         true,
 		storepb.Engine_COCKROACHDB:      true,
+		storepb.Engine_DB2:              true,
 	}
 	StatementReportEngines = map[storepb.Engine]bool{
 		storepb.Engine_POSTGRES:         true,
@@ -167,6 +171,42 @@ func Unobfuscate(dst, seed string) (string, error) {
 	return string(unobfuscated), nil
 }
 
+// Encrypt encrypts the data with AES-256-GCM. The key is derived from the secret.
+// The nonce is prepended to the ciphertext.
+func Encrypt(data []byte, secret string) ([]byte, error) {
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrapf(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// Decrypt decrypts the data encrypted by Encrypt with the same secret.
+func Decrypt(data []byte, secret string) ([]byte, error) {
+	aead, err := newAEAD(secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func newAEAD(secret string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
 // NormalizeExternalURL will format the external url.
 func NormalizeExternalURL(url string) (string, error) {
 	r := strings.TrimSpace(url)
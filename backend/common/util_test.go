@@ -160,6 +160,20 @@ func TestObfuscate(t *testing.T) {
 	}
 }
 
+func TestEncrypt(t *testing.T) {
+	secret := "01234567890123456789012345678901"
+	for _, src := range []string{"", "hello", "你好"} {
+		encrypted, err := Encrypt([]byte(src), secret)
+		require.NoError(t, err)
+		decrypted, err := Decrypt(encrypted, secret)
+		require.NoError(t, err)
+		require.Equal(t, src, string(decrypted))
+
+		_, err = Decrypt(encrypted, "another secret")
+		require.Error(t, err)
+	}
+}
+
 func TestNormalizeExternalURL(t *testing.T) {
 	tests := []struct {
 		url     string
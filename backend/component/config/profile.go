@@ -68,6 +68,9 @@ type Profile struct {
 
 	Lsp bool
 
+	// SecretDir is the directory of the mounted secrets. The file external secrets are disabled if empty.
+	SecretDir string
+
 	// can be set in runtime
 	RuntimeDebug atomic.Bool
 }
@@ -3,8 +3,11 @@ package dbfactory
 
 import (
 	"context"
+	"log/slog"
+	"strings"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/secret"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -102,11 +105,21 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 		return nil, err
 	}
 
-	updatedPassword, err := secret.ReplaceExternalSecret(ctx, password, dataSource.ExternalSecret)
-	if err != nil {
-		return nil, err
+	username := dataSource.Username
+	var updatedPassword string
+	var credential *secret.DynamicCredential
+	if secret.IsDynamicCredential(dataSource.ExternalSecret) {
+		credential, err = secret.GetDynamicCredential(ctx, dataSource.ExternalSecret)
+		if err != nil {
+			return nil, err
+		}
+		username, updatedPassword = credential.Username, credential.Password
+	} else {
+		updatedPassword, err = secret.ReplaceExternalSecret(ctx, password, dataSource.ExternalSecret)
+		if err != nil {
+			return nil, err
+		}
 	}
-	password = updatedPassword
 	sshConfig := db.SSHConfig{
 		Host:       dataSource.SSHHost,
 		Port:       dataSource.SSHPort,
@@ -135,50 +148,110 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 	connectionContext.EngineVersion = instance.EngineVersion
 
 	maximumSQLResultSize := d.store.GetMaximumSQLResultLimit(ctx)
-	driver, err := db.Open(
-		ctx,
-		instance.Engine,
-		db.DriverConfig{
-			DbBinDir: dbBinDir,
+	connectionConfig := db.ConnectionConfig{
+		Username: username,
+		Password: updatedPassword,
+		TLSConfig: db.TLSConfig{
+			UseSSL:  dataSource.UseSSL,
+			SslCA:   sslCA,
+			SslCert: sslCert,
+			SslKey:  sslKey,
 		},
-		db.ConnectionConfig{
-			Username: dataSource.Username,
-			Password: password,
-			TLSConfig: db.TLSConfig{
-				UseSSL:  dataSource.UseSSL,
-				SslCA:   sslCA,
-				SslCert: sslCert,
-				SslKey:  sslKey,
-			},
-			Host:                     dataSource.Host,
-			Port:                     dataSource.Port,
-			Database:                 databaseName,
-			DataShare:                datashare,
-			SRV:                      dataSource.SRV,
-			AuthenticationDatabase:   dataSource.AuthenticationDatabase,
-			SID:                      dataSource.SID,
-			ServiceName:              dataSource.ServiceName,
-			SSHConfig:                sshConfig,
-			ReadOnly:                 readOnly,
-			ConnectionContext:        connectionContext,
-			AuthenticationPrivateKey: authenticationPrivateKey,
-			AuthenticationType:       dataSource.AuthenticationType,
-			SASLConfig:               dbSaslConfig,
-			AdditionalAddresses:      dataSource.AdditionalAddresses,
-			ReplicaSet:               dataSource.ReplicaSet,
-			DirectConnection:         dataSource.DirectConnection,
-			Region:                   dataSource.Region,
-			WarehouseID:              dataSource.WarehouseID,
-			RedisType:                dataSource.RedisType,
-			MasterName:               dataSource.MasterName,
-			MasterUsername:           dataSource.MasterUsername,
-			MasterPassword:           masterPassword,
-			MaximumSQLResultSize:     maximumSQLResultSize,
-		},
-	)
+		Host:                     dataSource.Host,
+		Port:                     dataSource.Port,
+		Database:                 databaseName,
+		DataShare:                datashare,
+		SRV:                      dataSource.SRV,
+		AuthenticationDatabase:   dataSource.AuthenticationDatabase,
+		SID:                      dataSource.SID,
+		ServiceName:              dataSource.ServiceName,
+		SSHConfig:                sshConfig,
+		ReadOnly:                 readOnly,
+		ConnectionContext:        connectionContext,
+		AuthenticationPrivateKey: authenticationPrivateKey,
+		AuthenticationType:       dataSource.AuthenticationType,
+		SASLConfig:               dbSaslConfig,
+		AdditionalAddresses:      dataSource.AdditionalAddresses,
+		ReplicaSet:               dataSource.ReplicaSet,
+		DirectConnection:         dataSource.DirectConnection,
+		Region:                   dataSource.Region,
+		WarehouseID:              dataSource.WarehouseID,
+		RedisType:                dataSource.RedisType,
+		MasterName:               dataSource.MasterName,
+		MasterUsername:           dataSource.MasterUsername,
+		MasterPassword:           masterPassword,
+		MaximumSQLResultSize:     maximumSQLResultSize,
+	}
+	driver, err := db.Open(ctx, instance.Engine, db.DriverConfig{DbBinDir: dbBinDir}, connectionConfig)
+	if credential != nil {
+		if err != nil {
+			credential.Release(ctx)
+			return nil, err
+		}
+		return &dynamicCredentialDriver{Driver: driver, credential: credential}, nil
+	}
 	if err != nil {
-		return nil, err
+		// The external secret may have been rotated since it was cached, so refresh it and retry once.
+		if !isAuthenticationError(err) || !secret.InvalidateExternalSecret(password, dataSource.ExternalSecret) {
+			return nil, err
+		}
+		slog.Info("refreshing external secret after authentication failure", slog.String("instance", instance.ResourceID), log.BBError(err))
+		refreshedPassword, rerr := secret.ReplaceExternalSecret(ctx, password, dataSource.ExternalSecret)
+		if rerr != nil {
+			return nil, rerr
+		}
+		if refreshedPassword == updatedPassword {
+			return nil, err
+		}
+		connectionConfig.Password = refreshedPassword
+		driver, err = db.Open(ctx, instance.Engine, db.DriverConfig{DbBinDir: dbBinDir}, connectionConfig)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return driver, nil
 }
+
+// dynamicCredentialDriver revokes the leased credential when the driver is closed.
+type dynamicCredentialDriver struct {
+	db.Driver
+	credential *secret.DynamicCredential
+}
+
+func (d *dynamicCredentialDriver) Close(ctx context.Context) error {
+	defer d.credential.Release(ctx)
+	return d.Driver.Close(ctx)
+}
+
+// Unwrap returns the underlying driver.
+func (d *dynamicCredentialDriver) Unwrap() db.Driver {
+	return d.Driver
+}
+
+// authenticationErrorPatterns are the error messages of the database engines when the credential is rejected.
+var authenticationErrorPatterns = []string{
+	// MySQL, TiDB, MariaDB, OceanBase.
+	"access denied for user",
+	// PostgreSQL, Redshift, RisingWave.
+	"password authentication failed",
+	"sqlstate 28p01",
+	// SQL Server.
+	"login failed for user",
+	// Oracle.
+	"ora-01017",
+	// MongoDB.
+	"authentication failed",
+	// Snowflake.
+	"incorrect username or password",
+}
+
+func isAuthenticationError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, pattern := range authenticationErrorPatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
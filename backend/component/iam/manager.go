@@ -52,7 +52,11 @@ func NewManager(store *store.Store, licenseService enterprise.LicenseService) (*
 // Check if the user has permission on the resource hierarchy.
 // CEL on the binding is not considered.
 // When multiple projects are specified, the user should have permission on every projects.
+// If the request is authenticated by a scoped access token, the permission must also be within the token scope.
 func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
+	if scope, ok := common.GetAccessTokenScopeFromContext(ctx); ok && !scope.Allows(p, projectIDs...) {
+		return false, nil
+	}
 	if m.licenseService.IsFeatureEnabled(api.FeatureRBAC) != nil {
 		// nolint
 		return true, nil
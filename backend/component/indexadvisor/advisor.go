@@ -0,0 +1,389 @@
+// Package indexadvisor advises the indexes from the query plans and the synced schema without network access.
+package indexadvisor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// maxIndexColumns is the maximum number of the key columns of the suggested index.
+	// Wider indexes cost more to maintain than they save.
+	maxIndexColumns = 5
+	// maxIndexNameLength is the shorter one of the identifier length limits of PostgreSQL and MySQL.
+	maxIndexNameLength = 63
+)
+
+// Query is the query to advise the indexes for.
+type Query struct {
+	// Plan is the query plan returned by Explain.
+	Plan string
+	// SourceColumns are the columns referenced by the query span.
+	// The columns outside the set are ignored if it's not empty.
+	SourceColumns base.SourceColumnSet
+}
+
+// Advice is the index advice of a table.
+type Advice struct {
+	Schema string
+	Table  string
+	// Columns are the key columns of the suggested index.
+	Columns []string
+	// CurrentIndex is the existing index covering the columns.
+	CurrentIndex string
+	// CreateIndexStatement is empty if the current index covers the columns.
+	CreateIndexStatement string
+	// RedundantIndexes are the existing indexes which are the prefixes of the suggested index or another existing index.
+	RedundantIndexes []string
+	Reason           string
+	RowsExamined     int64
+	RowsReturned     int64
+	// EstimatedBenefit is the estimated fraction of the examined rows avoided by the index, from 0 to 1.
+	EstimatedBenefit float64
+}
+
+// Advise advises the indexes for the tables scanned without an index or sorted in the query plan.
+func Advise(engine storepb.Engine, metadata *model.DatabaseMetadata, query *Query) ([]*Advice, error) {
+	accesses, err := parsePlan(engine, query.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	var advices []*Advice
+	seen := make(map[string]bool)
+	for _, access := range accesses {
+		if !access.fullScan && len(access.sortColumns) == 0 {
+			continue
+		}
+		schemaName, table := resolveTable(metadata, access, query.SourceColumns)
+		if table == nil {
+			continue
+		}
+		tableName := table.GetProto().GetName()
+		advice := adviseTable(engine, schemaName, table, access, func(column string) bool {
+			if table.GetColumn(column) == nil {
+				return false
+			}
+			if len(query.SourceColumns) == 0 {
+				return true
+			}
+			for resource := range query.SourceColumns {
+				if resource.Schema == schemaName && resource.Table == tableName && resource.Column == column {
+					return true
+				}
+			}
+			return false
+		})
+		if advice == nil {
+			continue
+		}
+		// The self joins might advise the same index.
+		key := fmt.Sprintf("%s.%s:%s:%s", advice.Schema, advice.Table, advice.CurrentIndex, advice.CreateIndexStatement)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		advices = append(advices, advice)
+	}
+	return advices, nil
+}
+
+// resolveTable finds the table of the access in the metadata.
+// MySQL only reports the table alias, so the aliased table is looked up in the query span by the columns it uses.
+func resolveTable(metadata *model.DatabaseMetadata, access *tableAccess, sourceColumns base.SourceColumnSet) (string, *model.TableMetadata) {
+	schemaName := access.schema
+	if schemaName == "" {
+		for resource := range sourceColumns {
+			if resource.Table == access.table {
+				schemaName = resource.Schema
+				break
+			}
+		}
+	}
+	if schema := metadata.GetSchema(schemaName); schema != nil {
+		if table := schema.GetTable(access.table); table != nil {
+			return schemaName, table
+		}
+	}
+	if len(access.usedColumns) == 0 {
+		return "", nil
+	}
+
+	type tableKey struct {
+		schema string
+		table  string
+	}
+	columns := make(map[tableKey]map[string]bool)
+	for resource := range sourceColumns {
+		key := tableKey{schema: resource.Schema, table: resource.Table}
+		if columns[key] == nil {
+			columns[key] = make(map[string]bool)
+		}
+		columns[key][resource.Column] = true
+	}
+	var candidates []tableKey
+	for key, tableColumns := range columns {
+		matched := true
+		for _, column := range access.usedColumns {
+			if !tableColumns[column] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			candidates = append(candidates, key)
+		}
+	}
+	if len(candidates) != 1 {
+		return "", nil
+	}
+	schema := metadata.GetSchema(candidates[0].schema)
+	if schema == nil {
+		return "", nil
+	}
+	table := schema.GetTable(candidates[0].table)
+	if table == nil {
+		return "", nil
+	}
+	return candidates[0].schema, table
+}
+
+func adviseTable(engine storepb.Engine, schemaName string, table *model.TableMetadata, access *tableAccess, isColumn func(string) bool) *Advice {
+	equalityColumns := filterColumns(access.equalityColumns, isColumn)
+	sortColumns := filterColumns(access.sortColumns, isColumn)
+	rangeColumns := filterColumns(access.rangeColumns, isColumn)
+
+	// The key columns are the equality columns, followed by the sort columns and the first range column,
+	// since the index can't be used for the columns after a range scan.
+	columns := append([]string{}, equalityColumns...)
+	for _, column := range sortColumns {
+		if !containsColumn(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	for _, column := range rangeColumns {
+		if !containsColumn(columns, column) {
+			columns = append(columns, column)
+			break
+		}
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	if len(columns) > maxIndexColumns {
+		columns = columns[:maxIndexColumns]
+	}
+	equalityCount := min(len(equalityColumns), len(columns))
+
+	tableName := table.GetProto().GetName()
+	advice := &Advice{
+		Schema:       schemaName,
+		Table:        tableName,
+		RowsExamined: access.rowsExamined,
+		RowsReturned: access.rowsReturned,
+	}
+	if advice.RowsExamined == 0 {
+		advice.RowsExamined = table.GetRowCount()
+	}
+	if advice.RowsExamined > 0 && advice.RowsReturned < advice.RowsExamined {
+		advice.EstimatedBenefit = 1 - float64(advice.RowsReturned)/float64(advice.RowsExamined)
+	}
+	// The scan returning all the rows without sorting, such as the inner table of a hash join, doesn't benefit from the index.
+	if advice.RowsExamined > 0 && advice.EstimatedBenefit == 0 && len(sortColumns) == 0 {
+		return nil
+	}
+
+	var indexes []*storepb.IndexMetadata
+	for _, index := range table.GetProto().GetIndexes() {
+		if len(index.Expressions) > 0 && isBTreeIndex(index) {
+			indexes = append(indexes, index)
+		}
+	}
+	for _, index := range indexes {
+		if coversColumns(index.Expressions, columns, equalityCount) {
+			advice.Columns = columns
+			advice.CurrentIndex = index.Name
+			advice.Reason = fmt.Sprintf("The existing index %s covers the columns %s, but it's not used by the query plan. The table statistics might be stale or the filter is not selective.", index.Name, strings.Join(columns, ", "))
+			return advice
+		}
+	}
+
+	// Reorder the equality columns to start with the longest existing prefix index, so the suggested index supersedes it.
+	var redundantIndexes []string
+	var prefixIndex *storepb.IndexMetadata
+	for _, index := range indexes {
+		if isPrefixIndex(index.Expressions, columns, equalityCount) && (prefixIndex == nil || len(index.Expressions) > len(prefixIndex.Expressions)) {
+			prefixIndex = index
+		}
+	}
+	if prefixIndex != nil {
+		columns = reorderColumns(columns, prefixIndex.Expressions, equalityCount)
+		for _, index := range indexes {
+			if !index.Primary && !index.Unique && isPrefix(index.Expressions, columns) {
+				redundantIndexes = append(redundantIndexes, index.Name)
+			}
+		}
+	}
+	// The existing duplicated indexes are reported along with the advice of the same table.
+	for _, index := range indexes {
+		if index.Primary || index.Unique || containsColumn(redundantIndexes, index.Name) {
+			continue
+		}
+		for _, other := range indexes {
+			if other != index && isPrefix(index.Expressions, other.Expressions) && (len(index.Expressions) < len(other.Expressions) || index.Name > other.Name) {
+				redundantIndexes = append(redundantIndexes, index.Name)
+				break
+			}
+		}
+	}
+
+	advice.Columns = columns
+	advice.RedundantIndexes = redundantIndexes
+	advice.CreateIndexStatement = getCreateIndexStatement(engine, schemaName, tableName, getIndexName(table, tableName, columns), columns)
+	advice.Reason = getReason(access, equalityColumns, sortColumns, rangeColumns, redundantIndexes)
+	return advice
+}
+
+func getReason(access *tableAccess, equalityColumns, sortColumns, rangeColumns, redundantIndexes []string) string {
+	var reasons []string
+	if access.fullScan {
+		reasons = append(reasons, "The table is scanned without an index lookup.")
+	}
+	if len(equalityColumns) > 0 {
+		reasons = append(reasons, fmt.Sprintf("The columns %s are filtered by equality or joined.", strings.Join(equalityColumns, ", ")))
+	}
+	if len(sortColumns) > 0 {
+		reasons = append(reasons, fmt.Sprintf("The rows are sorted by %s.", strings.Join(sortColumns, ", ")))
+	}
+	if len(rangeColumns) > 0 {
+		reasons = append(reasons, fmt.Sprintf("The columns %s are filtered by range.", strings.Join(rangeColumns, ", ")))
+	}
+	if len(redundantIndexes) > 0 {
+		reasons = append(reasons, fmt.Sprintf("The indexes %s are redundant.", strings.Join(redundantIndexes, ", ")))
+	}
+	return strings.Join(reasons, " ")
+}
+
+func filterColumns(columns []string, isColumn func(string) bool) []string {
+	var result []string
+	for _, column := range columns {
+		if isColumn(column) {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+func containsColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if strings.EqualFold(c, column) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBTreeIndex(index *storepb.IndexMetadata) bool {
+	return index.Type == "" || strings.EqualFold(index.Type, "btree")
+}
+
+// coversColumns returns true if the index starts with the columns.
+// The first equalityCount columns can be in any order.
+func coversColumns(expressions []string, columns []string, equalityCount int) bool {
+	if len(expressions) < len(columns) {
+		return false
+	}
+	for i := 0; i < equalityCount; i++ {
+		if !containsColumn(columns[:equalityCount], expressions[i]) {
+			return false
+		}
+	}
+	return isPrefix(columns[equalityCount:], expressions[equalityCount:])
+}
+
+// isPrefixIndex returns true if the index is a prefix of the columns.
+// The first equalityCount columns can be in any order.
+func isPrefixIndex(expressions []string, columns []string, equalityCount int) bool {
+	if len(expressions) == 0 || len(expressions) > len(columns) {
+		return false
+	}
+	for i, expression := range expressions {
+		if i < equalityCount {
+			if !containsColumn(columns[:equalityCount], expression) {
+				return false
+			}
+		} else if !strings.EqualFold(expression, columns[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// reorderColumns moves the equality columns of the prefix index to the front in the same order.
+func reorderColumns(columns []string, prefix []string, equalityCount int) []string {
+	result := make([]string, 0, len(columns))
+	for _, expression := range prefix {
+		if len(result) == equalityCount {
+			break
+		}
+		result = append(result, expression)
+	}
+	for _, column := range columns[:equalityCount] {
+		if !containsColumn(result, column) {
+			result = append(result, column)
+		}
+	}
+	return append(result, columns[equalityCount:]...)
+}
+
+func isPrefix(prefix []string, columns []string) bool {
+	if len(prefix) > len(columns) {
+		return false
+	}
+	for i := range prefix {
+		if !strings.EqualFold(prefix[i], columns[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func getIndexName(table *model.TableMetadata, tableName string, columns []string) string {
+	name := fmt.Sprintf("idx_%s_%s", tableName, strings.Join(columns, "_"))
+	if len(name) > maxIndexNameLength {
+		name = name[:maxIndexNameLength]
+	}
+	candidate := name
+	for i := 1; table.GetIndex(candidate) != nil; i++ {
+		suffix := fmt.Sprintf("_%d", i)
+		candidate = name[:min(len(name), maxIndexNameLength-len(suffix))] + suffix
+	}
+	return candidate
+}
+
+func getCreateIndexStatement(engine storepb.Engine, schemaName, tableName, indexName string, columns []string) string {
+	quote := func(s string) string {
+		if engine == storepb.Engine_MYSQL {
+			return fmt.Sprintf("`%s`", strings.ReplaceAll(s, "`", "``"))
+		}
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `""`))
+	}
+	var quotedColumns []string
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, quote(column))
+	}
+	table := quote(tableName)
+	if schemaName != "" {
+		table = fmt.Sprintf("%s.%s", quote(schemaName), table)
+	}
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);", quote(indexName), table, strings.Join(quotedColumns, ", "))
+}
+
+// IsEngineSupported returns true if the query plan of the engine can be advised.
+func IsEngineSupported(engine storepb.Engine) bool {
+	return engine == storepb.Engine_POSTGRES || engine == storepb.Engine_MYSQL
+}
@@ -0,0 +1,180 @@
+package indexadvisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func newTestSourceColumns(database, schema string, tables map[string][]string) base.SourceColumnSet {
+	set := make(base.SourceColumnSet)
+	for table, columns := range tables {
+		for _, column := range columns {
+			set[base.ColumnResource{Database: database, Schema: schema, Table: table, Column: column}] = true
+		}
+	}
+	return set
+}
+
+func newTestTable(name string, rowCount int64, columns []string, indexes ...*storepb.IndexMetadata) *storepb.TableMetadata {
+	table := &storepb.TableMetadata{Name: name, RowCount: rowCount, Indexes: indexes}
+	for _, column := range columns {
+		table.Columns = append(table.Columns, &storepb.ColumnMetadata{Name: column})
+	}
+	return table
+}
+
+func TestAdvisePostgres(t *testing.T) {
+	plan := `[{"Plan": {"Node Type": "Sort", "Plan Rows": 10, "Sort Key": ["o.created_at"], "Plans": [
+		{"Node Type": "Hash Join", "Plan Rows": 10, "Hash Cond": "(o.customer_id = c.id)", "Plans": [
+			{"Node Type": "Seq Scan", "Relation Name": "orders", "Schema": "public", "Alias": "o", "Plan Rows": 10,
+				"Filter": "(((o.status)::text = 'paid AND unpaid'::text) AND (o.created_at > '2024-01-01 00:00:00'::timestamp without time zone))"},
+			{"Node Type": "Hash", "Plan Rows": 100, "Plans": [
+				{"Node Type": "Seq Scan", "Relation Name": "customers", "Schema": "public", "Alias": "c", "Plan Rows": 100}
+			]}
+		]}
+	]}}]`
+	metadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					newTestTable("orders", 10000, []string{"id", "customer_id", "status", "created_at"},
+						&storepb.IndexMetadata{Name: "orders_pkey", Expressions: []string{"id"}, Primary: true, Unique: true, Type: "btree"},
+						&storepb.IndexMetadata{Name: "idx_orders_customer_id", Expressions: []string{"customer_id"}, Type: "btree"},
+						&storepb.IndexMetadata{Name: "idx_orders_customer_id_dup", Expressions: []string{"customer_id"}, Type: "btree"},
+					),
+					newTestTable("customers", 100, []string{"id", "name"},
+						&storepb.IndexMetadata{Name: "customers_pkey", Expressions: []string{"id"}, Primary: true, Unique: true, Type: "btree"},
+					),
+				},
+			},
+		},
+	})
+	sourceColumns := newTestSourceColumns("db", "public", map[string][]string{
+		"orders":    {"id", "customer_id", "status", "created_at"},
+		"customers": {"id", "name"},
+	})
+
+	a := require.New(t)
+	advices, err := Advise(storepb.Engine_POSTGRES, metadata, &Query{Plan: plan, SourceColumns: sourceColumns})
+	a.NoError(err)
+	a.Len(advices, 1)
+	advice := advices[0]
+	a.Equal("public", advice.Schema)
+	a.Equal("orders", advice.Table)
+	a.Equal([]string{"customer_id", "status", "created_at"}, advice.Columns)
+	a.Equal(`CREATE INDEX "idx_orders_customer_id_status_created_at" ON "public"."orders" ("customer_id", "status", "created_at");`, advice.CreateIndexStatement)
+	a.Equal([]string{"idx_orders_customer_id", "idx_orders_customer_id_dup"}, advice.RedundantIndexes)
+	a.Equal(int64(10000), advice.RowsExamined)
+	a.Equal(int64(10), advice.RowsReturned)
+	a.InDelta(0.999, advice.EstimatedBenefit, 1e-9)
+}
+
+func TestAdviseMySQL(t *testing.T) {
+	plan := "{\"query_block\": {\"select_id\": 1, \"nested_loop\": [" +
+		"{\"table\": {\"table_name\": \"u\", \"access_type\": \"ALL\", \"rows_examined_per_scan\": 1000, \"rows_produced_per_join\": 10, " +
+		"\"used_columns\": [\"id\", \"email\", \"age\"], \"attached_condition\": \"((`db`.`u`.`email` = 'a@b.c') and (`db`.`u`.`age` between 18 and 30))\"}}," +
+		"{\"table\": {\"table_name\": \"p\", \"access_type\": \"ref\", \"key\": \"idx_posts_user_id\", \"rows_examined_per_scan\": 5, \"rows_produced_per_join\": 50, " +
+		"\"used_columns\": [\"id\", \"user_id\"]}}" +
+		"]}}"
+	metadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					newTestTable("users", 1000, []string{"id", "email", "age"},
+						&storepb.IndexMetadata{Name: "PRIMARY", Expressions: []string{"id"}, Primary: true, Unique: true, Type: "BTREE"},
+						&storepb.IndexMetadata{Name: "idx_users_email", Expressions: []string{"email"}, Type: "BTREE"},
+					),
+					newTestTable("posts", 5000, []string{"id", "user_id"},
+						&storepb.IndexMetadata{Name: "idx_posts_user_id", Expressions: []string{"user_id"}, Type: "BTREE"},
+					),
+				},
+			},
+		},
+	})
+	sourceColumns := newTestSourceColumns("db", "", map[string][]string{
+		"users": {"id", "email", "age"},
+		"posts": {"id", "user_id"},
+	})
+
+	a := require.New(t)
+	advices, err := Advise(storepb.Engine_MYSQL, metadata, &Query{Plan: plan, SourceColumns: sourceColumns})
+	a.NoError(err)
+	a.Len(advices, 1)
+	advice := advices[0]
+	a.Equal("users", advice.Table)
+	a.Equal("CREATE INDEX `idx_users_email_age` ON `users` (`email`, `age`);", advice.CreateIndexStatement)
+	a.Equal([]string{"idx_users_email"}, advice.RedundantIndexes)
+	a.InDelta(0.99, advice.EstimatedBenefit, 1e-9)
+
+	// The existing index covering the columns is reported instead of a new one.
+	metadata = model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					newTestTable("users", 1000, []string{"id", "email", "age"},
+						&storepb.IndexMetadata{Name: "idx_users_email_age", Expressions: []string{"email", "age", "id"}, Type: "BTREE"},
+					),
+				},
+			},
+		},
+	})
+	advices, err = Advise(storepb.Engine_MYSQL, metadata, &Query{Plan: plan, SourceColumns: sourceColumns})
+	a.NoError(err)
+	a.Len(advices, 1)
+	a.Equal("idx_users_email_age", advices[0].CurrentIndex)
+	a.Empty(advices[0].CreateIndexStatement)
+}
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		condition string
+		equality  []string
+		ranges    []string
+	}{
+		{
+			condition: "((a = 1) AND (b >= 2))",
+			equality:  []string{"a"},
+			ranges:    []string{"b"},
+		},
+		{
+			condition: "((a = 1) OR (b = 2))",
+		},
+		{
+			condition: "((lower((a)::text) = 'x'::text) AND (b = ANY ('{1,2}'::integer[])))",
+			equality:  []string{"b"},
+		},
+		{
+			condition: "(((a)::text ~~ '%x'::text) AND ((b)::text ~~ 'x%'::text) AND (c <> 1))",
+			ranges:    []string{"b"},
+		},
+		{
+			condition: "((`db`.`t`.`a` between 1 and 2) and (`db`.`t`.`b` is null))",
+			equality:  []string{"b"},
+			ranges:    []string{"a"},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		p := newPlanParser()
+		access := &tableAccess{table: "t"}
+		p.addAccess(access)
+		p.parseCondition(test.condition, access)
+		// The keywords are filtered by the table columns later.
+		a.Equal(test.equality, filterColumns(access.equalityColumns, isTestColumn), test.condition)
+		a.Equal(test.ranges, filterColumns(access.rangeColumns, isTestColumn), test.condition)
+	}
+}
+
+func isTestColumn(column string) bool {
+	return column == "a" || column == "b" || column == "c"
+}
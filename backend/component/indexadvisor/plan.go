@@ -0,0 +1,455 @@
+package indexadvisor
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	identifierPart = "(?:\"[^\"]+\"|`[^`]+`|[A-Za-z_][A-Za-z0-9_$]*)"
+	// columnRefRegexp matches the column references such as a, t.a, "t"."a" and `db`.`t`.`a`.
+	columnRefRegexp       = regexp.MustCompile(identifierPart + `(?:\.` + identifierPart + `)*`)
+	identifierPartRegexp  = regexp.MustCompile(identifierPart)
+	literalRegexp         = regexp.MustCompile(`'(?:[^']|'')*'`)
+	castRegexp            = regexp.MustCompile(`::[A-Za-z_][A-Za-z0-9_]*(?:\s+varying)?(?:\[\])?`)
+	functionCallRegexp    = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_$]*\(`)
+	leadingWildcardRegexp = regexp.MustCompile(`(?i)(?:LIKE|~~\*?)\s*'%`)
+	notEqualRegexp        = regexp.MustCompile(`<>|!=|!~~|(?i)\bNOT\b`)
+	equalityRegexp        = regexp.MustCompile(`(?i)(?:^|[^<>!])=(?:$|[^>])|\bIN\s*\(|\bIS\s+NULL\b`)
+	rangeRegexp           = regexp.MustCompile(`(?i)<|>|\bBETWEEN\b|\bLIKE\b|~~`)
+)
+
+// Explain returns the query plan of the statement in JSON format without running the statement.
+func Explain(ctx context.Context, engine storepb.Engine, sqlDB *sql.DB, statement string) (string, error) {
+	statement = strings.TrimRight(strings.TrimSpace(statement), ";")
+	var explain string
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		// VERBOSE qualifies the column references and shows the schema of the tables.
+		explain = fmt.Sprintf("EXPLAIN (VERBOSE, FORMAT JSON) %s", statement)
+	case storepb.Engine_MYSQL:
+		explain = fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement)
+	default:
+		return "", errors.Errorf("engine %v is not supported", engine)
+	}
+	var plan string
+	if err := sqlDB.QueryRowContext(ctx, explain).Scan(&plan); err != nil {
+		return "", errors.Wrapf(err, "failed to explain statement")
+	}
+	return plan, nil
+}
+
+type columnUsage int
+
+const (
+	usageEquality columnUsage = iota
+	usageRange
+	usageSort
+)
+
+// tableAccess is an access to a table in the query plan.
+type tableAccess struct {
+	schema string
+	table  string
+	alias  string
+	// fullScan is true if the rows of the table are read without an index lookup.
+	fullScan bool
+	index    string
+	// usedColumns are the columns read from the table, which are only reported by MySQL.
+	usedColumns []string
+
+	// equalityColumns are the columns filtered by equality or joined, in the order of appearance.
+	equalityColumns []string
+	rangeColumns    []string
+	sortColumns     []string
+
+	rowsExamined int64
+	rowsReturned int64
+}
+
+func (a *tableAccess) addColumn(usage columnUsage, column string) {
+	var columns *[]string
+	switch usage {
+	case usageEquality:
+		columns = &a.equalityColumns
+	case usageRange:
+		columns = &a.rangeColumns
+	case usageSort:
+		columns = &a.sortColumns
+	}
+	if !slices.Contains(*columns, column) {
+		*columns = append(*columns, column)
+	}
+}
+
+type planParser struct {
+	accesses []*tableAccess
+	// aliases maps the table alias, or the table name if it's not aliased, to the table access.
+	aliases map[string]*tableAccess
+}
+
+func newPlanParser() *planParser {
+	return &planParser{aliases: make(map[string]*tableAccess)}
+}
+
+func (p *planParser) addAccess(access *tableAccess) {
+	p.accesses = append(p.accesses, access)
+	if access.alias != "" {
+		p.aliases[access.alias] = access
+	}
+	if _, ok := p.aliases[access.table]; !ok {
+		p.aliases[access.table] = access
+	}
+}
+
+func parsePlan(engine storepb.Engine, plan string) ([]*tableAccess, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return parsePostgresPlan(plan)
+	case storepb.Engine_MYSQL:
+		return parseMySQLPlan(plan)
+	default:
+		return nil, errors.Errorf("engine %v is not supported", engine)
+	}
+}
+
+// pgPlanNode is the plan node of EXPLAIN (FORMAT JSON) in PostgreSQL.
+type pgPlanNode struct {
+	NodeType     string        `json:"Node Type"`
+	RelationName string        `json:"Relation Name"`
+	Schema       string        `json:"Schema"`
+	Alias        string        `json:"Alias"`
+	IndexName    string        `json:"Index Name"`
+	PlanRows     float64       `json:"Plan Rows"`
+	Filter       string        `json:"Filter"`
+	IndexCond    string        `json:"Index Cond"`
+	HashCond     string        `json:"Hash Cond"`
+	MergeCond    string        `json:"Merge Cond"`
+	JoinFilter   string        `json:"Join Filter"`
+	SortKey      []string      `json:"Sort Key"`
+	Plans        []*pgPlanNode `json:"Plans"`
+}
+
+func parsePostgresPlan(plan string) ([]*tableAccess, error) {
+	var explains []struct {
+		Plan *pgPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explains); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse query plan")
+	}
+	p := newPlanParser()
+	scans := make(map[*pgPlanNode]*tableAccess)
+	for _, explain := range explains {
+		p.collectPostgresScans(explain.Plan, scans)
+	}
+	// Parse the conditions after collecting all scans, so the references to any table alias can be resolved.
+	for _, explain := range explains {
+		p.parsePostgresConditions(explain.Plan, scans)
+	}
+	return p.accesses, nil
+}
+
+func (p *planParser) collectPostgresScans(node *pgPlanNode, scans map[*pgPlanNode]*tableAccess) {
+	if node == nil {
+		return
+	}
+	if node.RelationName != "" {
+		access := &tableAccess{
+			schema:       node.Schema,
+			table:        node.RelationName,
+			alias:        node.Alias,
+			fullScan:     node.NodeType == "Seq Scan",
+			index:        node.IndexName,
+			rowsReturned: int64(node.PlanRows),
+		}
+		p.addAccess(access)
+		scans[node] = access
+	}
+	for _, child := range node.Plans {
+		p.collectPostgresScans(child, scans)
+	}
+}
+
+func (p *planParser) parsePostgresConditions(node *pgPlanNode, scans map[*pgPlanNode]*tableAccess) {
+	if node == nil {
+		return
+	}
+	scope := scans[node]
+	p.parseCondition(node.Filter, scope)
+	p.parseCondition(node.IndexCond, scope)
+	p.parseCondition(node.HashCond, nil)
+	p.parseCondition(node.MergeCond, nil)
+	p.parseCondition(node.JoinFilter, nil)
+	if len(node.SortKey) > 0 {
+		// The unqualified sort keys can only be resolved if a single table is sorted.
+		var sortScope *tableAccess
+		if accesses := collectPostgresSubtreeScans(node, scans); len(accesses) == 1 {
+			sortScope = accesses[0]
+		}
+		for _, key := range node.SortKey {
+			p.parseSortKey(key, sortScope)
+		}
+	}
+	for _, child := range node.Plans {
+		p.parsePostgresConditions(child, scans)
+	}
+}
+
+func collectPostgresSubtreeScans(node *pgPlanNode, scans map[*pgPlanNode]*tableAccess) []*tableAccess {
+	var accesses []*tableAccess
+	if access, ok := scans[node]; ok {
+		accesses = append(accesses, access)
+	}
+	for _, child := range node.Plans {
+		accesses = append(accesses, collectPostgresSubtreeScans(child, scans)...)
+	}
+	return accesses
+}
+
+// mysqlTable is the table of EXPLAIN FORMAT=JSON in MySQL.
+type mysqlTable struct {
+	TableName           string   `json:"table_name"`
+	AccessType          string   `json:"access_type"`
+	Key                 string   `json:"key"`
+	UsedColumns         []string `json:"used_columns"`
+	RowsExaminedPerScan int64    `json:"rows_examined_per_scan"`
+	RowsProducedPerJoin int64    `json:"rows_produced_per_join"`
+	AttachedCondition   string   `json:"attached_condition"`
+}
+
+func parseMySQLPlan(plan string) ([]*tableAccess, error) {
+	var root any
+	if err := json.Unmarshal([]byte(plan), &root); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse query plan")
+	}
+	var tables []*mysqlTable
+	if err := collectMySQLTables(root, &tables); err != nil {
+		return nil, err
+	}
+
+	p := newPlanParser()
+	for _, table := range tables {
+		p.addAccess(&tableAccess{
+			// MySQL reports the alias as the table name.
+			table: table.TableName,
+			alias: table.TableName,
+			// The index access type is a full scan of the index tree.
+			fullScan:     table.AccessType == "ALL" || table.AccessType == "index",
+			index:        table.Key,
+			usedColumns:  table.UsedColumns,
+			rowsExamined: table.RowsExaminedPerScan,
+			rowsReturned: table.RowsProducedPerJoin,
+		})
+	}
+	for i, table := range tables {
+		p.parseCondition(table.AttachedCondition, p.accesses[i])
+	}
+	return p.accesses, nil
+}
+
+// collectMySQLTables collects the tables in the order of the plan, including the tables in the subqueries.
+func collectMySQLTables(value any, tables *[]*mysqlTable) error {
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if tableValue, ok := value[key].(map[string]any); ok && key == "table" {
+				bytes, err := json.Marshal(tableValue)
+				if err != nil {
+					return err
+				}
+				var table mysqlTable
+				if err := json.Unmarshal(bytes, &table); err != nil {
+					return errors.Wrapf(err, "failed to parse table in query plan")
+				}
+				*tables = append(*tables, &table)
+			}
+			if err := collectMySQLTables(value[key], tables); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, item := range value {
+			if err := collectMySQLTables(item, tables); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseCondition collects the column usages in the condition.
+// The unqualified columns are resolved to the scope table, which is nil for the join conditions.
+func (p *planParser) parseCondition(condition string, scope *tableAccess) {
+	if condition == "" {
+		return
+	}
+	for _, conjunct := range splitTopLevel(trimParentheses(condition), "and") {
+		conjunct = trimParentheses(conjunct)
+		// Neither the disjunctions nor the expressions on the columns can use a plain index.
+		if len(splitTopLevel(conjunct, "or")) > 1 || leadingWildcardRegexp.MatchString(conjunct) {
+			continue
+		}
+		normalized := castRegexp.ReplaceAllString(literalRegexp.ReplaceAllString(conjunct, "?"), "")
+		if functionCallRegexp.MatchString(normalized) || notEqualRegexp.MatchString(normalized) {
+			continue
+		}
+
+		type columnRef struct {
+			access *tableAccess
+			column string
+		}
+		var refs []columnRef
+		accesses := make(map[*tableAccess]bool)
+		for _, text := range columnRefRegexp.FindAllString(normalized, -1) {
+			access, column := p.resolveColumn(text, scope)
+			if access == nil {
+				continue
+			}
+			refs = append(refs, columnRef{access: access, column: column})
+			accesses[access] = true
+		}
+		if len(refs) == 0 {
+			continue
+		}
+
+		var usage columnUsage
+		switch {
+		case equalityRegexp.MatchString(normalized):
+			usage = usageEquality
+		case rangeRegexp.MatchString(normalized):
+			// The join on the inequality can still use the index to look up the range.
+			usage = usageRange
+		default:
+			continue
+		}
+		if len(accesses) > 1 && usage != usageEquality {
+			continue
+		}
+		for _, ref := range refs {
+			ref.access.addColumn(usage, ref.column)
+		}
+	}
+}
+
+// parseSortKey collects the sort column such as "t.a DESC" or "a".
+func (p *planParser) parseSortKey(key string, scope *tableAccess) {
+	key = trimParentheses(castRegexp.ReplaceAllString(key, ""))
+	if fields := strings.Fields(key); len(fields) > 0 {
+		key = fields[0]
+	}
+	if columnRefRegexp.FindString(key) != key {
+		return
+	}
+	if access, column := p.resolveColumn(key, scope); access != nil {
+		access.addColumn(usageSort, column)
+	}
+}
+
+func (p *planParser) resolveColumn(text string, scope *tableAccess) (*tableAccess, string) {
+	parts := identifierPartRegexp.FindAllString(text, -1)
+	for i, part := range parts {
+		parts[i] = unquoteIdentifier(part)
+	}
+	if len(parts) == 1 {
+		return scope, parts[0]
+	}
+	// The qualifier right before the column is the table alias, e.g. db.t.a in MySQL.
+	return p.aliases[parts[len(parts)-2]], parts[len(parts)-1]
+}
+
+func unquoteIdentifier(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '`') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// trimParentheses removes the parentheses wrapping the whole expression.
+func trimParentheses(s string) string {
+	for {
+		s = strings.TrimSpace(s)
+		if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+			return s
+		}
+		depth := 0
+		for i := 0; i < len(s); i++ {
+			switch s[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			// The first parenthesis is closed before the end, e.g. (a = 1) AND (b = 2).
+			if depth == 0 && i < len(s)-1 {
+				return s
+			}
+		}
+		s = s[1 : len(s)-1]
+	}
+}
+
+// splitTopLevel splits the expression by the keyword outside the parentheses and the quotes.
+// The AND of BETWEEN x AND y is not a separator.
+func splitTopLevel(s string, keyword string) []string {
+	separator := " " + keyword + " "
+	var result []string
+	var quote byte
+	depth, start := 0, 0
+	inBetween := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			continue
+		case c == '(':
+			depth++
+			continue
+		case c == ')':
+			depth--
+			continue
+		}
+		if depth != 0 {
+			continue
+		}
+		if hasKeywordAt(s, i, " between ") {
+			inBetween = true
+			continue
+		}
+		if !hasKeywordAt(s, i, separator) {
+			continue
+		}
+		if keyword == "and" && inBetween {
+			inBetween = false
+			continue
+		}
+		result = append(result, s[start:i])
+		start = i + len(separator)
+		i = start - 1
+	}
+	return append(result, s[start:])
+}
+
+func hasKeywordAt(s string, i int, keyword string) bool {
+	return i+len(keyword) <= len(s) && strings.EqualFold(s[i:i+len(keyword)], keyword)
+}
@@ -2,7 +2,6 @@ package secret
 
 import (
 	"context"
-	"encoding/json"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -44,13 +43,5 @@ func getSecretFromAWS(ctx context.Context, externalSecret *storepb.DataSourceExt
 		return "", errors.Errorf("empty secret string")
 	}
 
-	dataMap := make(map[string]any)
-	if err := json.Unmarshal([]byte(*secret.SecretString), &dataMap); err != nil {
-		return "", errors.Wrapf(err, "failed to unmarshal aws secret string")
-	}
-	val, ok := dataMap[externalSecret.PasswordKeyName].(string)
-	if !ok {
-		return "", errors.Errorf("cannot get value for %s, please make sure the secret exists", externalSecret.PasswordKeyName)
-	}
-	return val, nil
+	return getValueFromJSON(*secret.SecretString, externalSecret.PasswordKeyName)
 }
@@ -0,0 +1,42 @@
+package secret
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func getSecretFromAzure(ctx context.Context, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	// The default credential chain covers the managed identity on Azure VMs and App Service,
+	// the workload identity on AKS, and the AZURE_* environment variables.
+	// ref: https://learn.microsoft.com/en-us/azure/developer/go/azure-sdk-authentication
+	credential, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get Azure credential")
+	}
+	client, err := azsecrets.NewClient(externalSecret.Url, credential, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create Azure Key Vault client")
+	}
+
+	// An empty version means the latest version.
+	resp, err := client.GetSecret(ctx, externalSecret.SecretName, "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "SecretNotFound") {
+			return "", errors.Wrapf(err, "cannot found secret %s", externalSecret.SecretName)
+		}
+		return "", errors.Wrapf(err, "failed to get Azure Key Vault secret %s", externalSecret.SecretName)
+	}
+	if resp.Value == nil {
+		return "", errors.Errorf("empty secret value")
+	}
+	if externalSecret.PasswordKeyName == "" {
+		return *resp.Value, nil
+	}
+	return getValueFromJSON(*resp.Value, externalSecret.PasswordKeyName)
+}
@@ -0,0 +1,116 @@
+package secret
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	backendURL   = "url"
+	backendAWS   = "aws"
+	backendGCP   = "gcp"
+	backendVault = "vault"
+	backendAzure = "azure"
+)
+
+const fetchTimeout = 30 * time.Second
+
+// cacheTTLs is the time-to-live of the cached secrets per backend.
+// A rotated secret is picked up once the cached value expires, or immediately on authentication failure.
+var cacheTTLs = map[string]time.Duration{
+	backendURL:   5 * time.Minute,
+	backendAWS:   5 * time.Minute,
+	backendGCP:   5 * time.Minute,
+	backendVault: 5 * time.Minute,
+	backendAzure: 5 * time.Minute,
+}
+
+var fetchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "bb_external_secret_fetch_duration_seconds",
+	Help:    "The latency of fetching secrets from external secret backends.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"backend", "status"})
+
+var defaultCache = newCache()
+
+type cacheEntry struct {
+	value    string
+	expireAt time.Time
+}
+
+// cache is a concurrency-safe TTL cache of the external secrets.
+// Concurrent fetches of the same secret are de-duplicated.
+type cache struct {
+	mu      sync.RWMutex
+	entries map[string]*cacheEntry
+	group   singleflight.Group
+}
+
+func newCache() *cache {
+	return &cache{
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+func (c *cache) get(ctx context.Context, backend, key string, fetch func(context.Context) (string, error)) (string, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(entry.expireAt) {
+		return entry.value, nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		// The fetch is shared by all waiters, so it must not be canceled by the first caller.
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		defer cancel()
+		start := time.Now()
+		value, err := fetch(fetchCtx)
+		status := "success"
+		if err != nil {
+			status = "failure"
+		}
+		fetchDuration.WithLabelValues(backend, status).Observe(time.Since(start).Seconds())
+		if err != nil {
+			return "", err
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.entries[key] = &cacheEntry{
+			value:    value,
+			expireAt: time.Now().Add(cacheTTLs[backend]),
+		}
+		return value, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.(string)
+	if !ok {
+		return "", errors.Errorf("unexpected secret type %T", v)
+	}
+	return value, nil
+}
+
+func (c *cache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func getExternalSecretCacheKey(externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(externalSecret)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal external secret")
+	}
+	return string(b), nil
+}
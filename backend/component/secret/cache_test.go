@@ -0,0 +1,58 @@
+package secret
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	c := newCache()
+
+	var fetchCount atomic.Int32
+	fetch := func(context.Context) (string, error) {
+		fetchCount.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return "password", nil
+	}
+
+	// The results are checked in the test goroutine since FailNow cannot stop the test from the other goroutines.
+	type result struct {
+		value string
+		err   error
+	}
+	results := make(chan result, 10)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := c.get(ctx, backendURL, "key", fetch)
+			results <- result{value: v, err: err}
+		}()
+	}
+	wg.Wait()
+	close(results)
+	for r := range results {
+		a.NoError(r.err)
+		a.Equal("password", r.value)
+	}
+	a.Equal(int32(1), fetchCount.Load())
+
+	// Cached.
+	_, err := c.get(ctx, backendURL, "key", fetch)
+	a.NoError(err)
+	a.Equal(int32(1), fetchCount.Load())
+
+	// Refetched after invalidation.
+	c.invalidate("key")
+	_, err = c.get(ctx, backendURL, "key", fetch)
+	a.NoError(err)
+	a.Equal(int32(2), fetchCount.Load())
+}
@@ -0,0 +1,125 @@
+package secret
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// fileSecrets holds the secrets mounted as files, e.g. by Kubernetes secret volumes or the CSI secret store driver.
+var fileSecrets = &fileSecretStore{values: make(map[string]string)}
+
+type fileSecretStore struct {
+	mu sync.RWMutex
+	// dir is the directory of the mounted secrets. The file backend is disabled if empty.
+	dir    string
+	values map[string]string
+	// generation is bumped on every change so that a value read before the change is not cached.
+	generation int
+}
+
+// WatchSecretDirectory enables the file backend with the secrets mounted in dir and drops the
+// loaded secrets whenever the directory changes until ctx is done.
+// Kubernetes updates the mounted secrets by swapping the ..data symlink in the directory.
+func WatchSecretDirectory(ctx context.Context, dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return errors.Wrapf(err, "invalid secret directory %q", dir)
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrapf(err, "failed to create secret directory watcher")
+	}
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return errors.Wrapf(err, "failed to watch secret directory %q", dir)
+	}
+	fileSecrets.mu.Lock()
+	fileSecrets.dir = dir
+	fileSecrets.values = make(map[string]string)
+	fileSecrets.generation++
+	fileSecrets.mu.Unlock()
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				slog.Debug("secret directory changed", slog.String("event", event.String()))
+				fileSecrets.reset()
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				slog.Warn("secret directory watcher error", log.BBError(err))
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *fileSecretStore) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values = make(map[string]string)
+	s.generation++
+}
+
+func (s *fileSecretStore) get(name string) (string, error) {
+	s.mu.RLock()
+	dir := s.dir
+	value, ok := s.values[name]
+	generation := s.generation
+	s.mu.RUnlock()
+	if dir == "" {
+		return "", errors.New("file secrets are disabled, please start Bytebase with --secret-dir")
+	}
+	if ok {
+		return value, nil
+	}
+
+	// Only the files directly in the directory can be referenced.
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", errors.Errorf("invalid secret file name %q", name)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Errorf("cannot find secret file %s", name)
+		}
+		return "", errors.Wrapf(err, "failed to read secret file %s", name)
+	}
+	value = strings.TrimRight(string(content), "\r\n")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation == generation {
+		s.values[name] = value
+	}
+	return value, nil
+}
+
+func getSecretFromFile(externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	value, err := fileSecrets.get(externalSecret.SecretName)
+	if err != nil {
+		return "", err
+	}
+	if externalSecret.PasswordKeyName == "" {
+		return value, nil
+	}
+	return getValueFromJSON(value, externalSecret.PasswordKeyName)
+}
@@ -0,0 +1,41 @@
+package secret
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetSecretFromFile(t *testing.T) {
+	a := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	a.NoError(os.WriteFile(filepath.Join(dir, "password"), []byte("secret1\n"), 0600))
+	a.NoError(os.WriteFile(filepath.Join(dir, "credential"), []byte(`{"password": "secret2"}`), 0600))
+
+	a.NoError(WatchSecretDirectory(ctx, dir))
+	v, err := getSecretFromFile(&storepb.DataSourceExternalSecret{SecretName: "password"})
+	a.NoError(err)
+	a.Equal("secret1", v)
+	v, err = getSecretFromFile(&storepb.DataSourceExternalSecret{SecretName: "credential", PasswordKeyName: "password"})
+	a.NoError(err)
+	a.Equal("secret2", v)
+	for _, name := range []string{"", "../password", "sub/password", "..data"} {
+		_, err := getSecretFromFile(&storepb.DataSourceExternalSecret{SecretName: name})
+		a.Error(err, name)
+	}
+
+	// The rotated secret is picked up once the directory changes.
+	a.NoError(os.WriteFile(filepath.Join(dir, "password"), []byte("secret3\n"), 0600))
+	a.Eventually(func() bool {
+		v, err := getSecretFromFile(&storepb.DataSourceExternalSecret{SecretName: "password"})
+		return err == nil && v == "secret3"
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -14,39 +14,100 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
-var secretCache = make(map[string]string)
-
 // ReplaceExternalSecret replaces the secret with external secret.
+// The external secrets are cached with a per-backend TTL.
 func ReplaceExternalSecret(ctx context.Context, secret string, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
+	if IsDynamicCredential(externalSecret) {
+		return "", errors.Errorf("dynamic credentials must be leased with GetDynamicCredential")
+	}
 	if externalSecret != nil {
-		// TODO: consider cache?
-		switch externalSecret.SecretType {
-		case storepb.DataSourceExternalSecret_AWS_SECRETS_MANAGER:
+		// The file secrets are reloaded on changes instead of expiring.
+		if externalSecret.SecretType == storepb.DataSourceExternalSecret_FILE {
+			return getSecretFromFile(externalSecret)
+		}
+		backend, fetch := getExternalSecretFetcher(externalSecret)
+		if fetch != nil {
+			key, err := getExternalSecretCacheKey(externalSecret)
+			if err != nil {
+				return "", err
+			}
+			return defaultCache.get(ctx, backend, key, fetch)
+		}
+	}
+
+	return getSecretFromText(ctx, secret)
+}
+
+// InvalidateExternalSecret drops the cached value of the external secret so that the next
+// ReplaceExternalSecret fetches it again, e.g. after the database rejects a rotated password.
+// It returns false if the secret is not an external secret.
+func InvalidateExternalSecret(secret string, externalSecret *storepb.DataSourceExternalSecret) bool {
+	if externalSecret != nil {
+		if externalSecret.SecretType == storepb.DataSourceExternalSecret_FILE {
+			fileSecrets.reset()
+			return true
+		}
+		if _, fetch := getExternalSecretFetcher(externalSecret); fetch != nil {
+			key, err := getExternalSecretCacheKey(externalSecret)
+			if err != nil {
+				return false
+			}
+			defaultCache.invalidate(key)
+			return true
+		}
+	}
+	ok, secretURL := GetExternalSecretURL(secret)
+	if !ok {
+		return false
+	}
+	defaultCache.invalidate(secretURL)
+	return true
+}
+
+func getExternalSecretFetcher(externalSecret *storepb.DataSourceExternalSecret) (string, func(context.Context) (string, error)) {
+	switch externalSecret.SecretType {
+	case storepb.DataSourceExternalSecret_AWS_SECRETS_MANAGER:
+		return backendAWS, func(ctx context.Context) (string, error) {
 			return getSecretFromAWS(ctx, externalSecret)
-		case storepb.DataSourceExternalSecret_VAULT_KV_V2:
+		}
+	case storepb.DataSourceExternalSecret_VAULT_KV_V2:
+		return backendVault, func(ctx context.Context) (string, error) {
 			return getSecretFromVault(ctx, externalSecret)
-		case storepb.DataSourceExternalSecret_GCP_SECRET_MANAGER:
+		}
+	case storepb.DataSourceExternalSecret_GCP_SECRET_MANAGER:
+		return backendGCP, func(ctx context.Context) (string, error) {
 			return getSecretFromGCP(ctx, externalSecret)
 		}
+	case storepb.DataSourceExternalSecret_AZURE_KEY_VAULT:
+		return backendAzure, func(ctx context.Context) (string, error) {
+			return getSecretFromAzure(ctx, externalSecret)
+		}
+	default:
+		return "", nil
 	}
+}
 
-	return getSecretFromText(secret)
+// getValueFromJSON gets the value of the key from the secret stored as a JSON object.
+func getValueFromJSON(secret string, key string) (string, error) {
+	dataMap := make(map[string]any)
+	if err := json.Unmarshal([]byte(secret), &dataMap); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal secret string")
+	}
+	val, ok := dataMap[key].(string)
+	if !ok {
+		return "", errors.Errorf("cannot get value for %s, please make sure the secret exists", key)
+	}
+	return val, nil
 }
 
-func getSecretFromText(secret string) (string, error) {
+func getSecretFromText(ctx context.Context, secret string) (string, error) {
 	ok, secretURL := GetExternalSecretURL(secret)
 	if !ok {
 		return secret, nil
 	}
-	if v, ok := secretCache[secretURL]; ok {
-		return v, nil
-	}
-	secret, err := getSecretFromURL(secretURL)
-	if err != nil {
-		return "", err
-	}
-	secretCache[secretURL] = secret
-	return secret, err
+	return defaultCache.get(ctx, backendURL, secretURL, func(ctx context.Context) (string, error) {
+		return getSecretFromURL(ctx, secretURL)
+	})
 }
 
 // GetExternalSecretURL gets external secret URL from secret.
@@ -72,8 +133,12 @@ type accessResponse struct {
 	Payload payload `json:"payload"`
 }
 
-func getSecretFromURL(secretURL string) (string, error) {
-	response, err := http.Get(secretURL)
+func getSecretFromURL(ctx context.Context, secretURL string) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create request for %q", secretURL)
+	}
+	response, err := http.DefaultClient.Do(request)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to get secret from %q", secretURL)
 	}
@@ -0,0 +1,129 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	vault "github.com/hashicorp/vault/api"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const revokeTimeout = 10 * time.Second
+
+// DynamicCredential is a short-lived database credential leased from the Vault database secrets engine.
+// The lease is renewed in the background until Release is called.
+type DynamicCredential struct {
+	Username string
+	Password string
+
+	client      *vault.Client
+	leaseID     string
+	watcher     *vault.LifetimeWatcher
+	releaseOnce sync.Once
+	// done is closed once the lease stops renewing, either released, expired or failed to renew.
+	// Vault drops the database user when the lease expires, so the next connection leases a new credential.
+	done chan struct{}
+}
+
+// IsDynamicCredential returns whether the external secret leases a dynamic credential per connection.
+func IsDynamicCredential(externalSecret *storepb.DataSourceExternalSecret) bool {
+	return externalSecret != nil && externalSecret.SecretType == storepb.DataSourceExternalSecret_VAULT_DATABASE
+}
+
+// GetDynamicCredential leases a new credential from the Vault database secrets engine.
+// Caller must call Release once the connection is closed, otherwise the lease lives until its TTL.
+func GetDynamicCredential(ctx context.Context, externalSecret *storepb.DataSourceExternalSecret) (*DynamicCredential, error) {
+	if !IsDynamicCredential(externalSecret) {
+		return nil, errors.Errorf("unexpected secret type %q for dynamic credentials", externalSecret.GetSecretType())
+	}
+	client, err := getVaultClient(ctx, externalSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("%s/creds/%s", strings.Trim(externalSecret.EngineName, "/"), externalSecret.SecretName)
+	start := time.Now()
+	secret, err := client.Logical().ReadWithContext(ctx, path)
+	status := "success"
+	if err != nil {
+		status = "failure"
+	}
+	fetchDuration.WithLabelValues(backendVault, status).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get vault database credential from %q", path)
+	}
+	if secret == nil {
+		return nil, errors.Errorf("vault database role %q not found", path)
+	}
+	username, ok := secret.Data["username"].(string)
+	if !ok {
+		return nil, errors.Errorf("missing username in vault database credential %q", path)
+	}
+	password, ok := secret.Data["password"].(string)
+	if !ok {
+		return nil, errors.Errorf("missing password in vault database credential %q", path)
+	}
+
+	credential := &DynamicCredential{
+		Username: username,
+		Password: password,
+		client:   client,
+		leaseID:  secret.LeaseID,
+		done:     make(chan struct{}),
+	}
+	if secret.Renewable {
+		watcher, err := client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{Secret: secret})
+		if err != nil {
+			credential.Release(ctx)
+			return nil, errors.Wrapf(err, "failed to create vault lease watcher")
+		}
+		credential.watcher = watcher
+		go credential.watch()
+	} else {
+		close(credential.done)
+	}
+	return credential, nil
+}
+
+// watch renews the lease until it reaches its max TTL or the credential is released.
+func (c *DynamicCredential) watch() {
+	defer close(c.done)
+	go c.watcher.Start()
+	for {
+		select {
+		case err := <-c.watcher.DoneCh():
+			if err != nil {
+				slog.Warn("failed to renew vault database credential lease", slog.String("lease", c.leaseID), log.BBError(err))
+			}
+			return
+		case renewal := <-c.watcher.RenewCh():
+			slog.Debug("renewed vault database credential lease", slog.String("lease", c.leaseID), slog.Int("duration", renewal.Secret.LeaseDuration))
+		}
+	}
+}
+
+// Release stops renewing and revokes the lease so that Vault drops the database user.
+func (c *DynamicCredential) Release(ctx context.Context) {
+	c.releaseOnce.Do(func() {
+		if c.watcher != nil {
+			c.watcher.Stop()
+		}
+		if c.leaseID == "" {
+			return
+		}
+		// Revoke even if the caller is going away.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), revokeTimeout)
+		defer cancel()
+		if err := c.client.Sys().RevokeWithContext(ctx, c.leaseID); err != nil {
+			slog.Warn("failed to revoke vault database credential lease", slog.String("lease", c.leaseID), log.BBError(err))
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// fakeVault is the Vault database secrets engine issuing the leases of one second.
+type fakeVault struct {
+	mu         sync.Mutex
+	issued     int
+	renewals   map[string]int
+	revoked    map[string]int
+	failRenew  bool
+	renewable  bool
+	unexpected []string
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, *storepb.DataSourceExternalSecret) {
+	v := &fakeVault{
+		renewals:  map[string]int{},
+		revoked:   map[string]int{},
+		renewable: true,
+	}
+	server := httptest.NewServer(v)
+	t.Cleanup(server.Close)
+	return v, &storepb.DataSourceExternalSecret{
+		SecretType: storepb.DataSourceExternalSecret_VAULT_DATABASE,
+		Url:        server.URL,
+		AuthType:   storepb.DataSourceExternalSecret_TOKEN,
+		AuthOption: &storepb.DataSourceExternalSecret_Token{Token: "root"},
+		EngineName: "/database/",
+		SecretName: "readonly",
+	}
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if r.Header.Get("X-Vault-Token") != "root" {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+		return
+	}
+	var body struct {
+		LeaseID string `json:"lease_id"`
+	}
+	if r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /v1/database/creds/readonly":
+		v.issued++
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"lease_id":       fmt.Sprintf("database/creds/readonly/%d", v.issued),
+			"lease_duration": 1,
+			"renewable":      v.renewable,
+			"data": map[string]any{
+				"username": fmt.Sprintf("v-readonly-%d", v.issued),
+				"password": "password",
+			},
+		})
+	case "PUT /v1/sys/leases/renew":
+		if v.failRenew || v.revoked[body.LeaseID] > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors": ["lease not found"]}`))
+			return
+		}
+		v.renewals[body.LeaseID]++
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"lease_id":       body.LeaseID,
+			"lease_duration": 1,
+			"renewable":      true,
+		})
+	case "PUT /v1/sys/leases/revoke":
+		v.revoked[body.LeaseID]++
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		v.unexpected = append(v.unexpected, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (v *fakeVault) getRenewals(leaseID string) int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.renewals[leaseID]
+}
+
+func (v *fakeVault) getRevoked(leaseID string) int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.revoked[leaseID]
+}
+
+func TestDynamicCredentialLease(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	vault, externalSecret := newFakeVault(t)
+
+	credential, err := GetDynamicCredential(ctx, externalSecret)
+	a.NoError(err)
+	a.Equal("v-readonly-1", credential.Username)
+	a.Equal("password", credential.Password)
+	a.Equal("database/creds/readonly/1", credential.leaseID)
+
+	// The lease of one second is renewed before it expires, so it outlives the initial TTL.
+	a.Eventually(func() bool { return vault.getRenewals(credential.leaseID) >= 3 }, 5*time.Second, 50*time.Millisecond)
+	select {
+	case <-credential.done:
+		a.Fail("the lease stops renewing before release")
+	default:
+	}
+
+	credential.Release(ctx)
+	<-credential.done
+	a.Equal(1, vault.getRevoked(credential.leaseID))
+	renewals := vault.getRenewals(credential.leaseID)
+	// Releasing again doesn't revoke again.
+	credential.Release(ctx)
+	time.Sleep(1500 * time.Millisecond)
+	a.Equal(1, vault.getRevoked(credential.leaseID))
+	a.Equal(renewals, vault.getRenewals(credential.leaseID))
+	a.Empty(vault.unexpected)
+}
+
+func TestDynamicCredentialNotRenewable(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	vault, externalSecret := newFakeVault(t)
+	vault.renewable = false
+
+	credential, err := GetDynamicCredential(ctx, externalSecret)
+	a.NoError(err)
+	<-credential.done
+	credential.Release(ctx)
+	a.Equal(0, vault.getRenewals(credential.leaseID))
+	a.Equal(1, vault.getRevoked(credential.leaseID))
+}
+
+func TestDynamicCredentialRenewalFailure(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	vault, externalSecret := newFakeVault(t)
+	vault.failRenew = true
+
+	credential, err := GetDynamicCredential(ctx, externalSecret)
+	a.NoError(err)
+	// The watcher gives up once the lease expires.
+	select {
+	case <-credential.done:
+	case <-time.After(5 * time.Second):
+		a.FailNow("the lease keeps renewing after it expires")
+	}
+	a.Equal(0, vault.getRenewals(credential.leaseID))
+
+	// The next connection leases a new credential.
+	vault.mu.Lock()
+	vault.failRenew = false
+	vault.mu.Unlock()
+	leased, err := GetDynamicCredential(ctx, externalSecret)
+	a.NoError(err)
+	defer leased.Release(ctx)
+	a.Equal("v-readonly-2", leased.Username)
+	a.Eventually(func() bool { return vault.getRenewals(leased.leaseID) >= 1 }, 5*time.Second, 50*time.Millisecond)
+
+	// The expired lease is still revoked on close.
+	credential.Release(ctx)
+	a.Equal(1, vault.getRevoked(credential.leaseID))
+	a.Equal(0, vault.getRevoked(leased.leaseID))
+}
+
+func TestGetDynamicCredentialError(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	_, externalSecret := newFakeVault(t)
+
+	externalSecret.SecretName = "missing"
+	_, err := GetDynamicCredential(ctx, externalSecret)
+	a.ErrorContains(err, "database/creds/missing")
+
+	externalSecret.SecretType = storepb.DataSourceExternalSecret_VAULT_KV_V2
+	_, err = GetDynamicCredential(ctx, externalSecret)
+	a.ErrorContains(err, "unexpected secret type")
+}
@@ -234,6 +234,8 @@ func syntaxCheck(dbType storepb.Engine, statement string) (any, []*storepb.Advic
 		return partiqlSyntaxCheck(statement)
 	case storepb.Engine_COCKROACHDB:
 		return cockroachdbSyntaxCheck(statement)
+	case storepb.Engine_DB2:
+		return db2SyntaxCheck(statement)
 	}
 	return nil, []*storepb.Advice{
 		{
@@ -272,6 +274,40 @@ func cockroachdbSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	return result.Stmts, nil
 }
 
+// db2SyntaxCheck only checks the statements can be split, and the split statements are used for the checks.
+// The Db2 parser is registered only with the db2 build tag, so it's called by the registered splitter.
+func db2SyntaxCheck(statement string) (any, []*storepb.Advice) {
+	list, err := base.SplitMultiSQL(storepb.Engine_DB2, statement)
+	if err != nil {
+		if syntaxErr, ok := err.(*base.SyntaxError); ok {
+			return nil, []*storepb.Advice{
+				{
+					Status:  storepb.Advice_WARNING,
+					Code:    StatementSyntaxErrorCode,
+					Title:   SyntaxErrorTitle,
+					Content: syntaxErr.Message,
+					StartPosition: &storepb.Position{
+						Line:   int32(syntaxErr.Line),
+						Column: int32(syntaxErr.Column),
+					},
+				},
+			}
+		}
+		return nil, []*storepb.Advice{
+			{
+				Status:  storepb.Advice_WARNING,
+				Code:    InternalErrorCode,
+				Title:   "Parse error",
+				Content: err.Error(),
+				StartPosition: &storepb.Position{
+					Line: 1,
+				},
+			},
+		}
+	}
+	return base.FilterEmptySQL(list), nil
+}
+
 func partiqlSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	result, err := partiqlparser.ParsePartiQL(statement)
 	if err != nil {
@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// deliveryInterval is the interval to poll the due deliveries from the outbox.
+	deliveryInterval = 5 * time.Second
+	// deliveryLease is how long a claimed delivery is hidden from the other claims.
+	// An interrupted delivery is retried once the lease expires.
+	deliveryLease     = 2 * time.Minute
+	deliveryBatchSize = 20
+	// maxDeliveryAttempts is the number of attempts before a delivery is given up.
+	// The attempts span about an hour and a half with the exponential backoff.
+	maxDeliveryAttempts    = 10
+	deliveryInitialBackoff = 10 * time.Second
+	deliveryMaxBackoff     = 1 * time.Hour
+	// deliveryRetention is how long the finished deliveries are kept in the delivery log.
+	deliveryRetention = 30 * 24 * time.Hour
+)
+
+// deliveryEvent is the webhook context persisted with a delivery.
+type deliveryEvent struct {
+	webhook.Context
+	// MentionEndUserIDs are the IDs of the mentioned end users, which are loaded again on delivery.
+	MentionEndUserIDs []int `json:"mentionEndUserIds,omitempty"`
+}
+
+// Run delivers the webhooks in the outbox until ctx is done.
+// It also raises the events of the role grants about to expire.
+func (m *Manager) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(deliveryInterval)
+	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(1 * time.Hour)
+	defer cleanupTicker.Stop()
+	roleGrantTicker := time.NewTicker(roleGrantCheckInterval)
+	defer roleGrantTicker.Stop()
+	defer wg.Done()
+	slog.Debug("Webhook delivery runner started")
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Debug("Webhook delivery runner received context cancellation")
+			return
+		case <-cleanupTicker.C:
+			if _, err := m.store.DeleteWebhookDeliveries(ctx, time.Now().Add(-deliveryRetention)); err != nil {
+				slog.Error("failed to delete expired webhook deliveries", log.BBError(err))
+			}
+			if _, err := m.store.DeleteExpiredWebhookEventRecords(ctx, time.Now()); err != nil {
+				slog.Error("failed to delete expired webhook event records", log.BBError(err))
+			}
+		case <-roleGrantTicker.C:
+			m.notifyExpiringRoleGrants(ctx)
+		case <-ticker.C:
+			m.deliverPending(ctx)
+		case <-m.deliverCh:
+			m.deliverPending(ctx)
+		}
+	}
+}
+
+// enqueueWebhookList persists the deliveries of the webhook context to the outbox.
+func (m *Manager) enqueueWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+	// The event must be persisted even if the caller is canceled.
+	ctx = context.WithoutCancel(ctx)
+	webhookCtx.CreatedTs = time.Now().Unix()
+	event := deliveryEvent{Context: *webhookCtx}
+	for _, user := range webhookCtx.MentionEndUsers {
+		event.MentionEndUserIDs = append(event.MentionEndUserIDs, user.ID)
+	}
+	b, err := json.Marshal(&event)
+	if err != nil {
+		slog.Error("failed to marshal webhook event", slog.String("title", webhookCtx.Title), log.BBError(err))
+		return
+	}
+
+	var creates []*store.WebhookDeliveryMessage
+	for _, hook := range webhookList {
+		creates = append(creates, &store.WebhookDeliveryMessage{
+			ProjectWebhookID: hook.ID,
+			Event:            b,
+			Status:           store.WebhookDeliveryPending,
+			NextAttemptTime:  time.Now(),
+			Payload:          &storepb.WebhookDeliveryPayload{},
+		})
+	}
+	if _, err := m.store.CreateWebhookDeliveries(ctx, creates); err != nil {
+		slog.Error("failed to enqueue webhook deliveries", slog.String("title", webhookCtx.Title), log.BBError(err))
+		return
+	}
+	m.NotifyDelivery()
+}
+
+// NotifyDelivery wakes up the delivery runner to deliver the pending deliveries.
+func (m *Manager) NotifyDelivery() {
+	select {
+	case m.deliverCh <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) deliverPending(ctx context.Context) {
+	deliveries, err := m.store.ClaimWebhookDeliveries(ctx, deliveryLease, deliveryBatchSize)
+	if err != nil {
+		slog.Error("failed to claim webhook deliveries", log.BBError(err))
+		return
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+	imSetting, err := m.store.GetAppIMSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get app im setting", log.BBError(err))
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *store.WebhookDeliveryMessage) {
+			defer wg.Done()
+			if err := m.deliver(ctx, delivery, imSetting); err != nil {
+				slog.Error("failed to deliver webhook", slog.Int("delivery", delivery.UID), log.BBError(err))
+			}
+		}(delivery)
+	}
+	wg.Wait()
+	// There may be more due deliveries.
+	if len(deliveries) == deliveryBatchSize {
+		m.NotifyDelivery()
+	}
+}
+
+func (m *Manager) deliver(ctx context.Context, delivery *store.WebhookDeliveryMessage, imSetting *storepb.AppIMSetting) error {
+	hook, err := m.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{ID: &delivery.ProjectWebhookID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project webhook")
+	}
+	if hook == nil {
+		// The deliveries are deleted along with the webhook.
+		return nil
+	}
+	webhookCtx, err := m.getDeliveryContext(ctx, delivery)
+	if err != nil {
+		return err
+	}
+	webhookCtx.URL = hook.URL
+	webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
+	webhookCtx.CustomConfig = hook.Payload.GetCustomConfig()
+	webhookCtx.SlackChannelID = hook.Payload.GetSlackChannelId()
+	webhookCtx.IMSetting = imSetting
+	webhookCtx.Trace = &webhook.Trace{}
+	threadKey := ""
+	if webhookCtx.Issue != nil {
+		issue, err := m.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &webhookCtx.Issue.ID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get issue %d", webhookCtx.Issue.ID)
+		}
+		if issue != nil {
+			threadKey = issue.Payload.GetWebhookThreads()[int32(hook.ID)]
+			webhookCtx.Thread = &webhook.Thread{Key: threadKey}
+		}
+	}
+
+	start := time.Now()
+	postErr := webhook.Post(hook.Type, *webhookCtx)
+	if postErr == nil && webhookCtx.Thread != nil && threadKey == "" && webhookCtx.Thread.Key != "" {
+		if err := m.store.CreateIssueWebhookThread(ctx, webhookCtx.Issue.ID, hook.ID, webhookCtx.Thread.Key); err != nil {
+			slog.Error("failed to save webhook thread", slog.Int("issue", webhookCtx.Issue.ID), log.BBError(err))
+		}
+	}
+	attempt := &storepb.WebhookDeliveryPayload_Attempt{
+		Time:         timestamppb.New(start),
+		RequestBody:  webhookCtx.Trace.RequestBody,
+		ResponseCode: int32(webhookCtx.Trace.ResponseCode),
+		ResponseBody: webhookCtx.Trace.ResponseBody,
+		Latency:      durationpb.New(time.Since(start)),
+	}
+	if postErr != nil {
+		attempt.Error = postErr.Error()
+	}
+	delivery.Payload.Attempts = append(delivery.Payload.Attempts, attempt)
+
+	update := &store.UpdateWebhookDeliveryMessage{
+		UID:     delivery.UID,
+		Payload: delivery.Payload,
+	}
+	status := store.WebhookDeliverySucceeded
+	if postErr != nil {
+		attempts := len(delivery.Payload.Attempts)
+		if attempts >= maxDeliveryAttempts {
+			status = store.WebhookDeliveryFailed
+			// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
+			slog.Warn("Failed to post webhook event on activity",
+				slog.String("webhook type", hook.Type),
+				slog.String("webhook name", hook.Title),
+				slog.String("activity type", webhookCtx.ActivityType),
+				slog.String("title", webhookCtx.Title),
+				slog.Int("attempts", attempts),
+				log.BBError(postErr))
+		} else {
+			status = store.WebhookDeliveryPending
+			nextAttemptTime := time.Now().Add(getDeliveryBackoff(attempts))
+			update.NextAttemptTime = &nextAttemptTime
+		}
+	}
+	update.Status = &status
+	if _, err := m.store.UpdateWebhookDelivery(ctx, update); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery")
+	}
+	return nil
+}
+
+// getDeliveryContext restores the webhook context of the delivery.
+func (m *Manager) getDeliveryContext(ctx context.Context, delivery *store.WebhookDeliveryMessage) (*webhook.Context, error) {
+	var event deliveryEvent
+	if err := json.Unmarshal(delivery.Event, &event); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal webhook event")
+	}
+	for _, id := range event.MentionEndUserIDs {
+		user, err := m.store.GetUserByID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %d", id)
+		}
+		if user == nil || user.MemberDeleted {
+			continue
+		}
+		event.MentionEndUsers = append(event.MentionEndUsers, user)
+	}
+	return &event.Context, nil
+}
+
+// getDeliveryBackoff returns the delay before the next attempt after the given number of attempts.
+func getDeliveryBackoff(attempts int) time.Duration {
+	backoff := deliveryInitialBackoff
+	for i := 1; i < attempts && backoff < deliveryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, deliveryMaxBackoff)
+}
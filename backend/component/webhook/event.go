@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"time"
+
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -18,20 +20,33 @@ const (
 
 	EventTypeStageStatusUpdate   = "bb.webhook.event.stage.status.update"
 	EventTypeTaskRunStatusUpdate = "bb.webhook.event.taskRun.status.update"
+
+	EventTypeDatabaseSchemaDrift       = "bb.webhook.event.database.schemaDrift"
+	EventTypeDatabaseConnectionAnomaly = "bb.webhook.event.database.connection.anomaly"
+	EventTypeDatabaseDataExportReady   = "bb.webhook.event.database.dataExport.ready"
+	EventTypePlanCheckRunError         = "bb.webhook.event.planCheckRun.error"
+	EventTypeVCSSQLReviewViolation     = "bb.webhook.event.vcs.sqlReview.violation"
+	EventTypeRoleGrantExpiring         = "bb.webhook.event.roleGrant.expiring"
 )
 
 type Event struct {
 	Actor   *store.UserMessage
 	Type    EventType
 	Comment string
+	// Issue is nil for the events not related to an issue.
 	Issue   *Issue
 	Project *Project
 
-	IssueUpdate         *EventIssueUpdate
-	IssueApprovalCreate *EventIssueApprovalCreate
-	IssueRolloutReady   *EventIssueRolloutReady
-	StageStatusUpdate   *EventStageStatusUpdate
-	TaskRunStatusUpdate *EventTaskRunStatusUpdate
+	IssueUpdate           *EventIssueUpdate
+	IssueApprovalCreate   *EventIssueApprovalCreate
+	IssueRolloutReady     *EventIssueRolloutReady
+	StageStatusUpdate     *EventStageStatusUpdate
+	TaskRunStatusUpdate   *EventTaskRunStatusUpdate
+	Anomaly               *EventAnomaly
+	DataExportReady       *EventDataExportReady
+	PlanCheckRunError     *EventPlanCheckRunError
+	VCSSQLReviewViolation *EventVCSSQLReviewViolation
+	RoleGrantExpiring     *EventRoleGrantExpiring
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -94,3 +109,39 @@ type EventTaskRunStatusUpdate struct {
 	Detail        string
 	SkippedReason string
 }
+
+// EventAnomaly is the payload of the schema drift and connection anomaly events.
+// The DatabaseName is empty for the instance level anomalies.
+type EventAnomaly struct {
+	InstanceTitle string
+	DatabaseName  string
+	Detail        string
+}
+
+type EventDataExportReady struct {
+	TaskTitle     string
+	InstanceTitle string
+	DatabaseName  string
+}
+
+type EventPlanCheckRunError struct {
+	PlanUID       int64
+	PlanTitle     string
+	InstanceTitle string
+	DatabaseName  string
+	// Errors are the titles and contents of the ERROR results.
+	Errors []string
+}
+
+type EventVCSSQLReviewViolation struct {
+	PullRequestTitle string
+	PullRequestURL   string
+	ErrorCount       int
+	WarningCount     int
+}
+
+type EventRoleGrantExpiring struct {
+	Role       string
+	User       *store.UserMessage
+	ExpireTime time.Time
+}
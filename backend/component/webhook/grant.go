@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// roleGrantCheckInterval is the interval to check the role grants about to expire.
+	roleGrantCheckInterval = 1 * time.Hour
+	// roleGrantExpiringNotice is how long before the expiration the grant is notified.
+	roleGrantExpiringNotice = 24 * time.Hour
+)
+
+// roleGrantExpirationRegexp matches the `request.time < timestamp("2021-01-01T00:00:00Z")` expiration of the binding condition.
+var roleGrantExpirationRegexp = regexp.MustCompile(`request\.time\s*<\s*timestamp\(\s*"([^"]+)"\s*\)`)
+
+// notifyExpiringRoleGrants notifies the project role grants expiring within the notice window.
+// The notified grants are recorded, so each grant is notified once even if the checks are missed or repeated.
+func (m *Manager) notifyExpiringRoleGrants(ctx context.Context) {
+	now := time.Now()
+	projects, err := m.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("failed to list projects", log.BBError(err))
+		return
+	}
+	for _, project := range projects {
+		policy, err := m.store.GetProjectIamPolicy(ctx, project.UID)
+		if err != nil {
+			slog.Error("failed to get project iam policy", slog.String("project", project.ResourceID), log.BBError(err))
+			continue
+		}
+		for _, binding := range policy.Policy.GetBindings() {
+			expireTime, ok := getExpiringRoleGrantExpireTime(binding, now)
+			if !ok {
+				continue
+			}
+			for _, member := range binding.Members {
+				if member == api.AllUsers {
+					continue
+				}
+				for _, user := range utils.GetUsersByMember(ctx, m.store, member) {
+					notify, err := m.store.CreateWebhookEventRecordIfNotExists(ctx, &store.WebhookEventRecordMessage{
+						Key:        getRoleGrantEventRecordKey(project.ResourceID, binding.Role, user.ID, expireTime),
+						ExpireTime: expireTime,
+					})
+					if err != nil {
+						slog.Error("failed to record the expiring role grant", slog.String("project", project.ResourceID), log.BBError(err))
+						continue
+					}
+					if !notify {
+						continue
+					}
+					m.CreateEvent(ctx, &Event{
+						Actor:   m.store.GetSystemBotUser(ctx),
+						Type:    EventTypeRoleGrantExpiring,
+						Project: NewProject(project),
+						RoleGrantExpiring: &EventRoleGrantExpiring{
+							Role:       binding.Role,
+							User:       user,
+							ExpireTime: expireTime,
+						},
+					})
+				}
+			}
+		}
+	}
+}
+
+// getExpiringRoleGrantExpireTime returns the expiration of the binding if it expires within the notice window from now.
+func getExpiringRoleGrantExpireTime(binding *storepb.Binding, now time.Time) (time.Time, bool) {
+	expireTime, ok := getRoleGrantExpireTime(binding)
+	if !ok || !expireTime.After(now) || expireTime.After(now.Add(roleGrantExpiringNotice)) {
+		return time.Time{}, false
+	}
+	return expireTime, true
+}
+
+// getRoleGrantEventRecordKey returns the key recording the expiring grant of the role to the user has been notified.
+// The expiration is part of the key, so the grant extended to another expiration is notified again.
+func getRoleGrantEventRecordKey(projectID, role string, userID int, expireTime time.Time) string {
+	return fmt.Sprintf("role-grant/%s/%s/%d/%d", projectID, role, userID, expireTime.Unix())
+}
+
+// getRoleGrantExpireTime returns the earliest expiration in the binding condition.
+func getRoleGrantExpireTime(binding *storepb.Binding) (time.Time, bool) {
+	var expireTime time.Time
+	for _, match := range roleGrantExpirationRegexp.FindAllStringSubmatch(binding.GetCondition().GetExpression(), -1) {
+		t, err := time.Parse(time.RFC3339, match[1])
+		if err != nil {
+			continue
+		}
+		if expireTime.IsZero() || t.Before(expireTime) {
+			expireTime = t
+		}
+	}
+	return expireTime, !expireTime.IsZero()
+}
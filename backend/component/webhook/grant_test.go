@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetRoleGrantExpireTime(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       time.Time
+		wantOK     bool
+	}{
+		{expression: "", wantOK: false},
+		{expression: `resource.database_name == "employee"`, wantOK: false},
+		{expression: `request.time < timestamp("2026-10-19T08:00:00Z")`, want: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), wantOK: true},
+		{
+			expression: `resource.database_name == "employee" && request.time < timestamp( "2026-10-19T16:00:00+08:00" )`,
+			want:       time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC),
+			wantOK:     true,
+		},
+		// The earliest expiration takes effect.
+		{
+			expression: `request.time < timestamp("2026-12-01T00:00:00Z") && request.time < timestamp("2026-11-01T00:00:00Z")`,
+			want:       time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+			wantOK:     true,
+		},
+		{expression: `request.time < timestamp("tomorrow")`, wantOK: false},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		got, ok := getRoleGrantExpireTime(&storepb.Binding{Condition: &expr.Expr{Expression: test.expression}})
+		a.Equal(test.wantOK, ok, test.expression)
+		a.True(test.want.Equal(got), test.expression)
+	}
+}
+
+func TestGetExpiringRoleGrantExpireTime(t *testing.T) {
+	now := time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expireTime time.Time
+		wantOK     bool
+	}{
+		{name: "expired", expireTime: now.Add(-time.Hour), wantOK: false},
+		{name: "expiring now", expireTime: now, wantOK: false},
+		{name: "expiring soon", expireTime: now.Add(time.Minute), wantOK: true},
+		// The grants missed by the earlier checks are still notified within the notice window.
+		{name: "expiring in the middle of the window", expireTime: now.Add(roleGrantExpiringNotice - 5*roleGrantCheckInterval), wantOK: true},
+		{name: "expiring at the end of the window", expireTime: now.Add(roleGrantExpiringNotice), wantOK: true},
+		{name: "expiring after the window", expireTime: now.Add(roleGrantExpiringNotice + time.Second), wantOK: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			binding := &storepb.Binding{
+				Role:      "roles/projectQuerier",
+				Members:   []string{"users/101"},
+				Condition: &expr.Expr{Expression: `request.time < timestamp("` + test.expireTime.Format(time.RFC3339) + `")`},
+			}
+			got, ok := getExpiringRoleGrantExpireTime(binding, now)
+			a.Equal(test.wantOK, ok)
+			if test.wantOK {
+				a.True(test.expireTime.Equal(got))
+			}
+		})
+	}
+
+	_, ok := getExpiringRoleGrantExpireTime(&storepb.Binding{Role: "roles/projectQuerier"}, now)
+	require.False(t, ok)
+}
+
+func TestGetRoleGrantEventRecordKey(t *testing.T) {
+	a := require.New(t)
+	expireTime := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	key := getRoleGrantEventRecordKey("hr", "roles/projectQuerier", 101, expireTime)
+	a.Equal("role-grant/hr/roles/projectQuerier/101/1792396800", key)
+
+	// The same grant is recorded once regardless of the time zone.
+	a.Equal(key, getRoleGrantEventRecordKey("hr", "roles/projectQuerier", 101, expireTime.In(time.FixedZone("CST", 8*60*60))))
+	// The grant extended to another expiration, or granted to another user, role or project, is notified separately.
+	a.NotEqual(key, getRoleGrantEventRecordKey("hr", "roles/projectQuerier", 101, expireTime.Add(24*time.Hour)))
+	a.NotEqual(key, getRoleGrantEventRecordKey("hr", "roles/projectQuerier", 102, expireTime))
+	a.NotEqual(key, getRoleGrantEventRecordKey("hr", "roles/projectDeveloper", 101, expireTime))
+	a.NotEqual(key, getRoleGrantEventRecordKey("finance", "roles/projectQuerier", 101, expireTime))
+}
@@ -27,6 +27,8 @@ import (
 type Manager struct {
 	store      *store.Store
 	iamManager *iam.Manager
+	// deliverCh wakes up the delivery runner once deliveries are enqueued.
+	deliverCh chan struct{}
 }
 
 // Metadata is the activity metadata.
@@ -39,32 +41,13 @@ func NewManager(store *store.Store, iamManager *iam.Manager) *Manager {
 	return &Manager{
 		store:      store,
 		iamManager: iamManager,
+		deliverCh:  make(chan struct{}, 1),
 	}
 }
 
 func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
-	var activityType api.ActivityType
-	//exhaustive:enforce
-	switch e.Type {
-	case EventTypeIssueCreate:
-		activityType = api.ActivityIssueCreate
-	case EventTypeIssueUpdate:
-		activityType = api.ActivityIssueFieldUpdate
-	case EventTypeIssueStatusUpdate:
-		activityType = api.ActivityIssueStatusUpdate
-	case EventTypeIssueCommentCreate:
-		activityType = api.ActivityIssueCommentCreate
-	case EventTypeIssueApprovalCreate:
-		activityType = api.ActivityIssueApprovalNotify
-	case EventTypeIssueApprovalPass:
-		activityType = api.ActivityNotifyIssueApproved
-	case EventTypeIssueRolloutReady:
-		activityType = api.ActivityNotifyPipelineRollout
-	case EventTypeStageStatusUpdate:
-		activityType = api.ActivityPipelineStageStatusUpdate
-	case EventTypeTaskRunStatusUpdate:
-		activityType = api.ActivityPipelineTaskRunStatusUpdate
-	default:
+	activityType, ok := getActivityType(e.Type)
+	if !ok {
 		return
 	}
 	webhookList, err := m.store.FindProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
@@ -72,7 +55,7 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		ActivityType: &activityType,
 	})
 	if err != nil {
-		slog.Warn("failed to find project webhook", "issue_name", e.Issue.Title, log.BBError(err))
+		slog.Warn("failed to find project webhook", slog.String("event_type", string(e.Type)), slog.String("project", e.Project.ResourceID), log.BBError(err))
 		return
 	}
 
@@ -80,31 +63,77 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		return
 	}
 
-	webhookCtx, err := m.getWebhookContextFromEvent(ctx, e, activityType)
+	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Warn("failed to get workspace setting", slog.String("event_type", string(e.Type)), log.BBError(err))
+		return
+	}
+	webhookCtx, err := m.getWebhookContextFromEvent(ctx, e, activityType, setting.ExternalUrl)
 	if err != nil {
 		slog.Warn("failed to get webhook context",
-			slog.String("issue_name", e.Issue.Title),
+			slog.String("event_type", string(e.Type)),
+			slog.String("project", e.Project.ResourceID),
 			log.BBError(err))
 		return
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go m.postWebhookList(ctx, webhookCtx, webhookList)
+	// The webhooks are posted by the delivery runner to avoid blocking web serving thread.
+	m.enqueueWebhookList(ctx, webhookCtx, webhookList)
+}
+
+// getActivityType returns the activity type of the project webhooks subscribing to the event type.
+func getActivityType(eventType EventType) (api.ActivityType, bool) {
+	//exhaustive:enforce
+	switch eventType {
+	case EventTypeIssueCreate:
+		return api.ActivityIssueCreate, true
+	case EventTypeIssueUpdate:
+		return api.ActivityIssueFieldUpdate, true
+	case EventTypeIssueStatusUpdate:
+		return api.ActivityIssueStatusUpdate, true
+	case EventTypeIssueCommentCreate:
+		return api.ActivityIssueCommentCreate, true
+	case EventTypeIssueApprovalCreate:
+		return api.ActivityIssueApprovalNotify, true
+	case EventTypeIssueApprovalPass:
+		return api.ActivityNotifyIssueApproved, true
+	case EventTypeIssueRolloutReady:
+		return api.ActivityNotifyPipelineRollout, true
+	case EventTypeStageStatusUpdate:
+		return api.ActivityPipelineStageStatusUpdate, true
+	case EventTypeTaskRunStatusUpdate:
+		return api.ActivityPipelineTaskRunStatusUpdate, true
+	case EventTypeDatabaseSchemaDrift:
+		return api.ActivityDatabaseSchemaDrift, true
+	case EventTypeDatabaseConnectionAnomaly:
+		return api.ActivityDatabaseConnectionAnomaly, true
+	case EventTypeDatabaseDataExportReady:
+		return api.ActivityDatabaseDataExportReady, true
+	case EventTypePlanCheckRunError:
+		return api.ActivityPlanCheckRunError, true
+	case EventTypeVCSSQLReviewViolation:
+		return api.ActivityVCSSQLReviewViolation, true
+	case EventTypeRoleGrantExpiring:
+		return api.ActivityNotifyRoleGrantExpiring, true
+	default:
+		return "", false
+	}
 }
 
-func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, activityType api.ActivityType) (*webhook.Context, error) {
+// getWebhookContextFromEvent builds the webhook context of the event linking to the external URL of the workspace.
+func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, activityType api.ActivityType, externalURL string) (*webhook.Context, error) {
 	var webhookCtx webhook.Context
 	var mentions []string
 	var mentionUsers []*store.UserMessage
 
-	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get workspace setting")
-	}
-
 	level := webhook.WebhookInfo
 	title := ""
 	titleZh := ""
-	link := fmt.Sprintf("%s/projects/%s/issues/%s-%d", setting.ExternalUrl, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID)
+	link := fmt.Sprintf("%s/projects/%s", externalURL, e.Project.ResourceID)
+	if e.Issue != nil {
+		link = fmt.Sprintf("%s/projects/%s/issues/%s-%d", externalURL, e.Project.ResourceID, slug.Make(e.Issue.Title), e.Issue.UID)
+	}
+	var database *webhook.Database
+	description := e.Comment
 	switch e.Type {
 	case EventTypeIssueCreate:
 		title = "Issue created"
@@ -244,6 +273,82 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			}
 		}
 
+	case EventTypeDatabaseSchemaDrift:
+		u := e.Anomaly
+		level = webhook.WebhookWarn
+		title = "Schema drift detected"
+		titleZh = "检测到数据库结构漂移"
+		link = fmt.Sprintf("%s/projects/%s/anomalies", externalURL, e.Project.ResourceID)
+		database = &webhook.Database{Instance: u.InstanceTitle, Name: u.DatabaseName}
+		description = u.Detail
+
+	case EventTypeDatabaseConnectionAnomaly:
+		u := e.Anomaly
+		level = webhook.WebhookError
+		if u.DatabaseName == "" {
+			title = "Instance connection failed"
+			titleZh = "实例连接失败"
+		} else {
+			title = "Database connection failed"
+			titleZh = "数据库连接失败"
+		}
+		link = fmt.Sprintf("%s/projects/%s/anomalies", externalURL, e.Project.ResourceID)
+		database = &webhook.Database{Instance: u.InstanceTitle, Name: u.DatabaseName}
+		description = u.Detail
+
+	case EventTypeDatabaseDataExportReady:
+		u := e.DataExportReady
+		level = webhook.WebhookSuccess
+		title = "Data export is ready"
+		titleZh = "数据导出已就绪"
+		database = &webhook.Database{Instance: u.InstanceTitle, Name: u.DatabaseName}
+		mentionUsers = append(mentionUsers, e.Issue.Creator)
+		phone, err := maybeGetPhoneFromUser(e.Issue.Creator)
+		if err != nil {
+			slog.Warn("failed to parse phone number", slog.String("issue_title", e.Issue.Title), log.BBError(err))
+		} else if phone != "" {
+			mentions = append(mentions, phone)
+		}
+
+	case EventTypePlanCheckRunError:
+		u := e.PlanCheckRunError
+		level = webhook.WebhookError
+		title = "Plan check failed"
+		titleZh = "计划检查失败"
+		if e.Issue == nil {
+			link = fmt.Sprintf("%s/projects/%s/plans/%s-%d", externalURL, e.Project.ResourceID, slug.Make(u.PlanTitle), u.PlanUID)
+		}
+		database = &webhook.Database{Instance: u.InstanceTitle, Name: u.DatabaseName}
+		description = strings.Join(u.Errors, "\n")
+
+	case EventTypeVCSSQLReviewViolation:
+		u := e.VCSSQLReviewViolation
+		level = webhook.WebhookWarn
+		if u.ErrorCount > 0 {
+			level = webhook.WebhookError
+		}
+		title = "SQL review violations in pull request"
+		titleZh = "拉取请求违反 SQL 审核规范"
+		link = u.PullRequestURL
+		description = fmt.Sprintf("%s: %d errors, %d warnings", u.PullRequestTitle, u.ErrorCount, u.WarningCount)
+
+	case EventTypeRoleGrantExpiring:
+		u := e.RoleGrantExpiring
+		level = webhook.WebhookWarn
+		title = "Role grant is about to expire"
+		titleZh = "角色授权即将过期"
+		link = fmt.Sprintf("%s/projects/%s/members", externalURL, e.Project.ResourceID)
+		description = fmt.Sprintf("The role %s granted to %s expires at %s.", u.Role, u.User.Email, u.ExpireTime.UTC().Format(time.RFC3339))
+		if !u.User.MemberDeleted {
+			mentionUsers = append(mentionUsers, u.User)
+			phone, err := maybeGetPhoneFromUser(u.User)
+			if err != nil {
+				slog.Warn("failed to parse phone number", slog.String("user", u.User.Email), log.BBError(err))
+			} else if phone != "" {
+				mentions = append(mentions, phone)
+			}
+		}
+
 	case EventTypeIssueApprovalCreate:
 		pendingStep := e.IssueApprovalCreate.ApprovalStep
 
@@ -320,20 +425,14 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 		ActivityType: string(activityType),
 		Title:        title,
 		TitleZh:      titleZh,
-		Issue: &webhook.Issue{
-			ID:          e.Issue.UID,
-			Name:        e.Issue.Title,
-			Status:      e.Issue.Status,
-			Type:        e.Issue.Type,
-			Description: e.Issue.Description,
-		},
 		Project: &webhook.Project{
 			ID:   e.Project.UID,
 			Name: e.Project.Title,
 		},
 		Stage:               nil,
 		TaskResult:          nil,
-		Description:         e.Comment,
+		Database:            database,
+		Description:         description,
 		Link:                link,
 		CreatorID:           e.Actor.ID,
 		CreatorName:         e.Actor.Name,
@@ -341,6 +440,21 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 		MentionEndUsers:     mentionEndUsers,
 		MentionUsersByPhone: mentions,
 	}
+	if e.IssueApprovalCreate != nil && e.Issue != nil {
+		// The external approval nodes are approved by the external systems.
+		if _, ok := e.IssueApprovalCreate.ApprovalStep.Nodes[0].Payload.(*storepb.ApprovalNode_ExternalNodeId); !ok {
+			webhookCtx.ApprovalIssueName = common.FormatIssue(e.Project.ResourceID, e.Issue.UID)
+		}
+	}
+	if e.Issue != nil {
+		webhookCtx.Issue = &webhook.Issue{
+			ID:          e.Issue.UID,
+			Name:        e.Issue.Title,
+			Status:      e.Issue.Status,
+			Type:        e.Issue.Type,
+			Description: e.Issue.Description,
+		}
+	}
 	if u := e.TaskRunStatusUpdate; u != nil {
 		webhookCtx.TaskResult = &webhook.TaskResult{
 			Name:          u.Title,
@@ -349,6 +463,12 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			SkippedReason: u.SkippedReason,
 		}
 	}
+	if u := e.DataExportReady; u != nil {
+		webhookCtx.TaskResult = &webhook.TaskResult{
+			Name:   u.TaskTitle,
+			Status: api.TaskRunDone.String(),
+		}
+	}
 	if u := e.StageStatusUpdate; u != nil {
 		webhookCtx.Stage = &webhook.Stage{
 			Name: u.StageTitle,
@@ -358,37 +478,6 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 	return &webhookCtx, nil
 }
 
-func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
-	ctx = context.WithoutCancel(ctx)
-	setting, err := m.store.GetAppIMSetting(ctx)
-	if err != nil {
-		slog.Error("failed to get app im setting", log.BBError(err))
-	} else {
-		webhookCtx.IMSetting = setting
-	}
-
-	for _, hook := range webhookList {
-		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTs = time.Now().Unix()
-		webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Type, *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				slog.Warn("Failed to post webhook event on activity",
-					slog.String("webhook type", hook.Type),
-					slog.String("webhook name", hook.Title),
-					slog.String("activity type", webhookCtx.ActivityType),
-					slog.String("title", webhookCtx.Title),
-					log.BBError(err))
-				return
-			}
-		}(&webhookCtx, hook)
-	}
-}
-
 func (m *Manager) getUsersFromWorkspaceRole(role api.Role) func(context.Context) ([]*store.UserMessage, error) {
 	return func(ctx context.Context) ([]*store.UserMessage, error) {
 		policyMessage, err := m.store.GetWorkspaceIamPolicy(ctx)
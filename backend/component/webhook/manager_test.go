@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const testExternalURL = "https://bytebase.example.com"
+
+func TestGetWebhookContextFromEvent(t *testing.T) {
+	bot := &store.UserMessage{ID: 1, Name: "Bytebase", Email: "support@bytebase.com", Type: api.SystemBot}
+	creator := &store.UserMessage{ID: 101, Name: "Alice", Email: "alice@example.com", Type: api.EndUser, Phone: "+8613800000000"}
+	project := &Project{UID: 102, ResourceID: "hr", Title: "HR"}
+	issue := &Issue{UID: 103, Status: "OPEN", Type: "bb.issue.database.data.export", Title: "Export employees", Creator: creator}
+	expireTime := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event *Event
+		want  *webhook.Context
+	}{
+		{
+			name: "schema drift",
+			event: &Event{
+				Type:    EventTypeDatabaseSchemaDrift,
+				Anomaly: &EventAnomaly{InstanceTitle: "MySQL Prod", DatabaseName: "employee", Detail: "The table salary is dropped"},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookWarn,
+				ActivityType: string(api.ActivityDatabaseSchemaDrift),
+				Title:        "Schema drift detected",
+				TitleZh:      "检测到数据库结构漂移",
+				Description:  "The table salary is dropped",
+				Link:         "https://bytebase.example.com/projects/hr/anomalies",
+				Database:     &webhook.Database{Instance: "MySQL Prod", Name: "employee"},
+			},
+		},
+		{
+			name: "database connection anomaly",
+			event: &Event{
+				Type:    EventTypeDatabaseConnectionAnomaly,
+				Anomaly: &EventAnomaly{InstanceTitle: "MySQL Prod", DatabaseName: "employee", Detail: "Access denied"},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookError,
+				ActivityType: string(api.ActivityDatabaseConnectionAnomaly),
+				Title:        "Database connection failed",
+				TitleZh:      "数据库连接失败",
+				Description:  "Access denied",
+				Link:         "https://bytebase.example.com/projects/hr/anomalies",
+				Database:     &webhook.Database{Instance: "MySQL Prod", Name: "employee"},
+			},
+		},
+		{
+			name: "instance connection anomaly",
+			event: &Event{
+				Type:    EventTypeDatabaseConnectionAnomaly,
+				Anomaly: &EventAnomaly{InstanceTitle: "MySQL Prod", Detail: "Connection refused"},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookError,
+				ActivityType: string(api.ActivityDatabaseConnectionAnomaly),
+				Title:        "Instance connection failed",
+				TitleZh:      "实例连接失败",
+				Description:  "Connection refused",
+				Link:         "https://bytebase.example.com/projects/hr/anomalies",
+				Database:     &webhook.Database{Instance: "MySQL Prod"},
+			},
+		},
+		{
+			name: "data export ready",
+			event: &Event{
+				Type:            EventTypeDatabaseDataExportReady,
+				Issue:           issue,
+				DataExportReady: &EventDataExportReady{TaskTitle: "Export employee", InstanceTitle: "MySQL Prod", DatabaseName: "employee"},
+			},
+			want: &webhook.Context{
+				Level:               webhook.WebhookSuccess,
+				ActivityType:        string(api.ActivityDatabaseDataExportReady),
+				Title:               "Data export is ready",
+				TitleZh:             "数据导出已就绪",
+				Link:                "https://bytebase.example.com/projects/hr/issues/export-employees-103",
+				Issue:               &webhook.Issue{ID: 103, Name: "Export employees", Status: "OPEN", Type: "bb.issue.database.data.export"},
+				TaskResult:          &webhook.TaskResult{Name: "Export employee", Status: api.TaskRunDone.String()},
+				Database:            &webhook.Database{Instance: "MySQL Prod", Name: "employee"},
+				MentionEndUsers:     []*store.UserMessage{creator},
+				MentionUsersByPhone: []string{"13800000000"},
+			},
+		},
+		{
+			name: "plan check run error",
+			event: &Event{
+				Type: EventTypePlanCheckRunError,
+				PlanCheckRunError: &EventPlanCheckRunError{
+					PlanUID:       104,
+					PlanTitle:     "Add salary index",
+					InstanceTitle: "MySQL Prod",
+					DatabaseName:  "employee",
+					Errors:        []string{"Syntax error: near INDX", "Table salary does not exist"},
+				},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookError,
+				ActivityType: string(api.ActivityPlanCheckRunError),
+				Title:        "Plan check failed",
+				TitleZh:      "计划检查失败",
+				Description:  "Syntax error: near INDX\nTable salary does not exist",
+				Link:         "https://bytebase.example.com/projects/hr/plans/add-salary-index-104",
+				Database:     &webhook.Database{Instance: "MySQL Prod", Name: "employee"},
+			},
+		},
+		{
+			name: "plan check run error of issue",
+			event: &Event{
+				Type:              EventTypePlanCheckRunError,
+				Issue:             issue,
+				PlanCheckRunError: &EventPlanCheckRunError{PlanUID: 104, PlanTitle: "Add salary index", InstanceTitle: "MySQL Prod", DatabaseName: "employee", Errors: []string{"Syntax error: near INDX"}},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookError,
+				ActivityType: string(api.ActivityPlanCheckRunError),
+				Title:        "Plan check failed",
+				TitleZh:      "计划检查失败",
+				Description:  "Syntax error: near INDX",
+				Link:         "https://bytebase.example.com/projects/hr/issues/export-employees-103",
+				Issue:        &webhook.Issue{ID: 103, Name: "Export employees", Status: "OPEN", Type: "bb.issue.database.data.export"},
+				Database:     &webhook.Database{Instance: "MySQL Prod", Name: "employee"},
+			},
+		},
+		{
+			name: "SQL review warnings",
+			event: &Event{
+				Type:                  EventTypeVCSSQLReviewViolation,
+				VCSSQLReviewViolation: &EventVCSSQLReviewViolation{PullRequestTitle: "Add salary", PullRequestURL: "https://github.com/octocat/hr/pull/7", WarningCount: 2},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookWarn,
+				ActivityType: string(api.ActivityVCSSQLReviewViolation),
+				Title:        "SQL review violations in pull request",
+				TitleZh:      "拉取请求违反 SQL 审核规范",
+				Description:  "Add salary: 0 errors, 2 warnings",
+				Link:         "https://github.com/octocat/hr/pull/7",
+			},
+		},
+		{
+			name: "SQL review errors",
+			event: &Event{
+				Type:                  EventTypeVCSSQLReviewViolation,
+				VCSSQLReviewViolation: &EventVCSSQLReviewViolation{PullRequestTitle: "Add salary", PullRequestURL: "https://github.com/octocat/hr/pull/7", ErrorCount: 1, WarningCount: 2},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookError,
+				ActivityType: string(api.ActivityVCSSQLReviewViolation),
+				Title:        "SQL review violations in pull request",
+				TitleZh:      "拉取请求违反 SQL 审核规范",
+				Description:  "Add salary: 1 errors, 2 warnings",
+				Link:         "https://github.com/octocat/hr/pull/7",
+			},
+		},
+		{
+			name: "role grant expiring",
+			event: &Event{
+				Type:              EventTypeRoleGrantExpiring,
+				RoleGrantExpiring: &EventRoleGrantExpiring{Role: "roles/projectQuerier", User: creator, ExpireTime: expireTime.In(time.FixedZone("CST", 8*60*60))},
+			},
+			want: &webhook.Context{
+				Level:               webhook.WebhookWarn,
+				ActivityType:        string(api.ActivityNotifyRoleGrantExpiring),
+				Title:               "Role grant is about to expire",
+				TitleZh:             "角色授权即将过期",
+				Description:         "The role roles/projectQuerier granted to alice@example.com expires at 2026-10-19T08:00:00Z.",
+				Link:                "https://bytebase.example.com/projects/hr/members",
+				MentionEndUsers:     []*store.UserMessage{creator},
+				MentionUsersByPhone: []string{"13800000000"},
+			},
+		},
+		{
+			name: "role grant of deleted user expiring",
+			event: &Event{
+				Type: EventTypeRoleGrantExpiring,
+				RoleGrantExpiring: &EventRoleGrantExpiring{
+					Role:       "roles/projectQuerier",
+					User:       &store.UserMessage{ID: 105, Email: "bob@example.com", Type: api.EndUser, Phone: "+8613900000000", MemberDeleted: true},
+					ExpireTime: expireTime,
+				},
+			},
+			want: &webhook.Context{
+				Level:        webhook.WebhookWarn,
+				ActivityType: string(api.ActivityNotifyRoleGrantExpiring),
+				Title:        "Role grant is about to expire",
+				TitleZh:      "角色授权即将过期",
+				Description:  "The role roles/projectQuerier granted to bob@example.com expires at 2026-10-19T08:00:00Z.",
+				Link:         "https://bytebase.example.com/projects/hr/members",
+			},
+		},
+	}
+
+	// The new events don't look up the users in the store.
+	m := &Manager{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			test.event.Actor = bot
+			test.event.Project = project
+			test.want.Project = &webhook.Project{ID: 102, Name: "HR"}
+			test.want.CreatorID = bot.ID
+			test.want.CreatorName = bot.Name
+			test.want.CreatorEmail = bot.Email
+
+			activityType, ok := getActivityType(test.event.Type)
+			a.True(ok)
+			got, err := m.getWebhookContextFromEvent(context.Background(), test.event, activityType, testExternalURL)
+			a.NoError(err)
+			a.Equal(test.want, got)
+		})
+	}
+}
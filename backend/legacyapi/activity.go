@@ -11,6 +11,9 @@ const (
 	// ActivityPipelineRollout is the type for notifying releasers to rollout.
 	// Will not be stored. Only used for notification.
 	ActivityNotifyPipelineRollout ActivityType = "bb.notify.pipeline.rollout"
+	// ActivityNotifyRoleGrantExpiring is the type for notifying the project role grants about to expire.
+	// Will not be stored. Only used for notification.
+	ActivityNotifyRoleGrantExpiring ActivityType = "bb.notify.role-grant.expiring"
 
 	// Issue related.
 
@@ -76,4 +79,25 @@ const (
 	ActivitySQLQuery ActivityType = "bb.sql.query"
 	// ActivitySQLExport is the type for exporting SQL.
 	ActivitySQLExport ActivityType = "bb.sql.export"
+
+	// Database related.
+
+	// ActivityDatabaseSchemaDrift is the type for detecting the database schema drift.
+	// Used for notification only.
+	ActivityDatabaseSchemaDrift ActivityType = "bb.database.schema-drift"
+	// ActivityDatabaseConnectionAnomaly is the type for failing to connect to the instance or database.
+	// Used for notification only.
+	ActivityDatabaseConnectionAnomaly ActivityType = "bb.database.connection.anomaly"
+	// ActivityDatabaseDataExportReady is the type for the data export archive becoming ready.
+	// Used for notification only.
+	ActivityDatabaseDataExportReady ActivityType = "bb.database.data-export.ready"
+
+	// Review related.
+
+	// ActivityPlanCheckRunError is the type for plan check runs finishing with ERROR results.
+	// Used for notification only.
+	ActivityPlanCheckRunError ActivityType = "bb.plan.check-run.error"
+	// ActivityVCSSQLReviewViolation is the type for the SQL review policy violations on VCS pull requests.
+	// Used for notification only.
+	ActivityVCSSQLReviewViolation ActivityType = "bb.vcs.sql-review.violation"
 )
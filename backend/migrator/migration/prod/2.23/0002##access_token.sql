@@ -0,0 +1,18 @@
+CREATE TABLE access_token (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    expire_ts BIGINT NOT NULL DEFAULT 0,
+    last_used_ts BIGINT NOT NULL DEFAULT 0,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_access_token_unique_token_hash ON access_token(token_hash);
+
+CREATE INDEX idx_access_token_principal_id ON access_token(principal_id);
+
+ALTER SEQUENCE access_token_id_seq RESTART WITH 101;
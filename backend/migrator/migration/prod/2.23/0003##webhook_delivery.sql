@@ -0,0 +1,16 @@
+CREATE TABLE webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    project_webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    next_attempt_ts BIGINT NOT NULL DEFAULT 0,
+    event JSONB NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_webhook_delivery_project_webhook_id ON webhook_delivery(project_webhook_id);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_ts ON webhook_delivery(next_attempt_ts) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
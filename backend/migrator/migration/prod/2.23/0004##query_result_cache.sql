@@ -0,0 +1,13 @@
+CREATE TABLE query_result_cache (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database TEXT NOT NULL,
+    worksheet TEXT NOT NULL DEFAULT '',
+    statement TEXT NOT NULL,
+    result BYTEA NOT NULL
+);
+
+CREATE INDEX idx_query_result_cache_creator_id_database_worksheet ON query_result_cache(creator_id, database, worksheet);
+
+ALTER SEQUENCE query_result_cache_id_seq RESTART WITH 101;
@@ -0,0 +1,9 @@
+CREATE TABLE webhook_event_record (
+    key TEXT PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    fingerprints TEXT[] NOT NULL DEFAULT '{}',
+    expire_ts BIGINT NOT NULL
+);
+
+CREATE INDEX idx_webhook_event_record_expire_ts ON webhook_event_record(expire_ts);
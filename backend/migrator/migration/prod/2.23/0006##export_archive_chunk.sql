@@ -0,0 +1,6 @@
+CREATE TABLE export_archive_chunk (
+    export_archive_id INTEGER NOT NULL REFERENCES export_archive (id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    bytes BYTEA NOT NULL,
+    PRIMARY KEY (export_archive_id, seq)
+);
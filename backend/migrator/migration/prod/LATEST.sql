@@ -960,4 +960,84 @@ CREATE TABLE review_config
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
     payload JSONB NOT NULL DEFAULT '{}'
-);
\ No newline at end of file
+);
+
+-- access_token stores personal access tokens and service account keys.
+CREATE TABLE access_token (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id),
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    expire_ts BIGINT NOT NULL DEFAULT 0,
+    last_used_ts BIGINT NOT NULL DEFAULT 0,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_access_token_unique_token_hash ON access_token(token_hash);
+
+CREATE INDEX idx_access_token_principal_id ON access_token(principal_id);
+
+ALTER SEQUENCE access_token_id_seq RESTART WITH 101;
+
+-- webhook_delivery is the outbox and the delivery log of the project webhooks.
+CREATE TABLE webhook_delivery (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    project_webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'FAILED')),
+    next_attempt_ts BIGINT NOT NULL DEFAULT 0,
+    event JSONB NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_webhook_delivery_project_webhook_id ON webhook_delivery(project_webhook_id);
+
+CREATE INDEX idx_webhook_delivery_pending_next_attempt_ts ON webhook_delivery(next_attempt_ts) WHERE status = 'PENDING';
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+-- query_result_cache stores the latest SQL Editor query results per user, database and worksheet for comparing.
+-- The result is the encrypted query response with the masked values.
+CREATE TABLE query_result_cache (
+    id BIGSERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- the database resource name, for example, instances/{instance}/databases/{database}
+    database TEXT NOT NULL,
+    -- the worksheet resource name, for example, worksheets/{worksheet}
+    worksheet TEXT NOT NULL DEFAULT '',
+    statement TEXT NOT NULL,
+    result BYTEA NOT NULL
+);
+
+CREATE INDEX idx_query_result_cache_creator_id_database_worksheet ON query_result_cache(creator_id, database, worksheet);
+
+ALTER SEQUENCE query_result_cache_id_seq RESTART WITH 101;
+
+-- webhook_event_record records the webhook events raised for a subject, so that the same event is not raised repeatedly,
+-- e.g. the expiring role grant and the SQL review violations of a pull request.
+CREATE TABLE webhook_event_record (
+    -- the subject of the event, for example, role-grant/{project}/{role}/{member}/{expire_ts}
+    key TEXT PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- the fingerprints of the notified contents, for example, the SQL review violations of the pull request.
+    fingerprints TEXT[] NOT NULL DEFAULT '{}',
+    -- the record is deleted after the expire time.
+    expire_ts BIGINT NOT NULL
+);
+
+CREATE INDEX idx_webhook_event_record_expire_ts ON webhook_event_record(expire_ts);
+
+-- export_archive_chunk stores the content of the export archive in chunks, so that the archive is never held in memory.
+-- The content is the bytes of the export_archive followed by the chunks in seq order.
+CREATE TABLE export_archive_chunk (
+    export_archive_id INTEGER NOT NULL REFERENCES export_archive (id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    bytes BYTEA NOT NULL,
+    PRIMARY KEY (export_archive_id, seq)
+);
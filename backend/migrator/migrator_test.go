@@ -231,5 +231,5 @@ func TestMigrationCompatibility(t *testing.T) {
 func TestGetCutoffVersion(t *testing.T) {
 	releaseVersion, err := getProdCutoffVersion()
 	require.NoError(t, err)
-	require.Equal(t, semver.MustParse("2.23.1"), releaseVersion)
+	require.Equal(t, semver.MustParse("2.23.6"), releaseVersion)
 }
@@ -815,4 +815,15 @@ const (
 
 	// MSSQLStatementDisallowMixDDLDML is an advisor type for MSSQL disallow mix DDL and DML.
 	MSSQLStatementDisallowMixDDLDML Type = "bb.plugin.advisor.mssql.statement.disallow-mix-ddl-dml"
+
+	// Db2 Advisor.
+
+	// Db2NoSelectAll is an advisor type for Db2 no select all.
+	Db2NoSelectAll Type = "bb.plugin.advisor.db2.select.no-select-all"
+
+	// Db2WhereRequirementForSelect is an advisor type for Db2 WHERE clause requirement for SELECT statements.
+	Db2WhereRequirementForSelect Type = "bb.plugin.advisor.db2.where.require.select"
+
+	// Db2WhereRequirementForUpdateDelete is an advisor type for Db2 WHERE clause requirement for UPDATE/DELETE statements.
+	Db2WhereRequirementForUpdateDelete Type = "bb.plugin.advisor.db2.where.require.update-delete"
 )
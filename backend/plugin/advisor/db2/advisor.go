@@ -0,0 +1,54 @@
+// Package db2 is the advisor for Db2 database.
+package db2
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	db2parser "github.com/bytebase/bytebase/backend/plugin/parser/db2"
+)
+
+// statement is a split statement with its tokens.
+type statement struct {
+	single base.SingleSQL
+	tokens []db2parser.Token
+	// depths are the parenthesis depths of the tokens.
+	depths []int
+}
+
+// getStatements returns the statements to check, the AST of Db2 is the split statements.
+func getStatements(ctx advisor.Context) ([]*statement, error) {
+	list, ok := ctx.AST.([]base.SingleSQL)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to []base.SingleSQL")
+	}
+	var statements []*statement
+	for _, single := range list {
+		tokens, err := db2parser.Tokenize(single.Text)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+		s := &statement{single: single, tokens: tokens}
+		depth := 0
+		for _, token := range tokens {
+			if token.Type == db2parser.TokenOperator && token.Text == ")" {
+				depth--
+			}
+			s.depths = append(s.depths, depth)
+			if token.Type == db2parser.TokenOperator && token.Text == "(" {
+				depth++
+			}
+		}
+		statements = append(statements, s)
+	}
+	return statements, nil
+}
+
+// getLine returns the one based line of the token in the whole statements.
+func (s *statement) getLine(token db2parser.Token) int32 {
+	return int32(s.single.BaseLine + token.Line + 1)
+}
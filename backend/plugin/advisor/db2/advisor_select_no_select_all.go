@@ -0,0 +1,57 @@
+package db2
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	db2parser "github.com/bytebase/bytebase/backend/plugin/parser/db2"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*NoSelectAllAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DB2, advisor.Db2NoSelectAll, &NoSelectAllAdvisor{})
+}
+
+// NoSelectAllAdvisor is the advisor checking for no select all.
+type NoSelectAllAdvisor struct {
+}
+
+// Check checks for no select all.
+func (*NoSelectAllAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	statements, err := getStatements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, s := range statements {
+		for i, token := range s.tokens {
+			if !token.IsWord("SELECT") {
+				continue
+			}
+			j := i + 1
+			if j < len(s.tokens) && s.tokens[j].IsWord("DISTINCT", "ALL") {
+				j++
+			}
+			if j < len(s.tokens) && s.tokens[j].Type == db2parser.TokenOperator && s.tokens[j].Text == "*" {
+				adviceList = append(adviceList, &storepb.Advice{
+					Status:  level,
+					Code:    advisor.StatementSelectAll.Int32(),
+					Title:   string(ctx.Rule.Type),
+					Content: "Avoid using SELECT *.",
+					StartPosition: &storepb.Position{
+						Line: s.getLine(s.tokens[j]),
+					},
+				})
+				break
+			}
+		}
+	}
+	return adviceList, nil
+}
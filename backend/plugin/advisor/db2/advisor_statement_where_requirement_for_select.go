@@ -0,0 +1,80 @@
+package db2
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*WhereRequirementForSelectAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DB2, advisor.Db2WhereRequirementForSelect, &WhereRequirementForSelectAdvisor{})
+}
+
+// WhereRequirementForSelectAdvisor is the advisor checking for the WHERE clause requirement for SELECT statements.
+type WhereRequirementForSelectAdvisor struct {
+}
+
+// Check checks for the WHERE clause requirement for SELECT statements.
+func (*WhereRequirementForSelectAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	statements, err := getStatements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, s := range statements {
+		for i, token := range s.tokens {
+			if !token.IsWord("SELECT") {
+				continue
+			}
+			if hasFrom, hasWhere := s.getSelectClauses(i); hasFrom && !hasWhere {
+				adviceList = append(adviceList, &storepb.Advice{
+					Status:  level,
+					Code:    advisor.StatementNoWhere.Int32(),
+					Title:   string(ctx.Rule.Type),
+					Content: "WHERE clause is required for SELECT statement.",
+					StartPosition: &storepb.Position{
+						Line: s.getLine(token),
+					},
+				})
+			}
+		}
+	}
+	return adviceList, nil
+}
+
+// getSelectClauses returns whether the SELECT at the index has the FROM and WHERE clauses.
+// Selecting from SYSIBM.SYSDUMMY1, such as SELECT CURRENT DATE FROM SYSIBM.SYSDUMMY1, is treated as no FROM clause.
+func (s *statement) getSelectClauses(index int) (bool, bool) {
+	depth := s.depths[index]
+	hasFrom, hasWhere := false, false
+	for i := index + 1; i < len(s.tokens); i++ {
+		if s.depths[i] < depth {
+			break
+		}
+		if s.depths[i] > depth {
+			continue
+		}
+		token := s.tokens[i]
+		switch {
+		// The next SELECT of the set operations, such as UNION.
+		case token.IsWord("SELECT", "VALUES"):
+			return hasFrom, hasWhere
+		case token.IsWord("FROM"):
+			isDummy := i+3 < len(s.tokens) && s.tokens[i+1].IsWord("SYSIBM") && s.tokens[i+2].Text == "." && s.tokens[i+3].IsWord("SYSDUMMY1")
+			if !isDummy {
+				hasFrom = true
+			}
+		case token.IsWord("WHERE"):
+			hasWhere = true
+		}
+	}
+	return hasFrom, hasWhere
+}
@@ -0,0 +1,61 @@
+package db2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*WhereRequirementForUpdateDeleteAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DB2, advisor.Db2WhereRequirementForUpdateDelete, &WhereRequirementForUpdateDeleteAdvisor{})
+}
+
+// WhereRequirementForUpdateDeleteAdvisor is the advisor checking for the WHERE clause requirement for UPDATE and DELETE statements.
+type WhereRequirementForUpdateDeleteAdvisor struct {
+}
+
+// Check checks for the WHERE clause requirement for UPDATE and DELETE statements.
+func (*WhereRequirementForUpdateDeleteAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	statements, err := getStatements(ctx)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, s := range statements {
+		first := s.tokens[0]
+		if !first.IsWord("UPDATE", "DELETE") {
+			continue
+		}
+		hasWhere := false
+		for i, token := range s.tokens {
+			if s.depths[i] == 0 && token.IsWord("WHERE") {
+				hasWhere = true
+				break
+			}
+		}
+		if hasWhere {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.StatementNoWhere.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("WHERE clause is required for %s statement.", strings.ToUpper(first.Text)),
+			StartPosition: &storepb.Position{
+				Line: s.getLine(first),
+			},
+		})
+	}
+	return adviceList, nil
+}
@@ -0,0 +1,20 @@
+package db2
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDb2Rules(t *testing.T) {
+	db2Rules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleStatementNoSelectAll,
+		advisor.SchemaRuleStatementRequireWhereForSelect,
+		advisor.SchemaRuleStatementRequireWhereForUpdateDelete,
+	}
+
+	for _, rule := range db2Rules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_DB2, false, false /* record */)
+	}
+}
@@ -893,6 +893,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeWhereRequirementForSelect, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLWhereRequirementForSelect, nil
+		case storepb.Engine_DB2:
+			return Db2WhereRequirementForSelect, nil
 		}
 	case SchemaRuleStatementRequireWhereForUpdateDelete:
 		switch engine {
@@ -906,6 +908,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeWhereRequirementForUpdateDelete, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLWhereRequirementForUpdateDelete, nil
+		case storepb.Engine_DB2:
+			return Db2WhereRequirementForUpdateDelete, nil
 		}
 	case SchemaRuleStatementNoLeadingWildcardLike:
 		switch engine {
@@ -928,6 +932,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeNoSelectAll, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLNoSelectAll, nil
+		case storepb.Engine_DB2:
+			return Db2NoSelectAll, nil
 		}
 	case SchemaRuleSchemaBackwardCompatibility:
 		switch engine {
@@ -796,3 +796,19 @@ func isPlSQLBlock(stmt string) bool {
 
 	return false
 }
+
+// GetSessionID returns the session ID of the connection.
+func (*Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var id string
+	if err := conn.QueryRowContext(ctx, "SHOW session_id;").Scan(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// CancelQuery cancels the running queries of the session.
+// CockroachDB identifies the sessions by the session ID instead of the backend process ID.
+func (driver *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	_, err := driver.db.ExecContext(ctx, "CANCEL QUERIES IF EXISTS (SELECT query_id FROM [SHOW CLUSTER QUERIES] WHERE session_id = $1);", sessionID)
+	return err
+}
@@ -0,0 +1,264 @@
+// Package db2 is the plugin for IBM Db2 LUW driver.
+//
+// The go_ibm_db driver requires cgo and the IBM Data Server Driver (clidriver), so it's only
+// linked with the db2 build tag, such as `go build -tags db2`. Install the clidriver with
+// `go run github.com/ibmdb/go_ibm_db/installer/setup.go` and set the environment before building:
+//
+//	export IBM_DB_HOME=/path/to/clidriver
+//	export CGO_CFLAGS=-I$IBM_DB_HOME/include
+//	export CGO_LDFLAGS=-L$IBM_DB_HOME/lib
+//	export LD_LIBRARY_PATH=$IBM_DB_HOME/lib
+package db2
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	db2parser "github.com/bytebase/bytebase/backend/plugin/parser/db2"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	_ db.Driver = (*Driver)(nil)
+)
+
+const (
+	defaultPort = "50000"
+)
+
+func init() {
+	db.Register(storepb.Engine_DB2, newDriver)
+}
+
+// Driver is the IBM Db2 driver.
+type Driver struct {
+	db                   *sql.DB
+	databaseName         string
+	maximumSQLResultSize int64
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a Db2 driver.
+// A Db2 instance may host multiple databases but a connection is bound to one database,
+// so the database of the data source is required.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+	database := config.Database
+	if database == "" {
+		return nil, errors.New("database is required for Db2")
+	}
+	port := config.Port
+	if port == "" {
+		port = defaultPort
+	}
+	dsn := getDataSourceName(map[string]string{
+		"HOSTNAME": config.Host,
+		"PORT":     port,
+		"DATABASE": database,
+		"UID":      config.Username,
+		"PWD":      config.Password,
+		"PROTOCOL": "TCPIP",
+	})
+	db, err := sql.Open("go_ibm_db", dsn)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	driver.databaseName = database
+	driver.maximumSQLResultSize = config.MaximumSQLResultSize
+	return driver, nil
+}
+
+// getDataSourceName returns the CLI connection string of the keywords.
+// The values containing semicolons are enclosed in braces, such as PWD={a;b}.
+func getDataSourceName(keywords map[string]string) string {
+	var parts []string
+	for _, keyword := range []string{"HOSTNAME", "PORT", "DATABASE", "UID", "PWD", "PROTOCOL"} {
+		value := keywords[keyword]
+		if value == "" {
+			continue
+		}
+		if strings.ContainsAny(value, ";{}") {
+			value = fmt.Sprintf("{%s}", value)
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", keyword, value))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	return driver.db.Close()
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// Execute executes the statements in a transaction.
+func (driver *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	if opts.CreateDatabase {
+		return 0, errors.New("create database is not supported for Db2")
+	}
+
+	singleSQLs, err := db2parser.SplitSQL(statement)
+	if err != nil {
+		return 0, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return 0, nil
+	}
+
+	conn, err := driver.db.Conn(ctx)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	totalRowsAffected := int64(0)
+	for _, singleSQL := range singleSQLs {
+		sqlResult, err := tx.ExecContext(ctx, singleSQL.Text)
+		if err != nil {
+			return 0, &db.ErrorWithPosition{
+				Err: errors.Wrapf(err, "failed to execute context in a transaction"),
+				Start: &storepb.TaskRunResult_Position{
+					Line:   int32(singleSQL.FirstStatementLine),
+					Column: int32(singleSQL.FirstStatementColumn),
+				},
+				End: &storepb.TaskRunResult_Position{
+					Line:   int32(singleSQL.LastLine),
+					Column: int32(singleSQL.LastColumn),
+				},
+			}
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+		}
+		totalRowsAffected += rowsAffected
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrapf(err, "failed to commit transaction")
+	}
+	return totalRowsAffected, nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) ([]*v1pb.QueryResult, error) {
+	if queryContext.Explain {
+		return nil, errors.New("Db2 doesn't support EXPLAIN in SQL Editor")
+	}
+	singleSQLs, err := db2parser.SplitSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	// If the queryContext.Schema is not empty, set the default schema for the unqualified object names.
+	if queryContext.Schema != "" {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf(`SET SCHEMA "%s"`, queryContext.Schema)); err != nil {
+			return nil, err
+		}
+	}
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		statement := singleSQL.Text
+		_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_DB2, statement)
+		if err != nil {
+			return nil, err
+		}
+		if allQuery && queryContext.Limit > 0 {
+			statement = getStatementWithResultLimit(statement, queryContext.Limit)
+		}
+
+		startTime := time.Now()
+		queryResult, err := func() (*v1pb.QueryResult, error) {
+			if allQuery {
+				rows, err := conn.QueryContext(ctx, statement)
+				if err != nil {
+					return nil, err
+				}
+				defer rows.Close()
+				r, err := util.RowsToQueryResult(rows, driver.maximumSQLResultSize)
+				if err != nil {
+					return nil, err
+				}
+				if err := rows.Err(); err != nil {
+					return nil, err
+				}
+				return r, nil
+			}
+
+			sqlResult, err := conn.ExecContext(ctx, statement)
+			if err != nil {
+				return nil, err
+			}
+			affectedRows, err := sqlResult.RowsAffected()
+			if err != nil {
+				slog.Info("rowsAffected returns error", log.BBError(err))
+			}
+			return util.BuildAffectedRowsResult(affectedRows), nil
+		}()
+		stop := false
+		if err != nil {
+			queryResult = &v1pb.QueryResult{
+				Error: err.Error(),
+			}
+			stop = true
+		}
+		queryResult.Statement = statement
+		queryResult.Latency = durationpb.New(time.Since(startTime))
+		results = append(results, queryResult)
+		if stop {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// getStatementWithResultLimit limits the rows of the query.
+// The common table expressions cannot be nested in the subquery, so the WITH queries are limited by appending the clause.
+func getStatementWithResultLimit(statement string, limit int) string {
+	statement = util.TrimStatement(statement)
+	tokens, err := db2parser.Tokenize(statement)
+	if err != nil || len(tokens) == 0 || !tokens[0].IsWord("SELECT", "VALUES") {
+		return fmt.Sprintf("%s\nFETCH FIRST %d ROWS ONLY", statement, limit)
+	}
+	// To handle cases where there are comments in the query.
+	// eg. select * from t1 -- this is comment;
+	// Add two new line symbol here.
+	return fmt.Sprintf("SELECT * FROM (\n%s\n) FETCH FIRST %d ROWS ONLY", statement, limit)
+}
@@ -0,0 +1,171 @@
+//go:build db2
+
+package db2
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const testSchema = "BBTEST"
+
+// openTestDriver opens the driver to the Db2 database set by the environment, such as the ibmcom/db2 container:
+//
+//	BB_DB2_HOST=localhost BB_DB2_PORT=50000 BB_DB2_USER=db2inst1 BB_DB2_PASSWORD=password BB_DB2_DATABASE=testdb go test -tags db2 ./backend/plugin/db/db2/
+func openTestDriver(ctx context.Context, t *testing.T) db.Driver {
+	host := os.Getenv("BB_DB2_HOST")
+	if host == "" {
+		t.Skip("BB_DB2_HOST is not set")
+	}
+	driver, err := newDriver(db.DriverConfig{}).Open(ctx, storepb.Engine_DB2, db.ConnectionConfig{
+		Host:     host,
+		Port:     os.Getenv("BB_DB2_PORT"),
+		Username: os.Getenv("BB_DB2_USER"),
+		Password: os.Getenv("BB_DB2_PASSWORD"),
+		Database: os.Getenv("BB_DB2_DATABASE"),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		driver.Close(ctx)
+	})
+	require.NoError(t, driver.Ping(ctx))
+	return driver
+}
+
+func createTestSchema(ctx context.Context, t *testing.T, driver db.Driver) {
+	dropTestSchema(ctx, driver)
+	t.Cleanup(func() {
+		dropTestSchema(ctx, driver)
+	})
+	_, err := driver.Execute(ctx, `
+CREATE SCHEMA BBTEST;
+CREATE SEQUENCE BBTEST.ORDER_SEQ START WITH 100 INCREMENT BY 10;
+CREATE TABLE BBTEST.CUSTOMERS (
+  ID INTEGER NOT NULL GENERATED ALWAYS AS IDENTITY (START WITH 1, INCREMENT BY 1),
+  EMAIL VARCHAR(100) NOT NULL,
+  CONSTRAINT PK_CUSTOMERS PRIMARY KEY (ID),
+  CONSTRAINT UK_EMAIL UNIQUE (EMAIL)
+);
+CREATE TABLE BBTEST.ORDERS (
+  ID INTEGER NOT NULL,
+  CUSTOMER_ID INTEGER,
+  STATUS VARCHAR(10) DEFAULT 'NEW',
+  AMOUNT DECIMAL(10,2),
+  CONSTRAINT PK_ORDERS PRIMARY KEY (ID),
+  CONSTRAINT CK_STATUS CHECK (STATUS IN ('NEW', 'DONE')),
+  CONSTRAINT FK_CUSTOMER FOREIGN KEY (CUSTOMER_ID) REFERENCES BBTEST.CUSTOMERS (ID) ON DELETE CASCADE
+);
+CREATE INDEX BBTEST.IDX_ORDERS_STATUS ON BBTEST.ORDERS (STATUS);
+COMMENT ON TABLE BBTEST.ORDERS IS 'the orders';
+CREATE VIEW BBTEST.NEW_ORDERS AS SELECT ID, AMOUNT FROM BBTEST.ORDERS WHERE STATUS = 'NEW';
+--#SET TERMINATOR @
+CREATE PROCEDURE BBTEST.CLOSE_ORDER (IN ORDER_ID INTEGER)
+LANGUAGE SQL
+BEGIN
+  UPDATE BBTEST.ORDERS SET STATUS = 'DONE' WHERE ID = ORDER_ID;
+END
+@
+--#SET TERMINATOR ;
+`, db.ExecuteOptions{})
+	require.NoError(t, err)
+}
+
+func dropTestSchema(ctx context.Context, driver db.Driver) {
+	// The objects are dropped one by one, since the statements fail if the objects don't exist.
+	for _, statement := range []string{
+		"DROP PROCEDURE BBTEST.CLOSE_ORDER",
+		"DROP VIEW BBTEST.NEW_ORDERS",
+		"DROP TABLE BBTEST.ORDERS",
+		"DROP TABLE BBTEST.CUSTOMERS",
+		"DROP SEQUENCE BBTEST.ORDER_SEQ",
+		"DROP SCHEMA BBTEST RESTRICT",
+	} {
+		_, _ = driver.Execute(ctx, statement, db.ExecuteOptions{})
+	}
+}
+
+func TestSyncDBSchema(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	driver := openTestDriver(ctx, t)
+	createTestSchema(ctx, t, driver)
+
+	metadata, err := driver.SyncDBSchema(ctx)
+	a.NoError(err)
+	var schema *storepb.SchemaMetadata
+	for _, s := range metadata.Schemas {
+		if s.Name == testSchema {
+			schema = s
+		}
+	}
+	a.NotNil(schema)
+
+	a.Len(schema.Tables, 2)
+	customers, orders := schema.Tables[0], schema.Tables[1]
+	a.Equal("CUSTOMERS", customers.Name)
+	a.Equal("ORDERS", orders.Name)
+	a.Equal("the orders", orders.Comment)
+
+	var columnTypes []string
+	for _, column := range orders.Columns {
+		columnTypes = append(columnTypes, column.Name+" "+column.Type)
+	}
+	a.Equal([]string{"ID INTEGER", "CUSTOMER_ID INTEGER", "STATUS VARCHAR(10)", "AMOUNT DECIMAL(10,2)"}, columnTypes)
+	a.False(orders.Columns[0].Nullable)
+	a.Equal("'NEW'", orders.Columns[2].GetDefaultExpression())
+
+	indexes := make(map[string]*storepb.IndexMetadata)
+	for _, index := range append(customers.Indexes, orders.Indexes...) {
+		indexes[index.Name] = index
+	}
+	a.True(indexes["PK_CUSTOMERS"].Primary)
+	a.True(indexes["UK_EMAIL"].Unique)
+	a.Equal([]string{"EMAIL"}, indexes["UK_EMAIL"].Expressions)
+	a.Equal([]string{"STATUS"}, indexes["IDX_ORDERS_STATUS"].Expressions)
+
+	a.Len(orders.ForeignKeys, 1)
+	a.Equal("FK_CUSTOMER", orders.ForeignKeys[0].Name)
+	a.Equal("CUSTOMERS", orders.ForeignKeys[0].ReferencedTable)
+	a.Equal([]string{"ID"}, orders.ForeignKeys[0].ReferencedColumns)
+	a.Equal("CASCADE", orders.ForeignKeys[0].OnDelete)
+	a.Len(orders.CheckConstraints, 1)
+	a.Equal("CK_STATUS", orders.CheckConstraints[0].Name)
+
+	a.Len(schema.Views, 1)
+	a.Equal("NEW_ORDERS", schema.Views[0].Name)
+	a.Len(schema.Sequences, 1)
+	a.Equal("ORDER_SEQ", schema.Sequences[0].Name)
+	a.Len(schema.Procedures, 1)
+	a.Equal("CLOSE_ORDER", schema.Procedures[0].Name)
+}
+
+func TestDump(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	driver := openTestDriver(ctx, t)
+	createTestSchema(ctx, t, driver)
+
+	var buf strings.Builder
+	a.NoError(driver.Dump(ctx, &buf))
+	dump := buf.String()
+	for _, want := range []string{
+		`CREATE SCHEMA "BBTEST";`,
+		`"ID" INTEGER NOT NULL GENERATED ALWAYS AS IDENTITY (START WITH 1, INCREMENT BY 1)`,
+		`CONSTRAINT "PK_ORDERS" PRIMARY KEY ("ID")`,
+		`COMMENT ON TABLE "BBTEST"."ORDERS" IS 'the orders';`,
+		`ALTER TABLE "BBTEST"."ORDERS" ADD CONSTRAINT "FK_CUSTOMER" FOREIGN KEY ("CUSTOMER_ID") REFERENCES "BBTEST"."CUSTOMERS" ("ID") ON DELETE CASCADE ON UPDATE NO ACTION;`,
+		"--#SET TERMINATOR @\nCREATE PROCEDURE BBTEST.CLOSE_ORDER",
+	} {
+		a.Contains(dump, want)
+	}
+	// The dependencies are created before the dependents.
+	a.Less(strings.Index(dump, "CREATE SEQUENCE"), strings.Index(dump, `CREATE TABLE "BBTEST"."CUSTOMERS"`))
+	a.Less(strings.Index(dump, `CREATE TABLE "BBTEST"."ORDERS"`), strings.Index(dump, "CREATE VIEW BBTEST.NEW_ORDERS"))
+}
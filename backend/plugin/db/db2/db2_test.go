@@ -0,0 +1,139 @@
+package db2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetDataSourceName(t *testing.T) {
+	a := require.New(t)
+	got := getDataSourceName(map[string]string{
+		"HOSTNAME": "localhost",
+		"PORT":     "50000",
+		"DATABASE": "TESTDB",
+		"UID":      "db2inst1",
+		"PWD":      "a;b",
+		"PROTOCOL": "TCPIP",
+	})
+	a.Equal("HOSTNAME=localhost;PORT=50000;DATABASE=TESTDB;UID=db2inst1;PWD={a;b};PROTOCOL=TCPIP", got)
+
+	// The empty values are skipped.
+	got = getDataSourceName(map[string]string{"HOSTNAME": "localhost", "DATABASE": "TESTDB"})
+	a.Equal("HOSTNAME=localhost;DATABASE=TESTDB", got)
+}
+
+func TestGetStatementWithResultLimit(t *testing.T) {
+	tests := []struct {
+		statement string
+		limit     int
+		want      string
+	}{
+		{
+			statement: "SELECT * FROM t;",
+			limit:     10,
+			want:      "SELECT * FROM (\nSELECT * FROM t\n) FETCH FIRST 10 ROWS ONLY",
+		},
+		{
+			statement: "VALUES 1 -- comment",
+			limit:     5,
+			want:      "SELECT * FROM (\nVALUES 1 -- comment\n) FETCH FIRST 5 ROWS ONLY",
+		},
+		{
+			statement: "WITH c AS (SELECT 1 FROM SYSIBM.SYSDUMMY1) SELECT * FROM c",
+			limit:     5,
+			want:      "WITH c AS (SELECT 1 FROM SYSIBM.SYSDUMMY1) SELECT * FROM c\nFETCH FIRST 5 ROWS ONLY",
+		},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getStatementWithResultLimit(test.statement, test.limit), test.statement)
+	}
+}
+
+func TestFormatColumnType(t *testing.T) {
+	tests := []struct {
+		typeName string
+		length   int
+		scale    int
+		codePage int
+		want     string
+	}{
+		{typeName: "VARCHAR", length: 20, codePage: 1208, want: "VARCHAR(20)"},
+		{typeName: "CHARACTER", length: 16, codePage: 0, want: "CHARACTER(16) FOR BIT DATA"},
+		{typeName: "BLOB", length: 1048576, want: "BLOB(1048576)"},
+		{typeName: "DECIMAL", length: 10, scale: 2, want: "DECIMAL(10,2)"},
+		{typeName: "TIMESTAMP", length: 10, scale: 6, want: "TIMESTAMP"},
+		{typeName: "TIMESTAMP", length: 10, scale: 3, want: "TIMESTAMP(3)"},
+		{typeName: "INTEGER", length: 4, want: "INTEGER"},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, formatColumnType(test.typeName, test.length, test.scale, test.codePage))
+	}
+}
+
+func TestConvertReferentialRule(t *testing.T) {
+	a := require.New(t)
+	a.Equal("CASCADE", convertReferentialRule("C"))
+	a.Equal("SET NULL", convertReferentialRule("N"))
+	a.Equal("RESTRICT", convertReferentialRule("R"))
+	a.Equal("NO ACTION", convertReferentialRule("A"))
+}
+
+func TestWriteTable(t *testing.T) {
+	a := require.New(t)
+	table := &storepb.TableMetadata{
+		Name:    "ORDERS",
+		Comment: "the customer's orders",
+		Columns: []*storepb.ColumnMetadata{
+			{Name: "ID", Type: "INTEGER"},
+			{Name: "CUSTOMER_ID", Type: "INTEGER"},
+			{Name: "STATUS", Type: "VARCHAR(10)", Nullable: true, DefaultValue: &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: "'NEW'"}, Comment: "the order status"},
+			{Name: "TOTAL", Type: "DECIMAL(10,2)", Nullable: true, Generation: &storepb.GenerationMetadata{Expression: "(PRICE * QUANTITY)"}},
+		},
+		Indexes: []*storepb.IndexMetadata{
+			{Name: "PK_ORDERS", Primary: true, Unique: true, Expressions: []string{"ID"}},
+			{Name: "IDX_ORDERS_STATUS", Expressions: []string{"STATUS"}, Definition: `CREATE INDEX "APP"."IDX_ORDERS_STATUS" ON "APP"."ORDERS" ("STATUS")`},
+		},
+		CheckConstraints: []*storepb.CheckConstraintMetadata{
+			{Name: "CK_STATUS", Expression: "STATUS IN ('NEW', 'DONE')"},
+		},
+	}
+	identityMap := map[db.ColumnKey]string{
+		{Schema: "APP", Table: "ORDERS", Column: "ID"}: "GENERATED ALWAYS AS IDENTITY (START WITH 1, INCREMENT BY 1)",
+	}
+	var buf strings.Builder
+	writeTable(&buf, "APP", table, identityMap)
+	want := `CREATE TABLE "APP"."ORDERS" (
+  "ID" INTEGER NOT NULL GENERATED ALWAYS AS IDENTITY (START WITH 1, INCREMENT BY 1),
+  "CUSTOMER_ID" INTEGER NOT NULL,
+  "STATUS" VARCHAR(10) DEFAULT 'NEW',
+  "TOTAL" DECIMAL(10,2) GENERATED ALWAYS AS (PRICE * QUANTITY),
+  CONSTRAINT "PK_ORDERS" PRIMARY KEY ("ID"),
+  CONSTRAINT "CK_STATUS" CHECK (STATUS IN ('NEW', 'DONE'))
+);
+COMMENT ON TABLE "APP"."ORDERS" IS 'the customer''s orders';
+COMMENT ON COLUMN "APP"."ORDERS"."STATUS" IS 'the order status';
+`
+	a.Equal(want, buf.String())
+}
+
+func TestWriteForeignKey(t *testing.T) {
+	a := require.New(t)
+	var buf strings.Builder
+	writeForeignKey(&buf, "APP", "ORDERS", &storepb.ForeignKeyMetadata{
+		Name:              "FK_CUSTOMER",
+		Columns:           []string{"CUSTOMER_ID"},
+		ReferencedSchema:  "APP",
+		ReferencedTable:   "CUSTOMERS",
+		ReferencedColumns: []string{"ID"},
+		OnDelete:          "CASCADE",
+		OnUpdate:          "NO ACTION",
+	})
+	a.Equal(`ALTER TABLE "APP"."ORDERS" ADD CONSTRAINT "FK_CUSTOMER" FOREIGN KEY ("CUSTOMER_ID") REFERENCES "APP"."CUSTOMERS" ("ID") ON DELETE CASCADE ON UPDATE NO ACTION;`+"\n", buf.String())
+}
@@ -0,0 +1,267 @@
+package db2
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Dump dumps the database.
+// The objects are dumped in the order of the dependencies: schemas, sequences, tables, indexes, foreign keys, views and routines.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer) error {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	metadata, err := getDatabaseMetadata(txn, driver.databaseName)
+	if err != nil {
+		return err
+	}
+	identityMap, err := getIdentityColumns(txn)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get identity columns")
+	}
+	sequenceStatements, err := getSequenceStatements(txn)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sequences")
+	}
+
+	var buf strings.Builder
+	for _, schema := range metadata.Schemas {
+		fmt.Fprintf(&buf, "CREATE SCHEMA \"%s\";\n", schema.Name)
+	}
+	for _, statement := range sequenceStatements {
+		fmt.Fprintf(&buf, "%s;\n", statement)
+	}
+	for _, schema := range metadata.Schemas {
+		for _, table := range schema.Tables {
+			writeTable(&buf, schema.Name, table, identityMap)
+		}
+	}
+	for _, schema := range metadata.Schemas {
+		for _, table := range schema.Tables {
+			for _, index := range table.Indexes {
+				if index.Definition != "" {
+					fmt.Fprintf(&buf, "%s;\n", index.Definition)
+				}
+			}
+		}
+	}
+	for _, schema := range metadata.Schemas {
+		for _, table := range schema.Tables {
+			for _, foreignKey := range table.ForeignKeys {
+				writeForeignKey(&buf, schema.Name, table.Name, foreignKey)
+			}
+		}
+	}
+	if err := writeViewsAndRoutines(txn, &buf); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(out, buf.String())
+	return err
+}
+
+// getIdentityColumns gets the identity clauses of the identity columns, such as "GENERATED ALWAYS AS IDENTITY (START WITH 1, INCREMENT BY 1)".
+func getIdentityColumns(txn *sql.Tx) (map[db.ColumnKey]string, error) {
+	identityMap := make(map[db.ColumnKey]string)
+	query := fmt.Sprintf(`
+		SELECT C.TABSCHEMA, C.TABNAME, C.COLNAME, C.GENERATED, VARCHAR(I.START), VARCHAR(I.INCREMENT)
+		FROM SYSCAT.COLUMNS C
+		JOIN SYSCAT.COLIDENTATTRIBUTES I ON I.TABSCHEMA = C.TABSCHEMA AND I.TABNAME = C.TABNAME AND I.COLNAME = C.COLNAME
+		WHERE C.IDENTITY = 'Y' AND %s`, systemSchemaCondition("C.TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key db.ColumnKey
+		var generated, start, increment string
+		if err := rows.Scan(&key.Schema, &key.Table, &key.Column, &generated, &start, &increment); err != nil {
+			return nil, err
+		}
+		always := "ALWAYS"
+		if generated == "D" {
+			always = "BY DEFAULT"
+		}
+		identityMap[key] = fmt.Sprintf("GENERATED %s AS IDENTITY (START WITH %s, INCREMENT BY %s)", always, start, increment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return identityMap, nil
+}
+
+// getSequenceStatements gets the CREATE SEQUENCE statements.
+func getSequenceStatements(txn *sql.Tx) ([]string, error) {
+	query := fmt.Sprintf(`
+		SELECT S.SEQSCHEMA, S.SEQNAME, D.TYPENAME, VARCHAR(S.START), VARCHAR(S.INCREMENT), VARCHAR(S.MINVALUE), VARCHAR(S.MAXVALUE), S.CYCLE, S.CACHE, S.ORDER
+		FROM SYSCAT.SEQUENCES S
+		JOIN SYSCAT.DATATYPES D ON D.TYPEID = S.DATATYPEID
+		WHERE S.SEQTYPE = 'S' AND %s
+		ORDER BY S.CREATE_TIME`, systemSchemaCondition("S.SEQSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var statements []string
+	for rows.Next() {
+		var schemaName, name, dataType, start, increment, minValue, maxValue, cycle, order string
+		var cache int64
+		if err := rows.Scan(&schemaName, &name, &dataType, &start, &increment, &minValue, &maxValue, &cycle, &cache, &order); err != nil {
+			return nil, err
+		}
+		var buf strings.Builder
+		fmt.Fprintf(&buf, `CREATE SEQUENCE "%s"."%s" AS %s START WITH %s INCREMENT BY %s MINVALUE %s MAXVALUE %s`, schemaName, name, dataType, start, increment, minValue, maxValue)
+		if cycle == "Y" {
+			buf.WriteString(" CYCLE")
+		} else {
+			buf.WriteString(" NO CYCLE")
+		}
+		// The cache is 0 for NO CACHE.
+		if cache > 1 {
+			fmt.Fprintf(&buf, " CACHE %d", cache)
+		} else {
+			buf.WriteString(" NO CACHE")
+		}
+		if order == "Y" {
+			buf.WriteString(" ORDER")
+		}
+		statements = append(statements, buf.String())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return statements, nil
+}
+
+// writeTable writes the CREATE TABLE statement with the primary keys, unique constraints and check constraints,
+// followed by the comments.
+func writeTable(buf *strings.Builder, schemaName string, table *storepb.TableMetadata, identityMap map[db.ColumnKey]string) {
+	var definitions []string
+	for _, column := range table.Columns {
+		definition := fmt.Sprintf(`"%s" %s`, column.Name, column.Type)
+		if !column.Nullable {
+			definition += " NOT NULL"
+		}
+		if identity, ok := identityMap[db.ColumnKey{Schema: schemaName, Table: table.Name, Column: column.Name}]; ok {
+			definition += " " + identity
+		} else if column.Generation != nil {
+			definition += fmt.Sprintf(" GENERATED ALWAYS AS %s", column.Generation.Expression)
+		} else if column.GetDefaultExpression() != "" {
+			definition += fmt.Sprintf(" DEFAULT %s", column.GetDefaultExpression())
+		}
+		definitions = append(definitions, definition)
+	}
+	for _, index := range table.Indexes {
+		// The indexes with the definitions are created by the CREATE INDEX statements.
+		if index.Definition != "" {
+			continue
+		}
+		constraintType := "UNIQUE"
+		if index.Primary {
+			constraintType = "PRIMARY KEY"
+		}
+		definitions = append(definitions, fmt.Sprintf(`CONSTRAINT "%s" %s (%s)`, index.Name, constraintType, quoteIdentifiers(index.Expressions)))
+	}
+	for _, check := range table.CheckConstraints {
+		definitions = append(definitions, fmt.Sprintf(`CONSTRAINT "%s" CHECK (%s)`, check.Name, check.Expression))
+	}
+	fmt.Fprintf(buf, "CREATE TABLE \"%s\".\"%s\" (\n  %s\n);\n", schemaName, table.Name, strings.Join(definitions, ",\n  "))
+
+	if table.Comment != "" {
+		fmt.Fprintf(buf, "COMMENT ON TABLE \"%s\".\"%s\" IS '%s';\n", schemaName, table.Name, escapeString(table.Comment))
+	}
+	for _, column := range table.Columns {
+		if column.Comment != "" {
+			fmt.Fprintf(buf, "COMMENT ON COLUMN \"%s\".\"%s\".\"%s\" IS '%s';\n", schemaName, table.Name, column.Name, escapeString(column.Comment))
+		}
+	}
+}
+
+// writeForeignKey writes the foreign key by ALTER TABLE, so that the tables can reference each other.
+func writeForeignKey(buf *strings.Builder, schemaName, tableName string, foreignKey *storepb.ForeignKeyMetadata) {
+	fmt.Fprintf(buf, "ALTER TABLE \"%s\".\"%s\" ADD CONSTRAINT \"%s\" FOREIGN KEY (%s) REFERENCES \"%s\".\"%s\" (%s) ON DELETE %s ON UPDATE %s;\n",
+		schemaName,
+		tableName,
+		foreignKey.Name,
+		quoteIdentifiers(foreignKey.Columns),
+		foreignKey.ReferencedSchema,
+		foreignKey.ReferencedTable,
+		quoteIdentifiers(foreignKey.ReferencedColumns),
+		foreignKey.OnDelete,
+		foreignKey.OnUpdate,
+	)
+}
+
+// writeViewsAndRoutines writes the views and the SQL routines in the order of the creation, since they may depend on each other.
+// The unqualified names in the definitions are resolved by the default schema at the creation, so the default schema is set before each definition.
+// The routines contain semicolons, so they are terminated by the alternative terminator.
+func writeViewsAndRoutines(txn *sql.Tx, buf *strings.Builder) error {
+	query := fmt.Sprintf(`
+		SELECT V.QUALIFIER, V.TEXT, 'V' AS OBJECT_TYPE, T.CREATE_TIME
+		FROM SYSCAT.VIEWS V
+		JOIN SYSCAT.TABLES T ON T.TABSCHEMA = V.VIEWSCHEMA AND T.TABNAME = V.VIEWNAME
+		WHERE T.TYPE = 'V' AND %s
+		UNION ALL
+		SELECT QUALIFIER, TEXT, 'R' AS OBJECT_TYPE, CREATE_TIME
+		FROM SYSCAT.ROUTINES
+		WHERE ROUTINETYPE IN ('F', 'P') AND TEXT IS NOT NULL AND %s
+		ORDER BY CREATE_TIME`, systemSchemaCondition("V.VIEWSCHEMA"), systemSchemaCondition("ROUTINESCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	currentSchema := ""
+	for rows.Next() {
+		var qualifier, definition, objectType string
+		var createTime sql.NullTime
+		if err := rows.Scan(&qualifier, &definition, &objectType, &createTime); err != nil {
+			return err
+		}
+		if qualifier != currentSchema {
+			fmt.Fprintf(buf, "SET SCHEMA \"%s\";\n", qualifier)
+			currentSchema = qualifier
+		}
+		definition = strings.TrimSpace(definition)
+		if objectType == "V" {
+			fmt.Fprintf(buf, "%s;\n", definition)
+			continue
+		}
+		fmt.Fprintf(buf, "--#SET TERMINATOR @\n%s\n@\n--#SET TERMINATOR ;\n", definition)
+	}
+	if err := rows.Err(); err != nil {
+		return util.FormatErrorWithQuery(err, query)
+	}
+	return nil
+}
+
+func quoteIdentifiers(identifiers []string) string {
+	var quoted []string
+	for _, identifier := range identifiers {
+		quoted = append(quoted, fmt.Sprintf(`"%s"`, identifier))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func escapeString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
@@ -0,0 +1,8 @@
+//go:build db2
+
+package db2
+
+import (
+	// Import IBM Db2 driver.
+	_ "github.com/ibmdb/go_ibm_db"
+)
@@ -0,0 +1,619 @@
+package db2
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SyncInstance syncs the instance.
+// The connection is bound to one database, so the connected database is the only database of the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	version, err := driver.getVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var databaseName string
+	if err := driver.db.QueryRowContext(ctx, "SELECT CURRENT SERVER FROM SYSIBM.SYSDUMMY1").Scan(&databaseName); err != nil {
+		return nil, err
+	}
+
+	return &db.InstanceMetadata{
+		Version: version,
+		Databases: []*storepb.DatabaseSchemaMetadata{
+			{Name: strings.TrimSpace(databaseName)},
+		},
+	}, nil
+}
+
+// getVersion gets the version, such as "11.5.8.0".
+func (driver *Driver) getVersion(ctx context.Context) (string, error) {
+	var serviceLevel string
+	// The service level is like "DB2 v11.5.8.0".
+	if err := driver.db.QueryRowContext(ctx, "SELECT SERVICE_LEVEL FROM SYSIBMADM.ENV_INST_INFO").Scan(&serviceLevel); err != nil {
+		return "", err
+	}
+	fields := strings.Fields(serviceLevel)
+	if len(fields) == 0 {
+		return "", errors.Errorf("invalid service level %q", serviceLevel)
+	}
+	return strings.TrimPrefix(fields[len(fields)-1], "v"), nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	databaseMetadata, err := getDatabaseMetadata(txn, driver.databaseName)
+	if err != nil {
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return databaseMetadata, nil
+}
+
+func getDatabaseMetadata(txn *sql.Tx, databaseName string) (*storepb.DatabaseSchemaMetadata, error) {
+	schemaNames, err := getSchemas(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schemas")
+	}
+	tableMap, err := getTables(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tables")
+	}
+	viewMap, err := getViews(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views")
+	}
+	sequenceMap, err := getSequences(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sequences")
+	}
+	functionMap, procedureMap, err := getRoutines(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get routines")
+	}
+
+	databaseMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: databaseName,
+	}
+	for _, schemaName := range schemaNames {
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:       schemaName,
+			Tables:     tableMap[schemaName],
+			Views:      viewMap[schemaName],
+			Sequences:  sequenceMap[schemaName],
+			Functions:  functionMap[schemaName],
+			Procedures: procedureMap[schemaName],
+		})
+	}
+	return databaseMetadata, nil
+}
+
+// systemSchemaCondition excludes the system schemas, such as SYSIBM, SYSCAT, SYSTOOLS, NULLID for the packages and SQLJ for the Java routines.
+func systemSchemaCondition(column string) string {
+	return fmt.Sprintf("%s NOT LIKE 'SYS%%' AND %s NOT IN ('NULLID', 'SQLJ')", column, column)
+}
+
+func getSchemas(txn *sql.Tx) ([]string, error) {
+	query := fmt.Sprintf(`
+		SELECT SCHEMANAME FROM SYSCAT.SCHEMATA
+		WHERE %s
+		ORDER BY SCHEMANAME`, systemSchemaCondition("SCHEMANAME"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var schemaNames []string
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, err
+		}
+		schemaNames = append(schemaNames, schemaName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return schemaNames, nil
+}
+
+// getTables gets all tables of a database keyed by the schema.
+func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
+	columnMap, err := getColumns(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get columns")
+	}
+	keyColumnMap, err := getKeyColumns(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get key columns")
+	}
+	constraintMap, err := getKeyConstraints(txn, keyColumnMap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get primary and unique keys")
+	}
+	indexMap, err := getIndexes(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get indexes")
+	}
+	foreignKeyMap, err := getForeignKeys(txn, keyColumnMap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get foreign keys")
+	}
+	checkMap, err := getCheckConstraints(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get check constraints")
+	}
+
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	query := fmt.Sprintf(`
+		SELECT TABSCHEMA, TABNAME, CARD, REMARKS
+		FROM SYSCAT.TABLES
+		WHERE TYPE = 'T' AND %s
+		ORDER BY TABSCHEMA, TABNAME`, systemSchemaCondition("TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		table := &storepb.TableMetadata{}
+		var schemaName string
+		var card int64
+		var comment sql.NullString
+		if err := rows.Scan(&schemaName, &table.Name, &card, &comment); err != nil {
+			return nil, err
+		}
+		// The cardinality is -1 if the statistics are not collected.
+		if card > 0 {
+			table.RowCount = card
+		}
+		table.Comment = comment.String
+		table.UserComment = comment.String
+		key := db.TableKey{Schema: schemaName, Table: table.Name}
+		table.Columns = columnMap[key]
+		table.Indexes = append(constraintMap[key], indexMap[key]...)
+		table.ForeignKeys = foreignKeyMap[key]
+		table.CheckConstraints = checkMap[key]
+		tableMap[schemaName] = append(tableMap[schemaName], table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return tableMap, nil
+}
+
+// getColumns gets the columns keyed by the table.
+func getColumns(txn *sql.Tx) (map[db.TableKey][]*storepb.ColumnMetadata, error) {
+	columnMap := make(map[db.TableKey][]*storepb.ColumnMetadata)
+	query := fmt.Sprintf(`
+		SELECT TABSCHEMA, TABNAME, COLNAME, COLNO, TYPENAME, LENGTH, SCALE, CODEPAGE, DEFAULT, NULLS, GENERATED, TEXT, REMARKS
+		FROM SYSCAT.COLUMNS
+		WHERE %s
+		ORDER BY TABSCHEMA, TABNAME, COLNO`, systemSchemaCondition("TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		column := &storepb.ColumnMetadata{}
+		var schemaName, tableName, typeName, nulls, generated string
+		var length, scale, codePage int
+		var defaultValue, text, comment sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Position, &typeName, &length, &scale, &codePage, &defaultValue, &nulls, &generated, &text, &comment); err != nil {
+			return nil, err
+		}
+		// The COLNO is zero based.
+		column.Position++
+		column.Type = formatColumnType(typeName, length, scale, codePage)
+		column.Nullable = nulls == "Y"
+		if defaultValue.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: defaultValue.String}
+		}
+		// The TEXT is the generation expression for the generated columns, such as "AS (A + B)".
+		// The identity columns are generated without the expression.
+		if generated != "" && text.Valid {
+			column.Generation = &storepb.GenerationMetadata{
+				Type:       storepb.GenerationMetadata_TYPE_STORED,
+				Expression: strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text.String), "AS")),
+			}
+		}
+		column.Comment = comment.String
+		column.UserComment = comment.String
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		columnMap[key] = append(columnMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return columnMap, nil
+}
+
+// formatColumnType formats the column type with the length and scale, such as VARCHAR(20) and DECIMAL(10,2).
+func formatColumnType(typeName string, length, scale, codePage int) string {
+	switch typeName {
+	case "CHARACTER", "VARCHAR":
+		// The character strings of code page 0 are the binary strings.
+		if codePage == 0 {
+			return fmt.Sprintf("%s(%d) FOR BIT DATA", typeName, length)
+		}
+		return fmt.Sprintf("%s(%d)", typeName, length)
+	case "GRAPHIC", "VARGRAPHIC", "BINARY", "VARBINARY", "CLOB", "BLOB", "DBCLOB":
+		return fmt.Sprintf("%s(%d)", typeName, length)
+	case "DECIMAL":
+		return fmt.Sprintf("DECIMAL(%d,%d)", length, scale)
+	case "TIMESTAMP":
+		// The default precision of the fractional seconds is 6.
+		if scale != 6 {
+			return fmt.Sprintf("TIMESTAMP(%d)", scale)
+		}
+		return typeName
+	default:
+		return typeName
+	}
+}
+
+// constraintKey is the key of the constraint columns.
+type constraintKey struct {
+	schema     string
+	table      string
+	constraint string
+}
+
+// getKeyColumns gets the ordered columns of the primary keys, unique keys and foreign keys.
+func getKeyColumns(txn *sql.Tx) (map[constraintKey][]string, error) {
+	keyColumnMap := make(map[constraintKey][]string)
+	query := fmt.Sprintf(`
+		SELECT TABSCHEMA, TABNAME, CONSTNAME, COLNAME
+		FROM SYSCAT.KEYCOLUSE
+		WHERE %s
+		ORDER BY TABSCHEMA, TABNAME, CONSTNAME, COLSEQ`, systemSchemaCondition("TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key constraintKey
+		var columnName string
+		if err := rows.Scan(&key.schema, &key.table, &key.constraint, &columnName); err != nil {
+			return nil, err
+		}
+		keyColumnMap[key] = append(keyColumnMap[key], columnName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return keyColumnMap, nil
+}
+
+// getKeyConstraints gets the primary keys and unique constraints keyed by the table.
+// The constraints are defined in the CREATE TABLE statements, so the definitions are empty.
+func getKeyConstraints(txn *sql.Tx, keyColumnMap map[constraintKey][]string) (map[db.TableKey][]*storepb.IndexMetadata, error) {
+	constraintMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+	query := fmt.Sprintf(`
+		SELECT TABSCHEMA, TABNAME, CONSTNAME, TYPE
+		FROM SYSCAT.TABCONST
+		WHERE TYPE IN ('P', 'U') AND %s
+		ORDER BY TABSCHEMA, TABNAME, TYPE, CONSTNAME`, systemSchemaCondition("TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key constraintKey
+		var constraintType string
+		if err := rows.Scan(&key.schema, &key.table, &key.constraint, &constraintType); err != nil {
+			return nil, err
+		}
+		index := &storepb.IndexMetadata{
+			Name:        key.constraint,
+			Expressions: keyColumnMap[key],
+			Unique:      true,
+			Primary:     constraintType == "P",
+			Visible:     true,
+		}
+		tableKey := db.TableKey{Schema: key.schema, Table: key.table}
+		constraintMap[tableKey] = append(constraintMap[tableKey], index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return constraintMap, nil
+}
+
+// getIndexes gets the indexes keyed by the table.
+// The indexes backing the primary keys and unique constraints are excluded, since they are created by the constraints.
+func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
+	type indexColumn struct {
+		name  string
+		order string
+	}
+	indexColumnMap := make(map[db.IndexKey][]indexColumn)
+	columnQuery := fmt.Sprintf(`
+		SELECT INDSCHEMA, INDNAME, COLNAME, COLORDER
+		FROM SYSCAT.INDEXCOLUSE
+		WHERE %s
+		ORDER BY INDSCHEMA, INDNAME, COLSEQ`, systemSchemaCondition("INDSCHEMA"))
+	columnRows, err := txn.Query(columnQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, columnQuery)
+	}
+	defer columnRows.Close()
+	for columnRows.Next() {
+		var indexSchema, indexName string
+		var column indexColumn
+		if err := columnRows.Scan(&indexSchema, &indexName, &column.name, &column.order); err != nil {
+			return nil, err
+		}
+		key := db.IndexKey{Schema: indexSchema, Index: indexName}
+		indexColumnMap[key] = append(indexColumnMap[key], column)
+	}
+	if err := columnRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, columnQuery)
+	}
+
+	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+	query := fmt.Sprintf(`
+		SELECT I.INDSCHEMA, I.INDNAME, I.TABSCHEMA, I.TABNAME, I.UNIQUERULE, I.INDEXTYPE, I.REMARKS
+		FROM SYSCAT.INDEXES I
+		WHERE I.INDEXTYPE IN ('REG', 'CLUS') AND I.UNIQUERULE != 'P' AND %s
+			AND NOT EXISTS (
+				SELECT 1 FROM SYSCAT.CONSTDEP C
+				WHERE C.BTYPE = 'I' AND C.BSCHEMA = I.INDSCHEMA AND C.BNAME = I.INDNAME
+			)
+		ORDER BY I.TABSCHEMA, I.TABNAME, I.INDNAME`, systemSchemaCondition("I.TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		index := &storepb.IndexMetadata{}
+		var indexSchema, schemaName, tableName, uniqueRule string
+		var comment sql.NullString
+		if err := rows.Scan(&indexSchema, &index.Name, &schemaName, &tableName, &uniqueRule, &index.Type, &comment); err != nil {
+			return nil, err
+		}
+		index.Unique = uniqueRule == "U"
+		index.Visible = true
+		index.Comment = comment.String
+
+		var keys, includes []string
+		for _, column := range indexColumnMap[db.IndexKey{Schema: indexSchema, Index: index.Name}] {
+			// The INCLUDE columns are stored in the leaf pages only and don't order the index.
+			if column.order == "I" {
+				includes = append(includes, fmt.Sprintf(`"%s"`, column.name))
+				continue
+			}
+			index.Expressions = append(index.Expressions, column.name)
+			index.Descending = append(index.Descending, column.order == "D")
+			key := fmt.Sprintf(`"%s"`, column.name)
+			if column.order == "D" {
+				key += " DESC"
+			}
+			keys = append(keys, key)
+		}
+		var buf strings.Builder
+		buf.WriteString("CREATE ")
+		if index.Unique {
+			buf.WriteString("UNIQUE ")
+		}
+		fmt.Fprintf(&buf, `INDEX "%s"."%s" ON "%s"."%s" (%s)`, indexSchema, index.Name, schemaName, tableName, strings.Join(keys, ", "))
+		if len(includes) > 0 {
+			fmt.Fprintf(&buf, " INCLUDE (%s)", strings.Join(includes, ", "))
+		}
+		if index.Type == "CLUS" {
+			buf.WriteString(" CLUSTER")
+		}
+		index.Definition = buf.String()
+
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return indexMap, nil
+}
+
+// getForeignKeys gets the foreign keys keyed by the table.
+func getForeignKeys(txn *sql.Tx, keyColumnMap map[constraintKey][]string) (map[db.TableKey][]*storepb.ForeignKeyMetadata, error) {
+	foreignKeyMap := make(map[db.TableKey][]*storepb.ForeignKeyMetadata)
+	query := fmt.Sprintf(`
+		SELECT TABSCHEMA, TABNAME, CONSTNAME, REFTABSCHEMA, REFTABNAME, REFKEYNAME, DELETERULE, UPDATERULE
+		FROM SYSCAT.REFERENCES
+		WHERE %s
+		ORDER BY TABSCHEMA, TABNAME, CONSTNAME`, systemSchemaCondition("TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		foreignKey := &storepb.ForeignKeyMetadata{}
+		var schemaName, tableName, referencedKeyName, deleteRule, updateRule string
+		if err := rows.Scan(&schemaName, &tableName, &foreignKey.Name, &foreignKey.ReferencedSchema, &foreignKey.ReferencedTable, &referencedKeyName, &deleteRule, &updateRule); err != nil {
+			return nil, err
+		}
+		foreignKey.Columns = keyColumnMap[constraintKey{schema: schemaName, table: tableName, constraint: foreignKey.Name}]
+		foreignKey.ReferencedColumns = keyColumnMap[constraintKey{schema: foreignKey.ReferencedSchema, table: foreignKey.ReferencedTable, constraint: referencedKeyName}]
+		foreignKey.OnDelete = convertReferentialRule(deleteRule)
+		foreignKey.OnUpdate = convertReferentialRule(updateRule)
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		foreignKeyMap[key] = append(foreignKeyMap[key], foreignKey)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return foreignKeyMap, nil
+}
+
+// convertReferentialRule converts the delete and update rules of the foreign keys.
+func convertReferentialRule(rule string) string {
+	switch rule {
+	case "C":
+		return "CASCADE"
+	case "N":
+		return "SET NULL"
+	case "R":
+		return "RESTRICT"
+	default:
+		return "NO ACTION"
+	}
+}
+
+// getCheckConstraints gets the check constraints keyed by the table.
+func getCheckConstraints(txn *sql.Tx) (map[db.TableKey][]*storepb.CheckConstraintMetadata, error) {
+	checkMap := make(map[db.TableKey][]*storepb.CheckConstraintMetadata)
+	// The type C is the check constraint, other types are the functional dependencies and the system generated checks.
+	query := fmt.Sprintf(`
+		SELECT TABSCHEMA, TABNAME, CONSTNAME, TEXT
+		FROM SYSCAT.CHECKS
+		WHERE TYPE = 'C' AND %s
+		ORDER BY TABSCHEMA, TABNAME, CONSTNAME`, systemSchemaCondition("TABSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		check := &storepb.CheckConstraintMetadata{}
+		var schemaName, tableName string
+		if err := rows.Scan(&schemaName, &tableName, &check.Name, &check.Expression); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		checkMap[key] = append(checkMap[key], check)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return checkMap, nil
+}
+
+// getViews gets all views of a database keyed by the schema.
+// The definitions are the CREATE VIEW statements.
+func getViews(txn *sql.Tx) (map[string][]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+	query := fmt.Sprintf(`
+		SELECT V.VIEWSCHEMA, V.VIEWNAME, V.TEXT, T.REMARKS
+		FROM SYSCAT.VIEWS V
+		JOIN SYSCAT.TABLES T ON T.TABSCHEMA = V.VIEWSCHEMA AND T.TABNAME = V.VIEWNAME
+		WHERE T.TYPE = 'V' AND %s
+		ORDER BY V.VIEWSCHEMA, V.VIEWNAME`, systemSchemaCondition("V.VIEWSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		view := &storepb.ViewMetadata{}
+		var schemaName string
+		var comment sql.NullString
+		if err := rows.Scan(&schemaName, &view.Name, &view.Definition, &comment); err != nil {
+			return nil, err
+		}
+		view.Comment = comment.String
+		viewMap[schemaName] = append(viewMap[schemaName], view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return viewMap, nil
+}
+
+// getSequences gets all sequences of a database keyed by the schema.
+// The identity columns are backed by the internal sequences of type I, which are excluded.
+func getSequences(txn *sql.Tx) (map[string][]*storepb.SequenceMetadata, error) {
+	sequenceMap := make(map[string][]*storepb.SequenceMetadata)
+	query := fmt.Sprintf(`
+		SELECT S.SEQSCHEMA, S.SEQNAME, D.TYPENAME
+		FROM SYSCAT.SEQUENCES S
+		JOIN SYSCAT.DATATYPES D ON D.TYPEID = S.DATATYPEID
+		WHERE S.SEQTYPE = 'S' AND %s
+		ORDER BY S.SEQSCHEMA, S.SEQNAME`, systemSchemaCondition("S.SEQSCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		sequence := &storepb.SequenceMetadata{}
+		var schemaName string
+		if err := rows.Scan(&schemaName, &sequence.Name, &sequence.DataType); err != nil {
+			return nil, err
+		}
+		sequenceMap[schemaName] = append(sequenceMap[schemaName], sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return sequenceMap, nil
+}
+
+// getRoutines gets the SQL functions and procedures keyed by the schema.
+// The external routines don't have the source text, so they are excluded.
+func getRoutines(txn *sql.Tx) (map[string][]*storepb.FunctionMetadata, map[string][]*storepb.ProcedureMetadata, error) {
+	functionMap := make(map[string][]*storepb.FunctionMetadata)
+	procedureMap := make(map[string][]*storepb.ProcedureMetadata)
+	query := fmt.Sprintf(`
+		SELECT ROUTINESCHEMA, ROUTINENAME, ROUTINETYPE, TEXT
+		FROM SYSCAT.ROUTINES
+		WHERE ROUTINETYPE IN ('F', 'P') AND TEXT IS NOT NULL AND %s
+		ORDER BY ROUTINESCHEMA, ROUTINENAME, SPECIFICNAME`, systemSchemaCondition("ROUTINESCHEMA"))
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, name, routineType, definition string
+		if err := rows.Scan(&schemaName, &name, &routineType, &definition); err != nil {
+			return nil, nil, err
+		}
+		if routineType == "F" {
+			functionMap[schemaName] = append(functionMap[schemaName], &storepb.FunctionMetadata{
+				Name:       name,
+				Definition: definition,
+			})
+		} else {
+			procedureMap[schemaName] = append(procedureMap[schemaName], &storepb.ProcedureMetadata{
+				Name:       name,
+				Definition: definition,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, util.FormatErrorWithQuery(err, query)
+	}
+	return functionMap, procedureMap, nil
+}
+
+// SyncSlowQuery syncs the slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks if slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
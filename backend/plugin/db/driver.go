@@ -308,6 +308,50 @@ type Driver interface {
 	Dump(ctx context.Context, out io.Writer) error
 }
 
+// UnwrapDriver returns the engine driver wrapped by the driver factory, e.g. for leased credentials.
+// Use it before asserting the driver to a concrete engine driver type.
+func UnwrapDriver(driver Driver) Driver {
+	for {
+		u, ok := driver.(interface{ Unwrap() Driver })
+		if !ok {
+			return driver
+		}
+		driver = u.Unwrap()
+	}
+}
+
+// CursorQuerier is implemented by the drivers which can read the query result from a live cursor.
+type CursorQuerier interface {
+	// QueryCursor runs a single query statement in the given connection and returns the cursor of its rows.
+	// The cursor reads the rows with ctx, so ctx must outlive the cursor.
+	QueryCursor(ctx context.Context, conn *sql.Conn, statement string, queryContext QueryContext) (QueryCursor, error)
+}
+
+// QueryCanceler is implemented by the drivers which can cancel a running query on the database side.
+type QueryCanceler interface {
+	// GetSessionID returns the ID of the database session of the connection.
+	GetSessionID(ctx context.Context, conn *sql.Conn) (string, error)
+	// CancelQuery cancels the running query of the database session.
+	// It uses another connection as the connection of the session is busy.
+	CancelQuery(ctx context.Context, sessionID string) error
+}
+
+// QueryPlanExplainer is implemented by the drivers which can return the query plan in a structured format.
+type QueryPlanExplainer interface {
+	// ExplainQueryPlan returns the raw query plan of a single query statement in the given connection.
+	// The statement is run to collect the actual statistics if analyze is true.
+	ExplainQueryPlan(ctx context.Context, conn *sql.Conn, statement string, queryContext QueryContext, analyze bool) (string, error)
+}
+
+// QueryCursor is the cursor of a running query.
+// Remember to call Close to release the rows.
+type QueryCursor interface {
+	// Next reads at most batchSize rows with the column names and types.
+	// The done is true if all rows have been read.
+	Next(batchSize int) (result *v1pb.QueryResult, done bool, err error)
+	Close() error
+}
+
 // Register makes a database driver available by the provided type.
 // If Register is called twice with the same name or if driver is nil,
 // it panics.
@@ -323,6 +367,15 @@ func Register(dbType storepb.Engine, f driverFunc) {
 	drivers[dbType] = f
 }
 
+// IsRegistered returns true if the database driver of the type is registered.
+// The drivers built with the build tags, such as Db2, are not registered without the tags.
+func IsRegistered(dbType storepb.Engine) bool {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	_, ok := drivers[dbType]
+	return ok
+}
+
 // Open opens a database specified by its database driver type and connection config without verifying the connection.
 func Open(ctx context.Context, dbType storepb.Engine, driverConfig DriverConfig, connectionConfig ConnectionConfig) (Driver, error) {
 	driversMu.RLock()
@@ -0,0 +1,302 @@
+// Package duckdb is the plugin for DuckDB driver.
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	// Import DuckDB driver.
+	_ "github.com/marcboeker/go-duckdb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	duckdbparser "github.com/bytebase/bytebase/backend/plugin/parser/duckdb"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	_ db.Driver = (*Driver)(nil)
+)
+
+const (
+	// databaseFileSuffix is the suffix of the DuckDB database files in the instance directory.
+	databaseFileSuffix = ".duckdb"
+)
+
+func init() {
+	db.Register(storepb.Engine_DUCKDB, newDriver)
+}
+
+// Driver is the DuckDB driver.
+type Driver struct {
+	dir                  string
+	db                   *sql.DB
+	dsn                  string
+	connectionCtx        db.ConnectionContext
+	databaseName         string
+	maximumSQLResultSize int64
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a DuckDB driver.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+	// Host is the directory (instance) containing all DuckDB databases.
+	driver.dir = config.Host
+
+	// If config.Database is empty, we will get a connection to in-memory database.
+	dsn, db, err := openDatabase(driver.dir, config.Database)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	driver.dsn = dsn
+	driver.connectionCtx = config.ConnectionContext
+	driver.databaseName = config.Database
+	driver.maximumSQLResultSize = config.MaximumSQLResultSize
+	return driver, nil
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	if driver.db != nil {
+		return closeDatabase(driver.dsn, driver.db)
+	}
+	return nil
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// sharedDatabase is a database file opened in the process.
+type sharedDatabase struct {
+	db   *sql.DB
+	refs int
+}
+
+var (
+	sharedDatabasesMu sync.Mutex
+	// sharedDatabases are the opened database files keyed by the DSN.
+	// DuckDB doesn't support opening the same database file by multiple instances in a process,
+	// so the drivers of the same database share the opened database.
+	sharedDatabases = make(map[string]*sharedDatabase)
+)
+
+// openDatabase opens the database file, or the in-memory database if the database is empty.
+func openDatabase(dir, database string) (string, *sql.DB, error) {
+	dsn := ""
+	if database != "" {
+		dsn = path.Join(dir, database+databaseFileSuffix)
+	}
+	// The in-memory databases are private to the driver.
+	if dsn == "" {
+		db, err := sql.Open("duckdb", getDataSourceName(dsn))
+		if err != nil {
+			return "", nil, err
+		}
+		return dsn, db, nil
+	}
+
+	sharedDatabasesMu.Lock()
+	defer sharedDatabasesMu.Unlock()
+	if shared, ok := sharedDatabases[dsn]; ok {
+		shared.refs++
+		return dsn, shared.db, nil
+	}
+	db, err := sql.Open("duckdb", getDataSourceName(dsn))
+	if err != nil {
+		return "", nil, err
+	}
+	sharedDatabases[dsn] = &sharedDatabase{db: db, refs: 1}
+	return dsn, db, nil
+}
+
+// closeDatabase closes the database once it's not used by any driver.
+func closeDatabase(dsn string, db *sql.DB) error {
+	if dsn == "" {
+		return db.Close()
+	}
+	sharedDatabasesMu.Lock()
+	defer sharedDatabasesMu.Unlock()
+	shared, ok := sharedDatabases[dsn]
+	if !ok || shared.db != db {
+		return nil
+	}
+	shared.refs--
+	if shared.refs > 0 {
+		return nil
+	}
+	delete(sharedDatabases, dsn)
+	return db.Close()
+}
+
+// getDataSourceName returns the data source name of the database file.
+// The external access is disabled so that the statements cannot read or write the files on the Bytebase server,
+// such as read_csv('/etc/passwd') or COPY t TO '/tmp/t.csv'.
+func getDataSourceName(dsn string) string {
+	return fmt.Sprintf("%s?enable_external_access=false", dsn)
+}
+
+func (driver *Driver) getDatabases() ([]string, error) {
+	files, err := os.ReadDir(driver.dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %q", driver.dir)
+	}
+	var databases []string
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), databaseFileSuffix) {
+			continue
+		}
+		databases = append(databases, strings.TrimSuffix(file.Name(), databaseFileSuffix))
+	}
+	return databases, nil
+}
+
+// Execute executes a SQL statement.
+func (driver *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	if opts.CreateDatabase {
+		parts := strings.Split(statement, `'`)
+		if len(parts) != 3 {
+			return 0, errors.Errorf("invalid statement %q", statement)
+		}
+		dsn, db, err := openDatabase(driver.dir, parts[1])
+		if err != nil {
+			return 0, err
+		}
+		defer closeDatabase(dsn, db)
+		// We need to query to persist the database file.
+		if _, err := db.ExecContext(ctx, "SELECT 1;"); err != nil {
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	tx, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	sqlResult, err := tx.ExecContext(ctx, statement)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+		slog.Debug("rowsAffected returns error", log.BBError(err))
+		return 0, nil
+	}
+
+	return rowsAffected, nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) ([]*v1pb.QueryResult, error) {
+	singleSQLs, err := duckdbparser.SplitSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	// If the queryContext.Schema is not empty, set the search path for the database connection to the specified schema.
+	if queryContext.Schema != "" {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET search_path = '%s';", queryContext.Schema)); err != nil {
+			return nil, err
+		}
+	}
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		statement := singleSQL.Text
+		_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_DUCKDB, statement)
+		if err != nil {
+			return nil, err
+		}
+		if queryContext.Explain {
+			statement = fmt.Sprintf("EXPLAIN %s", statement)
+		} else if allQuery && queryContext.Limit > 0 {
+			statement = getStatementWithResultLimit(statement, queryContext.Limit)
+		}
+
+		startTime := time.Now()
+		queryResult, err := func() (*v1pb.QueryResult, error) {
+			if allQuery {
+				rows, err := conn.QueryContext(ctx, statement)
+				if err != nil {
+					return nil, err
+				}
+				defer rows.Close()
+				r, err := util.RowsToQueryResult(rows, driver.maximumSQLResultSize)
+				if err != nil {
+					return nil, err
+				}
+				if err := rows.Err(); err != nil {
+					return nil, err
+				}
+				return r, nil
+			}
+
+			sqlResult, err := conn.ExecContext(ctx, statement)
+			if err != nil {
+				return nil, err
+			}
+			affectedRows, err := sqlResult.RowsAffected()
+			if err != nil {
+				slog.Info("rowsAffected returns error", log.BBError(err))
+			}
+			return util.BuildAffectedRowsResult(affectedRows), nil
+		}()
+		stop := false
+		if err != nil {
+			queryResult = &v1pb.QueryResult{
+				Error: err.Error(),
+			}
+			stop = true
+		}
+		queryResult.Statement = statement
+		queryResult.Latency = durationpb.New(time.Since(startTime))
+		results = append(results, queryResult)
+		if stop {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// getStatementWithResultLimit limits the rows of the query.
+// The subquery works for the DuckDB specific queries as well, such as FROM-first queries, DESCRIBE and SUMMARIZE.
+func getStatementWithResultLimit(stmt string, limit int) string {
+	// To handle cases where there are comments in the query.
+	// eg. select * from t1 -- this is comment;
+	// Add two new line symbol here.
+	return fmt.Sprintf("SELECT * FROM (\n%s\n) LIMIT %d;", util.TrimStatement(stmt), limit)
+}
@@ -0,0 +1,185 @@
+package duckdb
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// openTestDriver creates the database in a temporary instance directory and opens the driver to it.
+func openTestDriver(ctx context.Context, t *testing.T, database string) db.Driver {
+	dir := t.TempDir()
+	instanceDriver, err := newDriver(db.DriverConfig{}).Open(ctx, storepb.Engine_DUCKDB, db.ConnectionConfig{Host: dir})
+	require.NoError(t, err)
+	defer instanceDriver.Close(ctx)
+	_, err = instanceDriver.Execute(ctx, "CREATE DATABASE '"+database+"';", db.ExecuteOptions{CreateDatabase: true})
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, database+databaseFileSuffix))
+
+	driver, err := newDriver(db.DriverConfig{}).Open(ctx, storepb.Engine_DUCKDB, db.ConnectionConfig{Host: dir, Database: database, MaximumSQLResultSize: common.DefaultMaximumSQLResultSize})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		driver.Close(ctx)
+	})
+	return driver
+}
+
+const testSchema = `
+CREATE SCHEMA app;
+CREATE SEQUENCE app.user_id_seq START 1;
+CREATE TABLE app.users (
+	id INTEGER PRIMARY KEY DEFAULT nextval('app.user_id_seq'),
+	email VARCHAR NOT NULL UNIQUE,
+	age INTEGER
+);
+CREATE INDEX idx_users_age ON app.users (age);
+CREATE VIEW app.adults AS SELECT id, email FROM app.users WHERE age >= 18;
+INSERT INTO app.users (email, age) VALUES ('a@example.com', 20), ('b@example.com', 10);
+`
+
+func TestExecuteAndQueryConn(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	driver := openTestDriver(ctx, t, "test")
+
+	affectedRows, err := driver.Execute(ctx, testSchema, db.ExecuteOptions{})
+	a.NoError(err)
+	a.Equal(int64(2), affectedRows)
+
+	conn, err := driver.GetDB().Conn(ctx)
+	a.NoError(err)
+	defer conn.Close()
+	results, err := driver.QueryConn(ctx, conn, "SELECT email FROM app.adults; SELECT count(*) FROM app.users;", db.QueryContext{Limit: 10})
+	a.NoError(err)
+	a.Len(results, 2)
+	a.Empty(results[0].Error)
+	a.Equal([]string{"email"}, results[0].ColumnNames)
+	a.Len(results[0].Rows, 1)
+	a.Equal("a@example.com", results[0].Rows[0].Values[0].GetStringValue())
+	a.Len(results[1].Rows, 1)
+
+	// The search path is set to the schema.
+	results, err = driver.QueryConn(ctx, conn, "SELECT id FROM users ORDER BY id;", db.QueryContext{Limit: 1, Schema: "app"})
+	a.NoError(err)
+	a.Len(results, 1)
+	a.Empty(results[0].Error)
+	a.Len(results[0].Rows, 1)
+
+	// The statements after the failed one are skipped.
+	results, err = driver.QueryConn(ctx, conn, "SELECT * FROM app.missing; SELECT 1;", db.QueryContext{})
+	a.NoError(err)
+	a.Len(results, 1)
+	a.NotEmpty(results[0].Error)
+}
+
+func TestExternalAccessDisabled(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	driver := openTestDriver(ctx, t, "test")
+
+	_, err := driver.Execute(ctx, "CREATE TABLE t AS SELECT * FROM read_csv('/etc/passwd');", db.ExecuteOptions{})
+	a.Error(err)
+}
+
+func TestSyncInstanceAndDBSchema(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	driver := openTestDriver(ctx, t, "test")
+	_, err := driver.Execute(ctx, testSchema, db.ExecuteOptions{})
+	a.NoError(err)
+
+	instance, err := driver.SyncInstance(ctx)
+	a.NoError(err)
+	a.NotEmpty(instance.Version)
+	a.Len(instance.Databases, 1)
+	a.Equal("test", instance.Databases[0].Name)
+
+	metadata, err := driver.SyncDBSchema(ctx)
+	a.NoError(err)
+	a.Equal("test", metadata.Name)
+	var schema *storepb.SchemaMetadata
+	for _, s := range metadata.Schemas {
+		if s.Name == "app" {
+			schema = s
+		}
+	}
+	a.NotNil(schema)
+
+	a.Len(schema.Tables, 1)
+	table := schema.Tables[0]
+	a.Equal("users", table.Name)
+	var columns []string
+	for _, column := range table.Columns {
+		columns = append(columns, column.Name)
+	}
+	a.Equal([]string{"id", "email", "age"}, columns)
+	a.False(table.Columns[1].Nullable)
+	a.True(table.Columns[2].Nullable)
+
+	indexes := make(map[string]*storepb.IndexMetadata)
+	for _, index := range table.Indexes {
+		indexes[index.Name] = index
+	}
+	a.True(indexes["users_pkey"].Primary)
+	a.Equal([]string{"id"}, indexes["users_pkey"].Expressions)
+	a.True(indexes["users_email_key"].Unique)
+	a.Equal([]string{"age"}, indexes["idx_users_age"].Expressions)
+	a.Contains(indexes["idx_users_age"].Definition, "CREATE INDEX idx_users_age")
+
+	a.Len(schema.Views, 1)
+	a.Equal("adults", schema.Views[0].Name)
+}
+
+func TestDump(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	driver := openTestDriver(ctx, t, "test")
+	_, err := driver.Execute(ctx, testSchema, db.ExecuteOptions{})
+	a.NoError(err)
+
+	var buf strings.Builder
+	a.NoError(driver.Dump(ctx, &buf))
+	dump := buf.String()
+	statements := strings.Split(strings.TrimSpace(dump), ";\n")
+	a.Len(statements, 5, dump)
+	a.Equal(`CREATE SCHEMA "app"`, statements[0])
+	a.Contains(statements[1], `CREATE SEQUENCE "app".user_id_seq`)
+	a.Contains(statements[2], "CREATE TABLE app.users")
+	a.Contains(statements[3], "CREATE VIEW app.adults")
+	a.Contains(statements[4], "CREATE INDEX idx_users_age")
+
+	// The dump recreates the schema in another database.
+	restore := openTestDriver(ctx, t, "restore")
+	_, err = restore.Execute(ctx, dump, db.ExecuteOptions{})
+	a.NoError(err)
+	var restoredBuf strings.Builder
+	a.NoError(restore.Dump(ctx, &restoredBuf))
+	a.Equal(dump, restoredBuf.String())
+}
+
+func TestGetStatementWithResultLimit(t *testing.T) {
+	a := require.New(t)
+	a.Equal("SELECT * FROM (\nFROM t -- comment\n) LIMIT 10;", getStatementWithResultLimit("FROM t -- comment;", 10))
+}
+
+func TestGetStringList(t *testing.T) {
+	a := require.New(t)
+	list, err := getStringList([]any{"a", "lower(b)"})
+	a.NoError(err)
+	a.Equal([]string{"a", "lower(b)"}, list)
+
+	// The index expressions are a string before DuckDB 1.1.
+	list, err = getStringList("[a, b]")
+	a.NoError(err)
+	a.Equal([]string{"a", "b"}, list)
+
+	_, err = getStringList(1)
+	a.Error(err)
+}
@@ -0,0 +1,98 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// Dump dumps the database.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer) error {
+	if driver.databaseName == "" {
+		return errors.Errorf("DuckDB can dump one database only at a time")
+	}
+
+	// Find all dumpable databases and make sure the existence of the database to be dumped.
+	databases, err := driver.getDatabases()
+	if err != nil {
+		return errors.Wrap(err, "failed to get databases")
+	}
+	exist := false
+	for _, n := range databases {
+		if n == driver.databaseName {
+			exist = true
+			break
+		}
+	}
+	if !exist {
+		return errors.Errorf("database %s not found", driver.databaseName)
+	}
+
+	return driver.dumpOneDatabase(ctx, out)
+}
+
+// dumpQueries are the queries of the object definitions in the order of the dependencies.
+// The objects of the same type are ordered by the creation.
+var dumpQueries = []string{
+	// The "main" schema exists in every database, and the definitions of the schemas are always NULL.
+	fmt.Sprintf(`SELECT format('CREATE SCHEMA "{}"', replace(schema_name, '"', '""')) FROM duckdb_schemas() WHERE database_name = current_database() AND %s AND schema_name != 'main' ORDER BY oid;`, systemSchemaCondition),
+	// The definitions of the sequences are not qualified by the schema, unlike the tables and views.
+	fmt.Sprintf(`SELECT format('CREATE SEQUENCE "{}".{}', replace(schema_name, '"', '""'), substr(sql, length('CREATE SEQUENCE ') + 1)) FROM duckdb_sequences() WHERE database_name = current_database() AND %s AND NOT temporary ORDER BY sequence_oid;`, systemSchemaCondition),
+	fmt.Sprintf(`SELECT sql FROM duckdb_tables() WHERE database_name = current_database() AND %s AND NOT internal AND NOT temporary ORDER BY table_oid;`, systemSchemaCondition),
+	fmt.Sprintf(`SELECT sql FROM duckdb_views() WHERE database_name = current_database() AND %s AND NOT internal AND NOT temporary ORDER BY view_oid;`, systemSchemaCondition),
+	fmt.Sprintf(`SELECT sql FROM duckdb_indexes() WHERE database_name = current_database() AND %s ORDER BY index_oid;`, systemSchemaCondition),
+}
+
+func (driver *Driver) dumpOneDatabase(ctx context.Context, out io.Writer) error {
+	// The read-only transactions are not supported by the DuckDB driver.
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	for _, query := range dumpQueries {
+		statements, err := getDefinitions(ctx, txn, query)
+		if err != nil {
+			return err
+		}
+		for _, statement := range statements {
+			if _, err := io.WriteString(out, fmt.Sprintf("%s;\n", statement)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return txn.Commit()
+}
+
+func getDefinitions(ctx context.Context, txn *sql.Tx, query string) ([]string, error) {
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var statements []string
+	for rows.Next() {
+		var statement sql.NullString
+		if err := rows.Scan(&statement); err != nil {
+			return nil, err
+		}
+		// The definitions of the objects created implicitly, such as the sequences of the columns, are NULL.
+		if !statement.Valid {
+			continue
+		}
+		statements = append(statements, strings.TrimRight(strings.TrimSpace(statement.String), ";"))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return statements, nil
+}
@@ -0,0 +1,358 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// systemSchemaCondition excludes the system schemas in the metadata functions, such as duckdb_schemas().
+	systemSchemaCondition = "schema_name NOT IN ('information_schema', 'pg_catalog')"
+)
+
+// SyncInstance syncs the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	version, err := driver.getVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseNames, err := driver.getDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	var databases []*storepb.DatabaseSchemaMetadata
+	for _, databaseName := range databaseNames {
+		databases = append(databases, &storepb.DatabaseSchemaMetadata{Name: databaseName})
+	}
+
+	return &db.InstanceMetadata{
+		Version:   version,
+		Databases: databases,
+	}, nil
+}
+
+// getVersion gets the version, such as "v1.1.3".
+func (driver *Driver) getVersion(ctx context.Context) (string, error) {
+	var version string
+	if err := driver.db.QueryRowContext(ctx, "SELECT version();").Scan(&version); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	databases, err := driver.getDatabases()
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, database := range databases {
+		if database == driver.databaseName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, common.Errorf(common.NotFound, "database %q not found", driver.databaseName)
+	}
+
+	// The read-only transactions are not supported by the DuckDB driver.
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	schemaNames, err := getSchemas(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schemas")
+	}
+	tableMap, err := getTables(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tables")
+	}
+	viewMap, err := getViews(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views")
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	databaseMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: driver.databaseName,
+	}
+	for _, schemaName := range schemaNames {
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:   schemaName,
+			Tables: tableMap[schemaName],
+			Views:  viewMap[schemaName],
+		})
+	}
+	return databaseMetadata, nil
+}
+
+func getSchemas(txn *sql.Tx) ([]string, error) {
+	var schemaNames []string
+	query := fmt.Sprintf(`
+		SELECT
+			schema_name
+		FROM duckdb_schemas()
+		WHERE database_name = current_database() AND %s
+		ORDER BY schema_name;`, systemSchemaCondition)
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, err
+		}
+		schemaNames = append(schemaNames, schemaName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return schemaNames, nil
+}
+
+// getTables gets all tables of a database keyed by the schema.
+func getTables(txn *sql.Tx) (map[string][]*storepb.TableMetadata, error) {
+	columnMap, err := getColumns(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get columns")
+	}
+	indexMap, err := getIndexes(txn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get indexes")
+	}
+
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	query := fmt.Sprintf(`
+		SELECT
+			schema_name, table_name, estimated_size, comment
+		FROM duckdb_tables()
+		WHERE database_name = current_database() AND %s AND NOT internal AND NOT temporary
+		ORDER BY schema_name, table_name;`, systemSchemaCondition)
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		table := &storepb.TableMetadata{}
+		var schemaName string
+		var estimatedSize sql.NullInt64
+		var comment sql.NullString
+		if err := rows.Scan(&schemaName, &table.Name, &estimatedSize, &comment); err != nil {
+			return nil, err
+		}
+		// The estimated size of DuckDB tables is the number of rows.
+		table.RowCount = estimatedSize.Int64
+		table.Comment = comment.String
+		key := db.TableKey{Schema: schemaName, Table: table.Name}
+		table.Columns = columnMap[key]
+		table.Indexes = indexMap[key]
+		tableMap[schemaName] = append(tableMap[schemaName], table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return tableMap, nil
+}
+
+// getColumns gets the columns of all tables and views of a database.
+func getColumns(txn *sql.Tx) (map[db.TableKey][]*storepb.ColumnMetadata, error) {
+	columnMap := make(map[db.TableKey][]*storepb.ColumnMetadata)
+	query := fmt.Sprintf(`
+		SELECT
+			schema_name, table_name, column_name, column_index, data_type, column_default, is_nullable, comment
+		FROM duckdb_columns()
+		WHERE database_name = current_database() AND %s AND NOT internal
+		ORDER BY schema_name, table_name, column_index;`, systemSchemaCondition)
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		column := &storepb.ColumnMetadata{}
+		var schemaName, tableName string
+		var defaultStr, comment sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Position, &column.Type, &defaultStr, &column.Nullable, &comment); err != nil {
+			return nil, err
+		}
+		if defaultStr.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: defaultStr.String}
+		}
+		column.Comment = comment.String
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		columnMap[key] = append(columnMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return columnMap, nil
+}
+
+// getIndexes gets the primary keys, the unique constraints and the indexes of all tables of a database.
+func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
+	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+
+	// The primary keys and unique constraints are not listed in duckdb_indexes().
+	query := fmt.Sprintf(`
+		SELECT
+			schema_name, table_name, constraint_type, constraint_column_names
+		FROM duckdb_constraints()
+		WHERE database_name = current_database() AND %s AND constraint_type IN ('PRIMARY KEY', 'UNIQUE')
+		ORDER BY schema_name, table_name, constraint_index;`, systemSchemaCondition)
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, tableName, constraintType string
+		var columnNames any
+		if err := rows.Scan(&schemaName, &tableName, &constraintType, &columnNames); err != nil {
+			return nil, err
+		}
+		index := &storepb.IndexMetadata{
+			Primary: constraintType == "PRIMARY KEY",
+			Unique:  true,
+		}
+		list, err := getStringList(columnNames)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get constraint columns")
+		}
+		index.Expressions = list
+		// DuckDB doesn't name the constraints, so they're named as PostgreSQL does.
+		if index.Primary {
+			index.Name = fmt.Sprintf("%s_pkey", tableName)
+		} else {
+			index.Name = fmt.Sprintf("%s_%s_key", tableName, strings.Join(index.Expressions, "_"))
+		}
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	query = fmt.Sprintf(`
+		SELECT
+			schema_name, table_name, index_name, is_unique, expressions, sql
+		FROM duckdb_indexes()
+		WHERE database_name = current_database() AND %s
+		ORDER BY schema_name, table_name, index_name;`, systemSchemaCondition)
+	indexRows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer indexRows.Close()
+	for indexRows.Next() {
+		index := &storepb.IndexMetadata{}
+		var schemaName, tableName string
+		var expressions any
+		var definition sql.NullString
+		if err := indexRows.Scan(&schemaName, &tableName, &index.Name, &index.Unique, &expressions, &definition); err != nil {
+			return nil, err
+		}
+		list, err := getStringList(expressions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get index expressions")
+		}
+		index.Expressions = list
+		index.Definition = definition.String
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := indexRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return indexMap, nil
+}
+
+// getStringList gets the strings of the VARCHAR[] value, such as the constraint columns and the index expressions.
+// The index expressions are a list in DuckDB 1.1 and later, and a string like "[a, b]" before.
+func getStringList(value any) ([]string, error) {
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		var list []string
+		for _, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return nil, errors.Errorf("unexpected list item type %T", item)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	case string:
+		var list []string
+		text := strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+		for _, item := range strings.Split(text, ",") {
+			list = append(list, strings.TrimSpace(item))
+		}
+		return list, nil
+	default:
+		return nil, errors.Errorf("unexpected list type %T", value)
+	}
+}
+
+// getViews gets all views of a database keyed by the schema.
+func getViews(txn *sql.Tx) (map[string][]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+	query := fmt.Sprintf(`
+		SELECT
+			schema_name, view_name, sql, comment
+		FROM duckdb_views()
+		WHERE database_name = current_database() AND %s AND NOT internal AND NOT temporary
+		ORDER BY schema_name, view_name;`, systemSchemaCondition)
+	rows, err := txn.Query(query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		view := &storepb.ViewMetadata{}
+		var schemaName string
+		var comment sql.NullString
+		if err := rows.Scan(&schemaName, &view.Name, &view.Definition, &comment); err != nil {
+			return nil, err
+		}
+		view.Comment = comment.String
+		viewMap[schemaName] = append(viewMap[schemaName], view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return viewMap, nil
+}
+
+// SyncSlowQuery syncs the slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks if slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
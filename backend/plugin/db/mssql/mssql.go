@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -370,3 +371,89 @@ func getShowPlanAll(s string) (bool, bool) {
 	}
 	return false, false
 }
+
+// GetSessionID returns the session ID of the connection.
+func (*Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var spid string
+	if err := conn.QueryRowContext(ctx, "SELECT @@SPID;").Scan(&spid); err != nil {
+		return "", err
+	}
+	return spid, nil
+}
+
+// CancelQuery kills the session, which rolls back its running query.
+func (driver *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	// KILL doesn't accept the parameter.
+	if _, err := strconv.ParseUint(sessionID, 10, 16); err != nil {
+		return errors.Errorf("invalid session ID %q", sessionID)
+	}
+	_, err := driver.db.ExecContext(ctx, fmt.Sprintf("KILL %s;", sessionID))
+	return err
+}
+
+// showPlanColumnName is the column name of the result set of the showplan XML.
+const showPlanColumnName = "Microsoft SQL Server 2005 XML Showplan"
+
+// ExplainQueryPlan returns the showplan XML of a single query statement.
+// The actual execution plan is returned by STATISTICS XML if analyze is true.
+func (*Driver) ExplainQueryPlan(ctx context.Context, conn *sql.Conn, statement string, _ db.QueryContext, analyze bool) (string, error) {
+	statement, err := util.GetSingleQuery(storepb.Engine_MSSQL, statement)
+	if err != nil {
+		return "", err
+	}
+
+	option := "SHOWPLAN_XML"
+	if analyze {
+		option = "STATISTICS XML"
+	}
+	// The SET statement must be the only statement in the batch.
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET %s ON;", option)); err != nil {
+		return "", err
+	}
+	defer func() {
+		// Reset the option even if the context is cancelled, because the connection might be reused.
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), fmt.Sprintf("SET %s OFF;", option)); err != nil {
+			slog.Warn("failed to reset the showplan option", log.BBError(err))
+		}
+	}()
+
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	// The actual execution plan is returned after the result sets of the statement.
+	var plan string
+	for {
+		columns, err := rows.Columns()
+		if err != nil {
+			return "", err
+		}
+		isPlan := len(columns) == 1 && columns[0] == showPlanColumnName
+		for rows.Next() {
+			if isPlan {
+				if err := rows.Scan(&plan); err != nil {
+					return "", err
+				}
+				continue
+			}
+			values := make([]any, len(columns))
+			for i := range values {
+				values[i] = new(any)
+			}
+			if err := rows.Scan(values...); err != nil {
+				return "", err
+			}
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if plan == "" {
+		return "", errors.New("no query plan returned")
+	}
+	return plan, nil
+}
@@ -0,0 +1,17 @@
+package mssql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelQueryInvalidSessionID(t *testing.T) {
+	a := require.New(t)
+	driver := &Driver{}
+	for _, sessionID := range []string{"", "-1", "70000", "52; SHUTDOWN"} {
+		// The invalid session ID is rejected before reaching the database.
+		a.ErrorContains(driver.CancelQuery(context.Background(), sessionID), "invalid session ID", sessionID)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"net"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -497,6 +498,42 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	return results, nil
 }
 
+// QueryCursor runs a single query statement in a given connection and returns the cursor of its rows.
+func (d *Driver) QueryCursor(ctx context.Context, conn *sql.Conn, statement string, _ db.QueryContext) (db.QueryCursor, error) {
+	statement, err := util.GetSingleQuery(storepb.Engine_MYSQL, statement)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx, util.MySQLPrependBytebaseAppComment(statement))
+	if err != nil {
+		return nil, err
+	}
+	return util.NewRowsCursor(rows)
+}
+
+// ExplainQueryPlan returns the query plan of a single query statement.
+// The plan is in JSON format, or in tree format if analyze is true since EXPLAIN ANALYZE only supports the tree format.
+func (d *Driver) ExplainQueryPlan(ctx context.Context, conn *sql.Conn, statement string, _ db.QueryContext, analyze bool) (string, error) {
+	if d.dbType != storepb.Engine_MYSQL {
+		return "", errors.Errorf("explaining query plan is not supported for %s", d.dbType)
+	}
+	statement, err := util.GetSingleQuery(storepb.Engine_MYSQL, statement)
+	if err != nil {
+		return "", err
+	}
+
+	explain := "EXPLAIN FORMAT=JSON"
+	if analyze {
+		explain = "EXPLAIN ANALYZE"
+	}
+	var plan string
+	if err := conn.QueryRowContext(ctx, util.MySQLPrependBytebaseAppComment(fmt.Sprintf("%s %s", explain, statement))).Scan(&plan); err != nil {
+		return "", err
+	}
+	return plan, nil
+}
+
 func (d *Driver) StopConnectionByID(id string) error {
 	// We cannot use placeholder parameter because TiDB doesn't accept it.
 	_, err := d.db.Exec(fmt.Sprintf("KILL QUERY %s", id))
@@ -510,3 +547,18 @@ func getConnectionID(ctx context.Context, conn *sql.Conn) (string, error) {
 	}
 	return id, nil
 }
+
+// GetSessionID returns the connection ID of the connection.
+func (d *Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	return getConnectionID(ctx, conn)
+}
+
+// CancelQuery kills the running query of the connection.
+func (d *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	// We cannot use placeholder parameter because TiDB doesn't accept it.
+	if _, err := strconv.ParseUint(sessionID, 10, 64); err != nil {
+		return errors.Errorf("invalid session ID %q", sessionID)
+	}
+	_, err := d.db.ExecContext(ctx, fmt.Sprintf("KILL QUERY %s", sessionID))
+	return err
+}
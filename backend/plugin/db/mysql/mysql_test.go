@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -42,3 +43,12 @@ func TestParseVersion(t *testing.T) {
 		a.Equal(tc.wantRest, rest)
 	}
 }
+
+func TestCancelQueryInvalidSessionID(t *testing.T) {
+	a := require.New(t)
+	driver := &Driver{}
+	for _, sessionID := range []string{"", "-1", "1; DROP TABLE t", "connection 1"} {
+		// The invalid session ID is rejected before reaching the database.
+		a.ErrorContains(driver.CancelQuery(context.Background(), sessionID), "invalid session ID", sessionID)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"math/big"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -32,6 +33,9 @@ import (
 
 var (
 	_ db.Driver = (*Driver)(nil)
+
+	// sessionIDRegex matches the "sid, serial#" of the session.
+	sessionIDRegex = regexp.MustCompile(`^\d+, \d+$`)
 )
 
 const dbVersion12 = 12
@@ -422,3 +426,22 @@ type subqueryListener struct {
 func (l *subqueryListener) EnterSubquery(*plsql.SubqueryContext) {
 	l.hasSubquery = true
 }
+
+// GetSessionID returns the "sid, serial#" of the session of the connection.
+func (*Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var id string
+	if err := conn.QueryRowContext(ctx, "SELECT sid || ', ' || serial# FROM v$session WHERE sid = SYS_CONTEXT('USERENV', 'SID')").Scan(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// CancelQuery cancels the running SQL of the session.
+func (driver *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	// ALTER SYSTEM doesn't accept the bind variable.
+	if !sessionIDRegex.MatchString(sessionID) {
+		return errors.Errorf("invalid session ID %q", sessionID)
+	}
+	_, err := driver.db.ExecContext(ctx, fmt.Sprintf("ALTER SYSTEM CANCEL SQL '%s'", sessionID))
+	return err
+}
@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -34,3 +35,13 @@ func TestParseVersion(t *testing.T) {
 		require.Equal(t, test.Second, v.Second)
 	}
 }
+
+func TestCancelQueryInvalidSessionID(t *testing.T) {
+	a := require.New(t)
+	a.True(sessionIDRegex.MatchString("123, 45678"))
+	driver := &Driver{}
+	for _, sessionID := range []string{"", "123", "123,45678", "123, 45678' --", "1, 2'; DROP TABLE t; --"} {
+		// The invalid session ID is rejected before reaching the database.
+		a.ErrorContains(driver.CancelQuery(context.Background(), sessionID), "invalid session ID", sessionID)
+	}
+}
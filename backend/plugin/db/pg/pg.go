@@ -721,6 +721,55 @@ func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement s
 	return results, nil
 }
 
+// QueryCursor runs a single query statement in a given connection and returns the cursor of its rows.
+func (driver *Driver) QueryCursor(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) (db.QueryCursor, error) {
+	statement, err := util.GetSingleQuery(storepb.Engine_POSTGRES, statement)
+	if err != nil {
+		return nil, err
+	}
+
+	if queryContext.Schema != "" {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET search_path TO %s;", queryContext.Schema)); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	return util.NewRowsCursor(rows)
+}
+
+// ExplainQueryPlan returns the query plan of a single query statement in JSON format.
+func (*Driver) ExplainQueryPlan(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext, analyze bool) (string, error) {
+	statement, err := util.GetSingleQuery(storepb.Engine_POSTGRES, statement)
+	if err != nil {
+		return "", err
+	}
+
+	// EXPLAIN ANALYZE runs the statement, so it's run in a read-only transaction which is rolled back.
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+	if queryContext.Schema != "" {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL search_path TO %s;", queryContext.Schema)); err != nil {
+			return "", err
+		}
+	}
+	// VERBOSE reports the schema of the tables.
+	options := "VERBOSE, FORMAT JSON"
+	if analyze {
+		options = "ANALYZE, BUFFERS, VERBOSE, FORMAT JSON"
+	}
+	var plan string
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN (%s) %s", options, statement)).Scan(&plan); err != nil {
+		return "", err
+	}
+	return plan, nil
+}
+
 func getPgError(e error) *v1pb.QueryResult_PostgresError_ {
 	if e == nil {
 		return nil
@@ -775,3 +824,18 @@ func isPlSQLBlock(stmt string) bool {
 
 	return false
 }
+
+// GetSessionID returns the backend process ID of the connection.
+func (driver *Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var pid string
+	if err := conn.QueryRowContext(ctx, "SELECT pg_backend_pid();").Scan(&pid); err != nil {
+		return "", err
+	}
+	return pid, nil
+}
+
+// CancelQuery cancels the running query of the backend process.
+func (driver *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	_, err := driver.db.ExecContext(ctx, "SELECT pg_cancel_backend($1);", sessionID)
+	return err
+}
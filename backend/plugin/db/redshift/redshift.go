@@ -393,3 +393,18 @@ func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement s
 func getStatementWithResultLimit(stmt string, limit int) string {
 	return fmt.Sprintf("WITH result AS (%s) SELECT * FROM result LIMIT %d;", stmt, limit)
 }
+
+// GetSessionID returns the backend process ID of the connection.
+func (driver *Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var pid string
+	if err := conn.QueryRowContext(ctx, "SELECT pg_backend_pid();").Scan(&pid); err != nil {
+		return "", err
+	}
+	return pid, nil
+}
+
+// CancelQuery cancels the running query of the backend process.
+func (driver *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	_, err := driver.db.ExecContext(ctx, "SELECT pg_cancel_backend($1);", sessionID)
+	return err
+}
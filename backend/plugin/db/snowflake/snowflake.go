@@ -365,3 +365,19 @@ func decodeRSAPrivateKey(key string) (*rsa.PrivateKey, error) {
 		return nil, errors.Errorf("unsupported pem block type: %s", block.Type)
 	}
 }
+
+// GetSessionID returns the session ID of the connection.
+func (*Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var id string
+	if err := conn.QueryRowContext(ctx, "SELECT CURRENT_SESSION()").Scan(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// CancelQuery cancels the running queries of the session.
+// The query ID for SYSTEM$CANCEL_QUERY is unknown until the query returns, so the queries are cancelled by the session.
+func (driver *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	_, err := driver.db.ExecContext(ctx, "SELECT SYSTEM$CANCEL_ALL_QUERIES(?)", sessionID)
+	return err
+}
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -406,3 +407,36 @@ func getConnectionID(ctx context.Context, conn *sql.Conn) (string, error) {
 	}
 	return id, nil
 }
+
+// GetSessionID returns the connection ID of the connection.
+func (d *Driver) GetSessionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	return getConnectionID(ctx, conn)
+}
+
+// CancelQuery kills the running query of the connection.
+func (d *Driver) CancelQuery(ctx context.Context, sessionID string) error {
+	// We cannot use placeholder parameter because TiDB doesn't accept it.
+	if _, err := strconv.ParseUint(sessionID, 10, 64); err != nil {
+		return errors.Errorf("invalid session ID %q", sessionID)
+	}
+	_, err := d.db.ExecContext(ctx, fmt.Sprintf("KILL QUERY %s", sessionID))
+	return err
+}
+
+// ExplainQueryPlan returns the query plan of a single query statement in the tidb_json format.
+func (*Driver) ExplainQueryPlan(ctx context.Context, conn *sql.Conn, statement string, _ db.QueryContext, analyze bool) (string, error) {
+	statement, err := util.GetSingleQuery(storepb.Engine_TIDB, statement)
+	if err != nil {
+		return "", err
+	}
+
+	explain := "EXPLAIN FORMAT = 'tidb_json'"
+	if analyze {
+		explain = "EXPLAIN ANALYZE FORMAT = 'tidb_json'"
+	}
+	var plan string
+	if err := conn.QueryRowContext(ctx, util.MySQLPrependBytebaseAppComment(fmt.Sprintf("%s %s", explain, statement))).Scan(&plan); err != nil {
+		return "", err
+	}
+	return plan, nil
+}
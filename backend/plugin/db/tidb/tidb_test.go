@@ -1,6 +1,7 @@
 package tidb
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -75,3 +76,12 @@ func TestIsNonTransactionStatement(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestCancelQueryInvalidSessionID(t *testing.T) {
+	a := require.New(t)
+	driver := &Driver{}
+	for _, sessionID := range []string{"", "-1", "1; DROP TABLE t", "connection 1"} {
+		// The invalid session ID is rejected before reaching the database.
+		a.ErrorContains(driver.CancelQuery(context.Background(), sessionID), "invalid session ID", sessionID)
+	}
+}
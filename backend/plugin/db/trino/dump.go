@@ -0,0 +1,57 @@
+package trino
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Dump dumps the schemas, tables and views of the catalog by SHOW CREATE.
+// The definitions are fully qualified by the catalog, such as CREATE TABLE hive.web.page_views.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer) error {
+	metadata, err := driver.SyncDBSchema(ctx)
+	if err != nil {
+		return err
+	}
+	catalog := quoteIdentifier(driver.databaseName)
+
+	for _, schema := range metadata.Schemas {
+		if _, err := io.WriteString(out, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s.%s;\n", catalog, quoteIdentifier(schema.Name))); err != nil {
+			return err
+		}
+	}
+	for _, schema := range metadata.Schemas {
+		for _, table := range schema.Tables {
+			definition, err := driver.showCreate(ctx, "TABLE", catalog, schema.Name, table.Name)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(out, fmt.Sprintf("%s;\n", definition)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, schema := range metadata.Schemas {
+		for _, view := range schema.Views {
+			definition, err := driver.showCreate(ctx, "VIEW", catalog, schema.Name, view.Name)
+			if err != nil {
+				return err
+			}
+			if _, err := io.WriteString(out, fmt.Sprintf("%s;\n", definition)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (driver *Driver) showCreate(ctx context.Context, objectType, catalog, schema, name string) (string, error) {
+	query := fmt.Sprintf("SHOW CREATE %s %s.%s.%s", objectType, catalog, quoteIdentifier(schema), quoteIdentifier(name))
+	var definition string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&definition); err != nil {
+		return "", errors.Wrapf(err, "failed to get definition of %s.%s", schema, name)
+	}
+	return definition, nil
+}
@@ -0,0 +1,232 @@
+package trino
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// systemCatalog is the catalog of the Trino runtime and metadata, such as the queries and the nodes.
+	systemCatalog = "system"
+	// informationSchema is the metadata schema in each catalog.
+	informationSchema = "information_schema"
+)
+
+// SyncInstance syncs the instance.
+// The catalogs are the databases of the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	var version string
+	if err := driver.db.QueryRowContext(ctx, "SELECT version()").Scan(&version); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT catalog_name FROM system.metadata.catalogs WHERE catalog_name != '%s' ORDER BY catalog_name", systemCatalog)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	var databases []*storepb.DatabaseSchemaMetadata
+	for rows.Next() {
+		var catalog string
+		if err := rows.Scan(&catalog); err != nil {
+			return nil, err
+		}
+		databases = append(databases, &storepb.DatabaseSchemaMetadata{Name: catalog})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	return &db.InstanceMetadata{
+		Version:   version,
+		Databases: databases,
+	}, nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	if driver.databaseName == "" {
+		return nil, errors.New("catalog is required for Trino")
+	}
+	catalog := quoteIdentifier(driver.databaseName)
+
+	schemaNames, err := driver.getSchemas(ctx, catalog)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schemas")
+	}
+	columnMap, err := driver.getColumns(ctx, catalog)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get columns")
+	}
+	tableMap, err := driver.getTables(ctx, catalog, columnMap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tables")
+	}
+	viewMap, err := driver.getViews(ctx, catalog, columnMap)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views")
+	}
+
+	databaseMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: driver.databaseName,
+	}
+	for _, schemaName := range schemaNames {
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:   schemaName,
+			Tables: tableMap[schemaName],
+			Views:  viewMap[schemaName],
+		})
+	}
+	return databaseMetadata, nil
+}
+
+func (driver *Driver) getSchemas(ctx context.Context, catalog string) ([]string, error) {
+	query := fmt.Sprintf(`
+		SELECT schema_name FROM %s.information_schema.schemata
+		WHERE schema_name != '%s'
+		ORDER BY schema_name`, catalog, informationSchema)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var schemaNames []string
+	for rows.Next() {
+		var schemaName string
+		if err := rows.Scan(&schemaName); err != nil {
+			return nil, err
+		}
+		schemaNames = append(schemaNames, schemaName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return schemaNames, nil
+}
+
+// getColumns gets the columns of the tables and views keyed by the table.
+func (driver *Driver) getColumns(ctx context.Context, catalog string) (map[db.TableKey][]*storepb.ColumnMetadata, error) {
+	columnMap := make(map[db.TableKey][]*storepb.ColumnMetadata)
+	query := fmt.Sprintf(`
+		SELECT table_schema, table_name, column_name, ordinal_position, column_default, is_nullable, data_type
+		FROM %s.information_schema.columns
+		WHERE table_schema != '%s'
+		ORDER BY table_schema, table_name, ordinal_position`, catalog, informationSchema)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		column := &storepb.ColumnMetadata{}
+		var schemaName, tableName, nullable string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Position, &defaultValue, &nullable, &column.Type); err != nil {
+			return nil, err
+		}
+		if defaultValue.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: defaultValue.String}
+		}
+		isNullable, err := util.ConvertYesNo(nullable)
+		if err != nil {
+			return nil, err
+		}
+		column.Nullable = isNullable
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		columnMap[key] = append(columnMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return columnMap, nil
+}
+
+// getTables gets the tables keyed by the schema.
+// The comments are read from system.metadata.table_comments since the information_schema doesn't have them.
+func (driver *Driver) getTables(ctx context.Context, catalog string, columnMap map[db.TableKey][]*storepb.ColumnMetadata) (map[string][]*storepb.TableMetadata, error) {
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	query := fmt.Sprintf(`
+		SELECT t.table_schema, t.table_name, c.comment
+		FROM %s.information_schema.tables t
+		LEFT JOIN system.metadata.table_comments c
+			ON c.catalog_name = t.table_catalog AND c.schema_name = t.table_schema AND c.table_name = t.table_name
+		WHERE t.table_type = 'BASE TABLE' AND t.table_schema != '%s'
+		ORDER BY t.table_schema, t.table_name`, catalog, informationSchema)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		table := &storepb.TableMetadata{}
+		var schemaName string
+		var comment sql.NullString
+		if err := rows.Scan(&schemaName, &table.Name, &comment); err != nil {
+			return nil, err
+		}
+		table.Comment = comment.String
+		table.UserComment = comment.String
+		table.Columns = columnMap[db.TableKey{Schema: schemaName, Table: table.Name}]
+		tableMap[schemaName] = append(tableMap[schemaName], table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return tableMap, nil
+}
+
+// getViews gets the views keyed by the schema.
+func (driver *Driver) getViews(ctx context.Context, catalog string, columnMap map[db.TableKey][]*storepb.ColumnMetadata) (map[string][]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+	query := fmt.Sprintf(`
+		SELECT table_schema, table_name, view_definition
+		FROM %s.information_schema.views
+		WHERE table_schema != '%s'
+		ORDER BY table_schema, table_name`, catalog, informationSchema)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		view := &storepb.ViewMetadata{}
+		var schemaName string
+		var definition sql.NullString
+		if err := rows.Scan(&schemaName, &view.Name, &definition); err != nil {
+			return nil, err
+		}
+		view.Definition = definition.String
+		view.Columns = columnMap[db.TableKey{Schema: schemaName, Table: view.Name}]
+		viewMap[schemaName] = append(viewMap[schemaName], view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return viewMap, nil
+}
+
+// quoteIdentifier quotes the identifier, such as the catalog names containing the hyphens.
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+// SyncSlowQuery syncs the slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks if slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
@@ -0,0 +1,229 @@
+// Package trino is the plugin for Trino driver.
+package trino
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/trinodb/trino-go-client/trino"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	trinoparser "github.com/bytebase/bytebase/backend/plugin/parser/trino"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	_ db.Driver = (*Driver)(nil)
+)
+
+const (
+	defaultPort = "8080"
+	// schemaHeader is the header of the default schema for the unqualified table names.
+	// The Trino driver sends the named arguments with the X-Trino- prefix as the request headers.
+	schemaHeader = "X-Trino-Schema"
+)
+
+func init() {
+	db.Register(storepb.Engine_TRINO, newDriver)
+}
+
+// Driver is the Trino driver.
+// Each catalog of the Trino cluster is a database, and the schemas of the catalog are the schemas of the database.
+type Driver struct {
+	db                   *sql.DB
+	databaseName         string
+	maximumSQLResultSize int64
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a Trino driver.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+	port := config.Port
+	if port == "" {
+		port = defaultPort
+	}
+	// Trino only accepts the password authentication over HTTPS.
+	scheme := "http"
+	if config.TLSConfig.UseSSL || config.Password != "" {
+		scheme = "https"
+	}
+	serverURI := &url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(config.Host, port),
+	}
+	if config.Password != "" {
+		serverURI.User = url.UserPassword(config.Username, config.Password)
+	} else {
+		serverURI.User = url.User(config.Username)
+	}
+	trinoConfig := &trino.Config{
+		ServerURI: serverURI.String(),
+		Source:    "bytebase",
+		Catalog:   config.Database,
+	}
+	dsn, err := trinoConfig.FormatDSN()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to format data source name")
+	}
+	db, err := sql.Open("trino", dsn)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	driver.databaseName = config.Database
+	driver.maximumSQLResultSize = config.MaximumSQLResultSize
+	return driver, nil
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	return driver.db.Close()
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// Execute executes the statements one by one.
+// The transactions are not used since most of the connectors don't support them.
+func (driver *Driver) Execute(ctx context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
+	singleSQLs, err := trinoparser.SplitSQL(statement)
+	if err != nil {
+		return 0, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+
+	totalRowsAffected := int64(0)
+	for _, singleSQL := range singleSQLs {
+		// Trino doesn't accept the trailing semicolons.
+		sqlResult, err := driver.db.ExecContext(ctx, util.TrimStatement(singleSQL.Text))
+		if err != nil {
+			return 0, &db.ErrorWithPosition{
+				Err: errors.Wrapf(err, "failed to execute statement"),
+				Start: &storepb.TaskRunResult_Position{
+					Line:   int32(singleSQL.FirstStatementLine),
+					Column: int32(singleSQL.FirstStatementColumn),
+				},
+				End: &storepb.TaskRunResult_Position{
+					Line:   int32(singleSQL.LastLine),
+					Column: int32(singleSQL.LastColumn),
+				},
+			}
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+		}
+		totalRowsAffected += rowsAffected
+	}
+	return totalRowsAffected, nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext db.QueryContext) ([]*v1pb.QueryResult, error) {
+	singleSQLs, err := trinoparser.SplitSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	// The Trino sessions are stateless, so the schema is sent with each query rather than by the USE statement.
+	var args []any
+	if queryContext.Schema != "" {
+		args = append(args, sql.Named(schemaHeader, queryContext.Schema))
+	}
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		statement := util.TrimStatement(singleSQL.Text)
+		_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_TRINO, statement)
+		if err != nil {
+			return nil, err
+		}
+		if queryContext.Explain {
+			statement = fmt.Sprintf("EXPLAIN %s", statement)
+		} else if allQuery && queryContext.Limit > 0 {
+			statement = getStatementWithResultLimit(statement, queryContext.Limit)
+		}
+
+		startTime := time.Now()
+		queryResult, err := func() (*v1pb.QueryResult, error) {
+			if allQuery || queryContext.Explain {
+				rows, err := conn.QueryContext(ctx, statement, args...)
+				if err != nil {
+					return nil, err
+				}
+				defer rows.Close()
+				r, err := util.RowsToQueryResult(rows, driver.maximumSQLResultSize)
+				if err != nil {
+					return nil, err
+				}
+				if err := rows.Err(); err != nil {
+					return nil, err
+				}
+				return r, nil
+			}
+
+			sqlResult, err := conn.ExecContext(ctx, statement, args...)
+			if err != nil {
+				return nil, err
+			}
+			affectedRows, err := sqlResult.RowsAffected()
+			if err != nil {
+				slog.Info("rowsAffected returns error", log.BBError(err))
+			}
+			return util.BuildAffectedRowsResult(affectedRows), nil
+		}()
+		stop := false
+		if err != nil {
+			queryResult = &v1pb.QueryResult{
+				Error: err.Error(),
+			}
+			stop = true
+		}
+		queryResult.Statement = statement
+		queryResult.Latency = durationpb.New(time.Since(startTime))
+		results = append(results, queryResult)
+		if stop {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// getStatementWithResultLimit limits the rows of the query.
+// The SHOW, DESCRIBE and EXPLAIN statements cannot be the subqueries, so they are not limited.
+func getStatementWithResultLimit(statement string, limit int) string {
+	if !trinoparser.CanBeSubquery(statement) {
+		return statement
+	}
+	// To handle cases where there are comments in the query.
+	// eg. select * from t1 -- this is comment;
+	// Add two new line symbol here.
+	return fmt.Sprintf("SELECT * FROM (\n%s\n) LIMIT %d", statement, limit)
+}
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"database/sql"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// RowsCursor is the query cursor reading the rows of database/sql in batches.
+type RowsCursor struct {
+	rows            *sql.Rows
+	columnNames     []string
+	columnTypeNames []string
+	// next is the row read ahead to tell if all rows have been read.
+	next *v1pb.QueryRow
+	done bool
+}
+
+// NewRowsCursor returns the cursor of the rows, which takes the ownership of the rows.
+func NewRowsCursor(rows *sql.Rows) (*RowsCursor, error) {
+	columnNames, columnTypeNames, err := getColumns(rows)
+	if err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	c := &RowsCursor{
+		rows:            rows,
+		columnNames:     columnNames,
+		columnTypeNames: columnTypeNames,
+	}
+	if err := c.readAhead(); err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Next reads at most batchSize rows. The done is true if all rows have been read.
+func (c *RowsCursor) Next(batchSize int) (*v1pb.QueryResult, bool, error) {
+	result := &v1pb.QueryResult{
+		ColumnNames:     c.columnNames,
+		ColumnTypeNames: c.columnTypeNames,
+	}
+	for len(result.Rows) < batchSize && c.next != nil {
+		result.Rows = append(result.Rows, c.next)
+		if err := c.readAhead(); err != nil {
+			return nil, false, err
+		}
+	}
+	return result, c.done, nil
+}
+
+// Close closes the rows.
+func (c *RowsCursor) Close() error {
+	return c.rows.Close()
+}
+
+func (c *RowsCursor) readAhead() error {
+	c.next = nil
+	if c.done {
+		return nil
+	}
+	if len(c.columnNames) > 0 && c.rows.Next() {
+		row, err := scanRow(c.rows, c.columnTypeNames)
+		if err != nil {
+			return err
+		}
+		c.next = row
+		return nil
+	}
+	c.done = true
+	return c.rows.Err()
+}
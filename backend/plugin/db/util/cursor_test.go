@@ -0,0 +1,45 @@
+package util
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowsCursor(t *testing.T) {
+	a := require.New(t)
+	db, err := sql.Open("sqlite3", ":memory:")
+	a.NoError(err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE t (n INTEGER); INSERT INTO t VALUES (1), (2), (3), (4), (5);")
+	a.NoError(err)
+	rows, err := db.Query("SELECT n FROM t ORDER BY n")
+	a.NoError(err)
+	cursor, err := NewRowsCursor(rows)
+	a.NoError(err)
+	defer cursor.Close()
+
+	var got []int64
+	for _, want := range []struct {
+		rows int
+		done bool
+	}{{2, false}, {2, false}, {1, true}} {
+		result, done, err := cursor.Next(2)
+		a.NoError(err)
+		a.Equal([]string{"n"}, result.ColumnNames)
+		a.Len(result.Rows, want.rows)
+		a.Equal(want.done, done)
+		for _, row := range result.Rows {
+			got = append(got, row.Values[0].GetInt64Value())
+		}
+	}
+	a.Equal([]int64{1, 2, 3, 4, 5}, got)
+
+	result, done, err := cursor.Next(2)
+	a.NoError(err)
+	a.Empty(result.Rows)
+	a.True(done)
+}
@@ -12,7 +12,9 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -46,20 +48,10 @@ var nullRowValue = &v1pb.RowValue{
 }
 
 func RowsToQueryResult(rows *sql.Rows, limit int64) (*v1pb.QueryResult, error) {
-	columnNames, err := rows.Columns()
-	if err != nil {
-		return nil, err
-	}
-	columnTypes, err := rows.ColumnTypes()
+	columnNames, columnTypeNames, err := getColumns(rows)
 	if err != nil {
 		return nil, err
 	}
-	// DatabaseTypeName returns the database system name of the column type.
-	// refer: https://pkg.go.dev/database/sql#ColumnType.DatabaseTypeName
-	var columnTypeNames []string
-	for _, v := range columnTypes {
-		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
-	}
 	result := &v1pb.QueryResult{
 		ColumnNames:     columnNames,
 		ColumnTypeNames: columnTypeNames,
@@ -68,64 +60,10 @@ func RowsToQueryResult(rows *sql.Rows, limit int64) (*v1pb.QueryResult, error) {
 
 	if columnLength > 0 {
 		for rows.Next() {
-			values := make([]any, columnLength)
-			for i, v := range columnTypeNames {
-				values[i] = makeValueByTypeName(v)
-			}
-
-			if err := rows.Scan(values...); err != nil {
+			row, err := scanRow(rows, columnTypeNames)
+			if err != nil {
 				return nil, err
 			}
-
-			row := &v1pb.QueryRow{}
-			for i := 0; i < columnLength; i++ {
-				rowValue := nullRowValue
-				switch raw := values[i].(type) {
-				case *sql.NullString:
-					if raw.Valid {
-						rowValue = &v1pb.RowValue{
-							Kind: &v1pb.RowValue_StringValue{
-								StringValue: raw.String,
-							},
-						}
-					}
-				case *sql.NullInt64:
-					if raw.Valid {
-						rowValue = &v1pb.RowValue{
-							Kind: &v1pb.RowValue_Int64Value{
-								Int64Value: raw.Int64,
-							},
-						}
-					}
-				case *[]byte:
-					if len(*raw) > 0 {
-						rowValue = &v1pb.RowValue{
-							Kind: &v1pb.RowValue_BytesValue{
-								BytesValue: *raw,
-							},
-						}
-					}
-				case *sql.NullBool:
-					if raw.Valid {
-						rowValue = &v1pb.RowValue{
-							Kind: &v1pb.RowValue_BoolValue{
-								BoolValue: raw.Bool,
-							},
-						}
-					}
-				case *sql.NullFloat64:
-					if raw.Valid {
-						rowValue = &v1pb.RowValue{
-							Kind: &v1pb.RowValue_DoubleValue{
-								DoubleValue: raw.Float64,
-							},
-						}
-					}
-				}
-
-				row.Values = append(row.Values, rowValue)
-			}
-
 			result.Rows = append(result.Rows, row)
 			n := len(result.Rows)
 			if (n&(n-1) == 0) && int64(proto.Size(result)) > limit {
@@ -142,6 +80,88 @@ func RowsToQueryResult(rows *sql.Rows, limit int64) (*v1pb.QueryResult, error) {
 	return result, nil
 }
 
+// getColumns returns the column names and the upper-cased column type names of the rows.
+func getColumns(rows *sql.Rows) ([]string, []string, error) {
+	columnNames, err := rows.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, err
+	}
+	// DatabaseTypeName returns the database system name of the column type.
+	// refer: https://pkg.go.dev/database/sql#ColumnType.DatabaseTypeName
+	var columnTypeNames []string
+	for _, v := range columnTypes {
+		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
+	}
+	return columnNames, columnTypeNames, nil
+}
+
+// scanRow scans the current row of the rows.
+func scanRow(rows *sql.Rows, columnTypeNames []string) (*v1pb.QueryRow, error) {
+	columnLength := len(columnTypeNames)
+	values := make([]any, columnLength)
+	for i, v := range columnTypeNames {
+		values[i] = makeValueByTypeName(v)
+	}
+
+	if err := rows.Scan(values...); err != nil {
+		return nil, err
+	}
+
+	row := &v1pb.QueryRow{}
+	for i := 0; i < columnLength; i++ {
+		rowValue := nullRowValue
+		switch raw := values[i].(type) {
+		case *sql.NullString:
+			if raw.Valid {
+				rowValue = &v1pb.RowValue{
+					Kind: &v1pb.RowValue_StringValue{
+						StringValue: raw.String,
+					},
+				}
+			}
+		case *sql.NullInt64:
+			if raw.Valid {
+				rowValue = &v1pb.RowValue{
+					Kind: &v1pb.RowValue_Int64Value{
+						Int64Value: raw.Int64,
+					},
+				}
+			}
+		case *[]byte:
+			if len(*raw) > 0 {
+				rowValue = &v1pb.RowValue{
+					Kind: &v1pb.RowValue_BytesValue{
+						BytesValue: *raw,
+					},
+				}
+			}
+		case *sql.NullBool:
+			if raw.Valid {
+				rowValue = &v1pb.RowValue{
+					Kind: &v1pb.RowValue_BoolValue{
+						BoolValue: raw.Bool,
+					},
+				}
+			}
+		case *sql.NullFloat64:
+			if raw.Valid {
+				rowValue = &v1pb.RowValue{
+					Kind: &v1pb.RowValue_DoubleValue{
+						DoubleValue: raw.Float64,
+					},
+				}
+			}
+		}
+
+		row.Values = append(row.Values, rowValue)
+	}
+	return row, nil
+}
+
 func makeValueByTypeName(typeName string) any {
 	switch typeName {
 	case "VARCHAR", "TEXT", "UUID", "TIMESTAMP":
@@ -159,6 +179,27 @@ func makeValueByTypeName(typeName string) any {
 	}
 }
 
+// GetSingleQuery returns the statement if it's a single query statement.
+func GetSingleQuery(engine storepb.Engine, statement string) (string, error) {
+	singleSQLs, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return "", err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) != 1 {
+		return "", errors.Errorf("expect a single statement, but got %d", len(singleSQLs))
+	}
+	statement = singleSQLs[0].Text
+	_, allQuery, err := base.ValidateSQLForEditor(engine, statement)
+	if err != nil {
+		return "", err
+	}
+	if !allQuery {
+		return "", errors.Errorf("expect a query statement")
+	}
+	return statement, nil
+}
+
 // TrimStatement trims the unused characters from the statement for making getStatementWithResultLimit() happy.
 func TrimStatement(statement string) string {
 	return strings.TrimLeftFunc(strings.TrimRightFunc(statement, utils.IsSpaceOrSemicolon), unicode.IsSpace)
@@ -0,0 +1,56 @@
+package db2
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterQueryValidator(storepb.Engine_DB2, validateQuery)
+}
+
+// validateQuery validates the SQL statement for SQL editor.
+// The SELECT, VALUES and WITH statements are queries unless they change the data by the data change table references,
+// such as SELECT * FROM FINAL TABLE (INSERT INTO t VALUES (1)). The SET statements only change the special registers.
+func validateQuery(statement string) (bool, bool, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return false, false, err
+	}
+	allQuery := true
+	for _, single := range list {
+		if single.Empty {
+			continue
+		}
+		tokens, err := Tokenize(single.Text)
+		if err != nil {
+			return false, false, err
+		}
+		switch {
+		case tokens[0].IsWord("SELECT", "VALUES", "WITH"):
+			if hasDataChange(tokens) {
+				return false, false, nil
+			}
+		case tokens[0].IsWord("SET"):
+			allQuery = false
+		default:
+			return false, false, nil
+		}
+	}
+	return true, allQuery, nil
+}
+
+// hasDataChange returns whether the query contains the data change statements.
+func hasDataChange(tokens []Token) bool {
+	for i, token := range tokens {
+		if !token.IsWord("INSERT", "UPDATE", "DELETE", "MERGE") {
+			continue
+		}
+		// SELECT ... FOR UPDATE locks the rows only.
+		if token.IsWord("UPDATE") && i > 0 && tokens[i-1].IsWord("FOR") {
+			continue
+		}
+		return true
+	}
+	return false
+}
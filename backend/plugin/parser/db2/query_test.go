@@ -0,0 +1,59 @@
+package db2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSQLForEditor(t *testing.T) {
+	type testData struct {
+		sql      string
+		valid    bool
+		allQuery bool
+	}
+	tests := []testData{
+		{
+			sql:      `SELECT * FROM t`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `WITH c AS (SELECT a FROM t) SELECT * FROM c; VALUES (1, 2);`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `SELECT * FROM t FOR UPDATE OF a`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `SET SCHEMA s; SELECT 'delete' FROM t`,
+			valid:    true,
+			allQuery: false,
+		},
+		{
+			sql:      `SELECT id FROM FINAL TABLE (INSERT INTO t (a) VALUES (1))`,
+			valid:    false,
+			allQuery: false,
+		},
+		{
+			sql:      `SELECT * FROM t; DELETE FROM t`,
+			valid:    false,
+			allQuery: false,
+		},
+		{
+			sql:      `CALL p(1)`,
+			valid:    false,
+			allQuery: false,
+		},
+	}
+
+	for _, test := range tests {
+		gotValid, gotAllQuery, err := validateQuery(test.sql)
+		require.NoError(t, err, test.sql)
+		require.Equal(t, test.valid, gotValid, test.sql)
+		require.Equal(t, test.allQuery, gotAllQuery, test.sql)
+	}
+}
@@ -0,0 +1,112 @@
+package db2
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	// terminatorDirectiveRegexp matches the directive of Db2 command line processor changing the statement terminator,
+	// such as "--#SET TERMINATOR @", which is used to create the routines and triggers containing semicolons.
+	terminatorDirectiveRegexp = regexp.MustCompile(`(?i)^--#SET\s+TERMINATOR\s+(\S+)`)
+)
+
+func init() {
+	base.RegisterSplitterFunc(storepb.Engine_DB2, SplitSQL)
+}
+
+// SplitSQL splits the given SQL statement into multiple SQL statements.
+// The statements are terminated by semicolons outside the compound statements, such as the BEGIN ... END
+// of the routines and triggers, or the terminator set by the --#SET TERMINATOR directive.
+// The terminators are not included in the statements since Db2 doesn't accept them in the dynamic SQL.
+func SplitSQL(statement string) ([]base.SingleSQL, error) {
+	s := newScanner(statement)
+	var list []base.SingleSQL
+	var tokens []Token
+	depth := 0
+	// pendingEnd is the END keyword whose block is decided by the next token, such as END IF.
+	pendingEnd := false
+
+	flush := func() {
+		if len(tokens) == 0 {
+			return
+		}
+		first, last := tokens[0], tokens[len(tokens)-1]
+		end := last.Offset + len(last.Text)
+		single := base.SingleSQL{
+			Text:            statement[first.Offset:end],
+			BaseLine:        first.Line,
+			LastLine:        last.Line,
+			LastColumn:      last.Column + utf8.RuneCountInString(last.Text) - 1,
+			Empty:           true,
+			ByteOffsetStart: first.Offset,
+			ByteOffsetEnd:   end,
+		}
+		// The last token may span multiple lines, such as a string literal.
+		if i := strings.LastIndexByte(last.Text, '\n'); i >= 0 {
+			single.LastLine += strings.Count(last.Text, "\n")
+			single.LastColumn = utf8.RuneCountInString(last.Text[i+1:]) - 1
+		}
+		for _, token := range tokens {
+			if token.Type != TokenComment {
+				single.Empty = false
+				single.FirstStatementLine = token.Line
+				single.FirstStatementColumn = token.Column
+				break
+			}
+		}
+		list = append(list, single)
+		tokens = nil
+		depth = 0
+		pendingEnd = false
+	}
+
+	for {
+		token, ok, err := s.next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if token.Type == TokenComment {
+			if matches := terminatorDirectiveRegexp.FindStringSubmatch(token.Text); matches != nil {
+				flush()
+				s.terminator = matches[1]
+				continue
+			}
+			tokens = append(tokens, token)
+			continue
+		}
+
+		afterEnd := pendingEnd
+		if pendingEnd {
+			pendingEnd = false
+			// END IF, END WHILE, END FOR, END LOOP and END REPEAT close the control statements without BEGIN.
+			if !token.IsWord("IF", "WHILE", "FOR", "LOOP", "REPEAT") && depth > 0 {
+				depth--
+			}
+		}
+		switch {
+		// The CASE in END CASE closes the block rather than opens one.
+		case token.IsWord("BEGIN", "CASE") && !afterEnd:
+			depth++
+		case token.IsWord("END"):
+			pendingEnd = true
+		}
+
+		isTerminator := token.Type == TokenOperator && token.Text == s.terminator
+		// The semicolons are the statement terminator only outside the compound statements.
+		if isTerminator && (s.terminator != ";" || depth == 0) {
+			flush()
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	flush()
+	return list, nil
+}
@@ -0,0 +1,114 @@
+package db2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSQL(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: `CREATE TABLE t (a INT, b VARCHAR(10) DEFAULT 'x;y');
+INSERT INTO t VALUES (1, 'it''s');`,
+			want: []string{
+				`CREATE TABLE t (a INT, b VARCHAR(10) DEFAULT 'x;y')`,
+				`INSERT INTO t VALUES (1, 'it''s')`,
+			},
+		},
+		{
+			statement: `-- comment; with semicolon
+SELECT "a;b" FROM t /* ; */ WHERE a = 1;
+SELECT CASE WHEN a > 1 THEN 1 ELSE 0 END FROM t`,
+			want: []string{
+				`-- comment; with semicolon
+SELECT "a;b" FROM t /* ; */ WHERE a = 1`,
+				`SELECT CASE WHEN a > 1 THEN 1 ELSE 0 END FROM t`,
+			},
+		},
+		{
+			statement: `CREATE PROCEDURE p (IN v INT)
+LANGUAGE SQL
+BEGIN
+  IF v > 0 THEN
+    INSERT INTO t VALUES (v);
+  END IF;
+  WHILE v > 0 DO
+    SET v = v - 1;
+  END WHILE;
+  CASE v
+    WHEN 0 THEN DELETE FROM t;
+    ELSE UPDATE t SET a = v;
+  END CASE;
+END;
+CALL p(1);`,
+			want: []string{
+				`CREATE PROCEDURE p (IN v INT)
+LANGUAGE SQL
+BEGIN
+  IF v > 0 THEN
+    INSERT INTO t VALUES (v);
+  END IF;
+  WHILE v > 0 DO
+    SET v = v - 1;
+  END WHILE;
+  CASE v
+    WHEN 0 THEN DELETE FROM t;
+    ELSE UPDATE t SET a = v;
+  END CASE;
+END`,
+				`CALL p(1)`,
+			},
+		},
+		{
+			statement: `--#SET TERMINATOR @
+CREATE TRIGGER trg AFTER INSERT ON t FOR EACH ROW
+  UPDATE s SET c = c + 1; @
+--#SET TERMINATOR ;
+SELECT * FROM t;`,
+			want: []string{
+				`CREATE TRIGGER trg AFTER INSERT ON t FOR EACH ROW
+  UPDATE s SET c = c + 1;`,
+				`SELECT * FROM t`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		list, err := SplitSQL(test.statement)
+		require.NoError(t, err, test.statement)
+		var got []string
+		for _, single := range list {
+			got = append(got, single.Text)
+		}
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
+
+func TestSplitSQLPosition(t *testing.T) {
+	a := require.New(t)
+	list, err := SplitSQL("SELECT 1 FROM t;\n/* comment */\n  SELECT 'a\nb' FROM t;\n-- trailing")
+	a.NoError(err)
+	a.Len(list, 3)
+
+	a.Equal(0, list[0].BaseLine)
+	a.Equal(0, list[0].LastLine)
+	a.Equal(14, list[0].LastColumn)
+
+	a.Equal(1, list[1].BaseLine)
+	a.Equal(2, list[1].FirstStatementLine)
+	a.Equal(2, list[1].FirstStatementColumn)
+	a.Equal(3, list[1].LastLine)
+	a.Equal(8, list[1].LastColumn)
+	a.False(list[1].Empty)
+
+	a.True(list[2].Empty)
+}
+
+func TestSplitSQLSyntaxError(t *testing.T) {
+	_, err := SplitSQL("SELECT 'a FROM t;")
+	require.Error(t, err)
+}
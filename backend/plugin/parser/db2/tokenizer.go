@@ -0,0 +1,220 @@
+// Package db2 provides the parser support for IBM Db2 LUW.
+package db2
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+// TokenType is the type of a Db2 token.
+type TokenType int
+
+const (
+	// TokenWord is a keyword or an unquoted identifier.
+	TokenWord TokenType = iota
+	// TokenQuotedIdentifier is a double-quoted identifier.
+	TokenQuotedIdentifier
+	// TokenString is a single-quoted string literal.
+	TokenString
+	// TokenNumber is a numeric literal.
+	TokenNumber
+	// TokenOperator is a punctuation or an operator, such as "(", "*" and ";".
+	TokenOperator
+	// TokenComment is a -- or /* */ comment.
+	TokenComment
+)
+
+// Token is a token of the Db2 statement.
+type Token struct {
+	Type TokenType
+	Text string
+	// Offset is the byte offset of the token in the statement.
+	Offset int
+	// Line and Column are the zero based position of the token.
+	Line   int
+	Column int
+}
+
+// IsWord returns whether the token is the unquoted word, case-insensitively.
+func (t Token) IsWord(words ...string) bool {
+	if t.Type != TokenWord {
+		return false
+	}
+	for _, word := range words {
+		if strings.EqualFold(t.Text, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// Tokenize returns the tokens of the statement without the comments.
+func Tokenize(statement string) ([]Token, error) {
+	s := newScanner(statement)
+	var tokens []Token
+	for {
+		token, ok, err := s.next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return tokens, nil
+		}
+		if token.Type != TokenComment {
+			tokens = append(tokens, token)
+		}
+	}
+}
+
+// scanner scans the Db2 tokens.
+type scanner struct {
+	text   string
+	offset int
+	line   int
+	column int
+	// terminator is the statement terminator, which is changed by the --#SET TERMINATOR directive.
+	terminator string
+}
+
+func newScanner(text string) *scanner {
+	return &scanner{
+		text:       text,
+		terminator: ";",
+	}
+}
+
+func (s *scanner) peek(n int) byte {
+	if s.offset+n >= len(s.text) {
+		return 0
+	}
+	return s.text[s.offset+n]
+}
+
+// advance skips n bytes and maintains the position.
+func (s *scanner) advance(n int) {
+	end := min(s.offset+n, len(s.text))
+	for s.offset < end {
+		r, size := utf8.DecodeRuneInString(s.text[s.offset:])
+		if r == '\n' {
+			s.line++
+			s.column = 0
+		} else {
+			s.column++
+		}
+		s.offset += size
+	}
+}
+
+// next returns the next token, or false at the end of the text.
+func (s *scanner) next() (Token, bool, error) {
+	for s.offset < len(s.text) {
+		r, size := utf8.DecodeRuneInString(s.text[s.offset:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		s.advance(size)
+	}
+	if s.offset >= len(s.text) {
+		return Token{}, false, nil
+	}
+
+	token := Token{Offset: s.offset, Line: s.line, Column: s.column}
+	switch c := s.peek(0); {
+	case c == '-' && s.peek(1) == '-':
+		token.Type = TokenComment
+		end := strings.IndexByte(s.text[s.offset:], '\n')
+		if end < 0 {
+			end = len(s.text) - s.offset
+		}
+		s.advance(end)
+	case c == '/' && s.peek(1) == '*':
+		token.Type = TokenComment
+		end := strings.Index(s.text[s.offset+2:], "*/")
+		if end < 0 {
+			return Token{}, false, s.syntaxError(token, "unterminated comment")
+		}
+		s.advance(end + 4)
+	case c == '\'':
+		token.Type = TokenString
+		if err := s.scanQuoted(token, '\''); err != nil {
+			return Token{}, false, err
+		}
+	case c == '"':
+		token.Type = TokenQuotedIdentifier
+		if err := s.scanQuoted(token, '"'); err != nil {
+			return Token{}, false, err
+		}
+	case s.terminator != ";" && strings.HasPrefix(s.text[s.offset:], s.terminator):
+		token.Type = TokenOperator
+		s.advance(len(s.terminator))
+	case isDigit(c) || (c == '.' && isDigit(s.peek(1))):
+		token.Type = TokenNumber
+		for s.offset < len(s.text) && (isDigit(s.peek(0)) || s.peek(0) == '.' ||
+			((s.peek(0) == 'E' || s.peek(0) == 'e') && (isDigit(s.peek(1)) || s.peek(1) == '+' || s.peek(1) == '-'))) {
+			if s.peek(0) == 'E' || s.peek(0) == 'e' {
+				s.advance(1)
+			}
+			s.advance(1)
+		}
+	case s.isWordRune():
+		token.Type = TokenWord
+		for s.offset < len(s.text) && s.isWordRune() {
+			_, size := utf8.DecodeRuneInString(s.text[s.offset:])
+			s.advance(size)
+		}
+	default:
+		token.Type = TokenOperator
+		// The multiple-character operators.
+		n := 1
+		for _, operator := range []string{"<>", "<=", ">=", "!=", "||", "=>"} {
+			if strings.HasPrefix(s.text[s.offset:], operator) {
+				n = len(operator)
+				break
+			}
+		}
+		_, size := utf8.DecodeRuneInString(s.text[s.offset:])
+		s.advance(max(n, size))
+	}
+	token.Text = s.text[token.Offset:s.offset]
+	return token, true, nil
+}
+
+// scanQuoted scans the quoted string or identifier, where the quote is escaped by doubling it.
+func (s *scanner) scanQuoted(token Token, quote byte) error {
+	s.advance(1)
+	for {
+		i := strings.IndexByte(s.text[s.offset:], quote)
+		if i < 0 {
+			return s.syntaxError(token, "unterminated quoted string or identifier")
+		}
+		s.advance(i + 1)
+		if s.peek(0) != quote {
+			return nil
+		}
+		s.advance(1)
+	}
+}
+
+func (s *scanner) isWordRune() bool {
+	r, _ := utf8.DecodeRuneInString(s.text[s.offset:])
+	if s.terminator != ";" && strings.HasPrefix(s.text[s.offset:], s.terminator) {
+		return false
+	}
+	return r == '_' || r == '@' || r == '#' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func (*scanner) syntaxError(token Token, message string) error {
+	return &base.SyntaxError{
+		Line:    token.Line + 1,
+		Column:  token.Column,
+		Message: fmt.Sprintf("Syntax error at line %d:%d \n%s", token.Line+1, token.Column, message),
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
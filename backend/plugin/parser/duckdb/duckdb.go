@@ -0,0 +1,147 @@
+// Package duckdb provides the parser support for DuckDB.
+// DuckDB follows the PostgreSQL dialect, so the statements are handled by the PostgreSQL parser
+// after the DuckDB specific syntax, such as FROM-first queries, is rewritten.
+package duckdb
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// defaultSchema is the default schema of a DuckDB database.
+	defaultSchema = "main"
+)
+
+func init() {
+	base.RegisterSplitterFunc(storepb.Engine_DUCKDB, SplitSQL)
+}
+
+// SplitSQL splits the given SQL statement into multiple SQL statements.
+func SplitSQL(statement string) ([]base.SingleSQL, error) {
+	return pgparser.SplitSQL(statement)
+}
+
+// statementKind is the kind of the DuckDB specific statements which are not supported by the PostgreSQL parser.
+type statementKind int
+
+const (
+	// kindOther is the statement supported by the PostgreSQL parser.
+	kindOther statementKind = iota
+	// kindFromFirst is the query starting with FROM, such as `FROM t` or `FROM t SELECT a WHERE b > 1`.
+	kindFromFirst
+	// kindDescribe is the DESCRIBE statement for a table or a query.
+	kindDescribe
+	// kindShow is the SHOW statement, such as `SHOW TABLES`.
+	kindShow
+	// kindSummarize is the SUMMARIZE statement for a table or a query.
+	kindSummarize
+)
+
+// duckdbStatement is a single DuckDB statement with the tokens on the default channel.
+type duckdbStatement struct {
+	kind   statementKind
+	tokens []antlr.Token
+}
+
+func newDuckDBStatement(statement string) *duckdbStatement {
+	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	s := &duckdbStatement{}
+	for _, token := range stream.GetAllTokens() {
+		if token.GetChannel() != antlr.TokenDefaultChannel || token.GetTokenType() == antlr.TokenEOF {
+			continue
+		}
+		s.tokens = append(s.tokens, token)
+	}
+	if len(s.tokens) == 0 {
+		return s
+	}
+	switch strings.ToUpper(s.tokens[0].GetText()) {
+	case "FROM":
+		s.kind = kindFromFirst
+	case "DESCRIBE", "DESC":
+		s.kind = kindDescribe
+	case "SHOW":
+		s.kind = kindShow
+	case "SUMMARIZE":
+		s.kind = kindSummarize
+	}
+	return s
+}
+
+// getTarget returns the text after the leading keyword of the DESCRIBE and SUMMARIZE statements,
+// and whether the target is a query rather than a table.
+func (s *duckdbStatement) getTarget() (string, bool) {
+	if len(s.tokens) < 2 {
+		return "", false
+	}
+	text := s.getText(1, len(s.tokens))
+	switch s.tokens[1].GetTokenType() {
+	case parser.PostgreSQLLexerSELECT, parser.PostgreSQLLexerWITH, parser.PostgreSQLLexerFROM, parser.PostgreSQLLexerVALUES, parser.PostgreSQLLexerOPEN_PAREN:
+		return text, true
+	default:
+		return text, false
+	}
+}
+
+// rewriteFromFirst rewrites the FROM-first query into the standard SELECT query.
+// `FROM t` is rewritten to `SELECT * FROM t`, and `FROM t SELECT a WHERE b > 1` is rewritten to `SELECT a FROM t WHERE b > 1`.
+func (s *duckdbStatement) rewriteFromFirst() string {
+	selectIndex, restIndex := -1, len(s.tokens)
+	depth := 0
+	for i, token := range s.tokens {
+		switch token.GetTokenType() {
+		case parser.PostgreSQLLexerOPEN_PAREN:
+			depth++
+			continue
+		case parser.PostgreSQLLexerCLOSE_PAREN:
+			depth--
+			continue
+		}
+		if depth != 0 {
+			continue
+		}
+		if selectIndex < 0 {
+			if token.GetTokenType() == parser.PostgreSQLLexerSELECT {
+				selectIndex = i
+			}
+			continue
+		}
+		switch token.GetTokenType() {
+		case parser.PostgreSQLLexerWHERE, parser.PostgreSQLLexerGROUP_P, parser.PostgreSQLLexerHAVING, parser.PostgreSQLLexerWINDOW,
+			parser.PostgreSQLLexerORDER, parser.PostgreSQLLexerLIMIT, parser.PostgreSQLLexerOFFSET, parser.PostgreSQLLexerFETCH,
+			parser.PostgreSQLLexerUNION, parser.PostgreSQLLexerEXCEPT, parser.PostgreSQLLexerINTERSECT, parser.PostgreSQLLexerSEMI:
+			restIndex = i
+		}
+		if restIndex != len(s.tokens) {
+			break
+		}
+	}
+	if selectIndex < 0 {
+		return "SELECT * " + s.getText(0, len(s.tokens))
+	}
+	parts := []string{s.getText(selectIndex, restIndex), s.getText(0, selectIndex)}
+	if restIndex < len(s.tokens) {
+		parts = append(parts, s.getText(restIndex, len(s.tokens)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// getText returns the original text of the tokens in [start, end).
+func (s *duckdbStatement) getText(start, end int) string {
+	if start >= end {
+		return ""
+	}
+	stream := s.tokens[start].GetInputStream()
+	return stream.GetText(s.tokens[start].GetStart(), s.tokens[end-1].GetStop())
+}
@@ -0,0 +1,67 @@
+package duckdb
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterQueryValidator(storepb.Engine_DUCKDB, validateQuery)
+	base.RegisterExtractResourceListFunc(storepb.Engine_DUCKDB, ExtractResourceList)
+}
+
+// validateQuery validates the SQL statement for SQL editor.
+// The DESCRIBE, SHOW and SUMMARIZE statements only read the data or the metadata,
+// the FROM-first queries are rewritten to SELECT queries, and the others are validated by the PostgreSQL validator.
+func validateQuery(statement string) (bool, bool, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return false, false, err
+	}
+	allQuery := true
+	for _, single := range list {
+		if single.Empty {
+			continue
+		}
+		stmtReadOnly, stmtQuery, err := validateSingleQuery(single.Text)
+		if err != nil {
+			return false, false, err
+		}
+		if !stmtReadOnly {
+			return false, false, nil
+		}
+		allQuery = allQuery && stmtQuery
+	}
+	return true, allQuery, nil
+}
+
+func validateSingleQuery(statement string) (bool, bool, error) {
+	stmt := newDuckDBStatement(statement)
+	switch stmt.kind {
+	case kindFromFirst:
+		return base.ValidateSQLForEditor(storepb.Engine_POSTGRES, stmt.rewriteFromFirst())
+	case kindShow:
+		return true, true, nil
+	case kindDescribe, kindSummarize:
+		// SUMMARIZE runs the query, so the query is validated as well.
+		if target, isQuery := stmt.getTarget(); isQuery {
+			return validateSingleQuery(target)
+		}
+		return true, true, nil
+	default:
+		return base.ValidateSQLForEditor(storepb.Engine_POSTGRES, statement)
+	}
+}
+
+// ExtractResourceList extracts the tables accessed by the statement.
+func ExtractResourceList(currentDatabase string, currentSchema string, statement string) ([]base.SchemaResource, error) {
+	if currentSchema == "" {
+		currentSchema = defaultSchema
+	}
+	stmt := newDuckDBStatement(statement)
+	if stmt.kind == kindFromFirst {
+		statement = stmt.rewriteFromFirst()
+	}
+	return pgparser.ExtractResourceList(currentDatabase, currentSchema, statement)
+}
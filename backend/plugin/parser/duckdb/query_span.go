@@ -0,0 +1,65 @@
+package duckdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// summarizeColumns are the result columns of the SUMMARIZE statement.
+var summarizeColumns = []string{
+	"column_name", "column_type", "min", "max", "approx_unique", "avg", "std", "q25", "q50", "q75", "count", "null_percentage",
+}
+
+func init() {
+	base.RegisterGetQuerySpan(storepb.Engine_DUCKDB, GetQuerySpan)
+}
+
+// GetQuerySpan returns the query span for the given statement.
+func GetQuerySpan(ctx context.Context, gCtx base.GetQuerySpanContext, statement, database, schema string, ignoreCaseSensitive bool) (*base.QuerySpan, error) {
+	if schema == "" {
+		schema = defaultSchema
+	}
+	stmt := newDuckDBStatement(statement)
+	switch stmt.kind {
+	case kindFromFirst:
+		return pgparser.GetQuerySpan(ctx, gCtx, stmt.rewriteFromFirst(), database, schema, ignoreCaseSensitive)
+	case kindDescribe, kindShow:
+		// DESCRIBE and SHOW return the metadata only.
+		return &base.QuerySpan{
+			SourceColumns: base.SourceColumnSet{},
+			Results:       []base.QuerySpanResult{},
+		}, nil
+	case kindSummarize:
+		target, isQuery := stmt.getTarget()
+		if !isQuery {
+			target = fmt.Sprintf("SELECT * FROM %s", target)
+		}
+		span, err := GetQuerySpan(ctx, gCtx, target, database, schema, ignoreCaseSensitive)
+		if err != nil {
+			return nil, err
+		}
+		// Each row of the SUMMARIZE results is the statistics of a column, such as the min and max values,
+		// so every result column may contain the data from any of the source columns.
+		sourceColumns := base.SourceColumnSet{}
+		for _, result := range span.Results {
+			sourceColumns, _ = base.MergeSourceColumnSet(sourceColumns, result.SourceColumns)
+		}
+		summarizeSpan := &base.QuerySpan{
+			SourceColumns: span.SourceColumns,
+			NotFoundError: span.NotFoundError,
+		}
+		for _, column := range summarizeColumns {
+			summarizeSpan.Results = append(summarizeSpan.Results, base.QuerySpanResult{
+				Name:          column,
+				SourceColumns: sourceColumns,
+			})
+		}
+		return summarizeSpan, nil
+	default:
+		return pgparser.GetQuerySpan(ctx, gCtx, statement, database, schema, ignoreCaseSensitive)
+	}
+}
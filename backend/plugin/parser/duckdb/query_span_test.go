@@ -0,0 +1,65 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetQuerySpan(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "main",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "t",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "a"},
+							{Name: "b"},
+						},
+					},
+				},
+			},
+		},
+	}
+	gCtx := base.GetQuerySpanContext{
+		GetDatabaseMetadataFunc: func(_ context.Context, _, databaseName string) (string, *model.DatabaseMetadata, error) {
+			if databaseName != metadata.Name {
+				return "", nil, errors.Errorf("database %q not found", databaseName)
+			}
+			return databaseName, model.NewDatabaseMetadata(metadata), nil
+		},
+		ListDatabaseNamesFunc: func(_ context.Context, _ string) ([]string, error) {
+			return []string{metadata.Name}, nil
+		},
+	}
+	columnA := base.ColumnResource{Database: "db", Schema: "main", Table: "t", Column: "a"}
+	columnB := base.ColumnResource{Database: "db", Schema: "main", Table: "t", Column: "b"}
+
+	a := require.New(t)
+
+	span, err := GetQuerySpan(context.TODO(), gCtx, "FROM t SELECT b", "db", "", false)
+	a.NoError(err)
+	a.Len(span.Results, 1)
+	a.Equal("b", span.Results[0].Name)
+	a.Equal(base.SourceColumnSet{columnB: true}, span.Results[0].SourceColumns)
+
+	span, err = GetQuerySpan(context.TODO(), gCtx, "DESCRIBE t", "db", "", false)
+	a.NoError(err)
+	a.Empty(span.Results)
+
+	span, err = GetQuerySpan(context.TODO(), gCtx, "SUMMARIZE t", "db", "", false)
+	a.NoError(err)
+	a.Len(span.Results, len(summarizeColumns))
+	for _, result := range span.Results {
+		a.Equal(base.SourceColumnSet{columnA: true, columnB: true}, result.SourceColumns, result.Name)
+	}
+}
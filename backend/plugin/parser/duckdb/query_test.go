@@ -0,0 +1,131 @@
+package duckdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestValidateSQLForEditor(t *testing.T) {
+	type testData struct {
+		sql      string
+		valid    bool
+		allQuery bool
+	}
+	tests := []testData{
+		{
+			sql:      `select * from t`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `FROM t;`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `/* comment */ FROM t SELECT a, b WHERE a > 1 ORDER BY b`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `DESCRIBE t; SHOW ALL TABLES;`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `SUMMARIZE SELECT * FROM t`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `SELECT * FROM t; SET threads = 4;`,
+			valid:    true,
+			allQuery: false,
+		},
+		{
+			sql:      `SELECT * FROM t; INSERT INTO t VALUES (1);`,
+			valid:    false,
+			allQuery: false,
+		},
+		{
+			sql:      `create table t (a int);`,
+			valid:    false,
+			allQuery: false,
+		},
+	}
+
+	for _, test := range tests {
+		gotValid, gotAllQuery, err := validateQuery(test.sql)
+		require.NoError(t, err, test.sql)
+		require.Equal(t, test.valid, gotValid, test.sql)
+		require.Equal(t, test.allQuery, gotAllQuery, test.sql)
+	}
+}
+
+func TestRewriteFromFirst(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+	}{
+		{
+			statement: `FROM t`,
+			want:      `SELECT * FROM t`,
+		},
+		{
+			statement: `FROM t SELECT a, b`,
+			want:      `SELECT a, b FROM t`,
+		},
+		{
+			statement: `FROM t JOIN (SELECT * FROM t1 WHERE c > 0) s ON t.a = s.a SELECT t.a, s.b WHERE t.a > 1 LIMIT 10;`,
+			want:      `SELECT t.a, s.b FROM t JOIN (SELECT * FROM t1 WHERE c > 0) s ON t.a = s.a WHERE t.a > 1 LIMIT 10;`,
+		},
+	}
+
+	for _, test := range tests {
+		stmt := newDuckDBStatement(test.statement)
+		require.Equal(t, kindFromFirst, stmt.kind, test.statement)
+		require.Equal(t, test.want, stmt.rewriteFromFirst(), test.statement)
+	}
+}
+
+func TestExtractResourceList(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []base.SchemaResource
+	}{
+		{
+			statement: `FROM t SELECT a;`,
+			want: []base.SchemaResource{
+				{
+					Database: "db",
+					Schema:   "main",
+					Table:    "t",
+				},
+			},
+		},
+		{
+			statement: `SELECT * FROM s.t1 JOIN t2 ON t1.a = t2.a;`,
+			want: []base.SchemaResource{
+				{
+					Database: "db",
+					Schema:   "main",
+					Table:    "t2",
+				},
+				{
+					Database: "db",
+					Schema:   "s",
+					Table:    "t1",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ExtractResourceList("db", "", test.statement)
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,258 @@
+package trino
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pgquery "github.com/pganalyze/pg_query_go/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// placeholderSchemaPrefix is the prefix of the placeholder schemas for the schemas of the other catalogs.
+	placeholderSchemaPrefix = "_bb_catalog_schema_"
+)
+
+// relation is a table reference of the statement, such as `hive.web.page_views`.
+type relation struct {
+	catalog string
+	schema  string
+	table   string
+	// location is the byte offset of the reference in the statement.
+	location int
+}
+
+// getRelations returns the table references of the statement.
+func getRelations(statement string) ([]relation, error) {
+	jsonText, err := pgquery.ParseToJSON(statement)
+	if err != nil {
+		return nil, err
+	}
+	var jsonData map[string]any
+	if err := json.Unmarshal([]byte(jsonText), &jsonData); err != nil {
+		return nil, err
+	}
+	return collectRelations(jsonData), nil
+}
+
+func collectRelations(node any) []relation {
+	var result []relation
+	switch v := node.(type) {
+	case map[string]any:
+		if rangeVar, ok := v["RangeVar"].(map[string]any); ok {
+			r := relation{location: -1}
+			r.catalog, _ = rangeVar["catalogname"].(string)
+			r.schema, _ = rangeVar["schemaname"].(string)
+			r.table, _ = rangeVar["relname"].(string)
+			if location, ok := rangeVar["location"].(float64); ok {
+				r.location = int(location)
+			}
+			result = append(result, r)
+		}
+		for _, value := range v {
+			result = append(result, collectRelations(value)...)
+		}
+	case []any:
+		for _, item := range v {
+			result = append(result, collectRelations(item)...)
+		}
+	}
+	return result
+}
+
+// ExtractResourceList extracts the tables accessed by the statement.
+// The catalog of the table reference is the database, such as the catalog hive of `hive.web.page_views`.
+func ExtractResourceList(currentDatabase string, currentSchema string, statement string) ([]base.SchemaResource, error) {
+	relations, err := getRelations(statement)
+	if err != nil {
+		return nil, err
+	}
+	resourceMap := make(map[string]base.SchemaResource)
+	for _, r := range relations {
+		resource := base.SchemaResource{
+			Database: currentDatabase,
+			Schema:   currentSchema,
+			Table:    r.table,
+		}
+		if r.catalog != "" {
+			resource.Database = r.catalog
+		}
+		if r.schema != "" {
+			resource.Schema = r.schema
+		}
+		resourceMap[resource.String()] = resource
+	}
+	var list []base.SchemaResource
+	for _, resource := range resourceMap {
+		list = append(list, resource)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].String() < list[j].String()
+	})
+	return list, nil
+}
+
+// catalogSchema is a schema of another catalog referenced by the federated query.
+type catalogSchema struct {
+	catalog string
+	schema  string
+}
+
+// rewriteCatalogReferences rewrites the table references of the catalogs other than the current catalog,
+// such as `hive.web.page_views`, to the placeholder schemas, such as `"_bb_catalog_schema_0"."page_views"`,
+// because the PostgreSQL query span extractor resolves the tables in the current database only.
+// It returns the rewritten statement and the schemas of the placeholders.
+func rewriteCatalogReferences(statement, currentCatalog string) (string, map[string]catalogSchema, error) {
+	relations, err := getRelations(statement)
+	if err != nil {
+		return "", nil, err
+	}
+	var references []relation
+	for _, r := range relations {
+		if r.catalog != "" && r.catalog != currentCatalog && r.location >= 0 {
+			references = append(references, r)
+		}
+	}
+	if len(references) == 0 {
+		return statement, nil, nil
+	}
+
+	placeholders := make(map[string]catalogSchema)
+	placeholderNames := make(map[catalogSchema]string)
+	// Rewrite from the end so that the locations of the preceding references are unchanged.
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].location > references[j].location
+	})
+	for _, r := range references {
+		key := catalogSchema{catalog: r.catalog, schema: r.schema}
+		name, ok := placeholderNames[key]
+		if !ok {
+			name = fmt.Sprintf("%s%d", placeholderSchemaPrefix, len(placeholderNames))
+			placeholderNames[key] = name
+			placeholders[name] = key
+		}
+		end, err := scanQualifiedName(statement, r.location)
+		if err != nil {
+			return "", nil, err
+		}
+		statement = fmt.Sprintf(`%s"%s"."%s"%s`, statement[:r.location], name, strings.ReplaceAll(r.table, `"`, `""`), statement[end:])
+	}
+	return statement, placeholders, nil
+}
+
+// scanQualifiedName returns the end offset of the dotted identifiers starting at the offset, such as `hive."web".page_views`.
+func scanQualifiedName(statement string, start int) (int, error) {
+	pos := start
+	for {
+		if pos >= len(statement) {
+			return 0, errors.Errorf("expect identifier at offset %d", pos)
+		}
+		if statement[pos] == '"' {
+			pos++
+			for {
+				i := strings.IndexByte(statement[pos:], '"')
+				if i < 0 {
+					return 0, errors.Errorf("unterminated quoted identifier at offset %d", start)
+				}
+				pos += i + 1
+				if pos < len(statement) && statement[pos] == '"' {
+					pos++
+					continue
+				}
+				break
+			}
+		} else {
+			identifierStart := pos
+			for pos < len(statement) {
+				r, size := utf8.DecodeRuneInString(statement[pos:])
+				if r != '_' && r != '$' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				pos += size
+			}
+			if pos == identifierStart {
+				return 0, errors.Errorf("expect identifier at offset %d", pos)
+			}
+		}
+		end := pos
+		for pos < len(statement) && unicode.IsSpace(rune(statement[pos])) {
+			pos++
+		}
+		if pos >= len(statement) || statement[pos] != '.' {
+			return end, nil
+		}
+		pos++
+		for pos < len(statement) && unicode.IsSpace(rune(statement[pos])) {
+			pos++
+		}
+	}
+}
+
+// newCatalogQuerySpanContext returns the query span context whose current database contains the placeholder schemas
+// copied from the schemas of the other catalogs.
+func newCatalogQuerySpanContext(gCtx base.GetQuerySpanContext, currentCatalog string, placeholders map[string]catalogSchema) base.GetQuerySpanContext {
+	getDatabaseMetadata := gCtx.GetDatabaseMetadataFunc
+	gCtx.GetDatabaseMetadataFunc = func(ctx context.Context, instanceID, databaseName string) (string, *model.DatabaseMetadata, error) {
+		name, metadata, err := getDatabaseMetadata(ctx, instanceID, databaseName)
+		if err != nil || databaseName != currentCatalog {
+			return name, metadata, err
+		}
+		merged := &storepb.DatabaseSchemaMetadata{Name: name}
+		if metadata != nil {
+			for _, schemaName := range metadata.ListSchemaNames() {
+				merged.Schemas = append(merged.Schemas, metadata.GetSchema(schemaName).GetProto())
+			}
+		}
+		for placeholder, reference := range placeholders {
+			// The catalogs which are not synced, such as the system catalog, are skipped like the unknown tables.
+			_, catalogMetadata, err := getDatabaseMetadata(ctx, instanceID, reference.catalog)
+			if err != nil || catalogMetadata == nil {
+				continue
+			}
+			schema := catalogMetadata.GetSchema(reference.schema)
+			if schema == nil {
+				continue
+			}
+			schemaMetadata, ok := proto.Clone(schema.GetProto()).(*storepb.SchemaMetadata)
+			if !ok {
+				continue
+			}
+			schemaMetadata.Name = placeholder
+			merged.Schemas = append(merged.Schemas, schemaMetadata)
+		}
+		return name, model.NewDatabaseMetadata(merged), nil
+	}
+	return gCtx
+}
+
+// restoreCatalogReferences restores the source columns in the placeholder schemas to the columns of the other catalogs.
+func restoreCatalogReferences(span *base.QuerySpan, placeholders map[string]catalogSchema) {
+	restore := func(set base.SourceColumnSet) base.SourceColumnSet {
+		if set == nil {
+			return nil
+		}
+		result := make(base.SourceColumnSet, len(set))
+		for column, value := range set {
+			if reference, ok := placeholders[column.Schema]; ok {
+				column.Database = reference.catalog
+				column.Schema = reference.schema
+			}
+			result[column] = value
+		}
+		return result
+	}
+	span.SourceColumns = restore(span.SourceColumns)
+	for i := range span.Results {
+		span.Results[i].SourceColumns = restore(span.Results[i].SourceColumns)
+	}
+}
@@ -0,0 +1,54 @@
+package trino
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterQueryValidator(storepb.Engine_TRINO, validateQuery)
+	base.RegisterExtractResourceListFunc(storepb.Engine_TRINO, ExtractResourceList)
+}
+
+// validateQuery validates the SQL statement for SQL editor.
+// The SHOW, DESCRIBE and EXPLAIN statements only read the metadata, except EXPLAIN ANALYZE which runs the statement.
+// The USE statements only change the session. The others are validated by the PostgreSQL validator.
+func validateQuery(statement string) (bool, bool, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return false, false, err
+	}
+	allQuery := true
+	for _, single := range list {
+		if single.Empty {
+			continue
+		}
+		stmtReadOnly, stmtQuery, err := validateSingleQuery(single.Text)
+		if err != nil {
+			return false, false, err
+		}
+		if !stmtReadOnly {
+			return false, false, nil
+		}
+		allQuery = allQuery && stmtQuery
+	}
+	return true, allQuery, nil
+}
+
+func validateSingleQuery(statement string) (bool, bool, error) {
+	stmt := newTrinoStatement(statement)
+	switch stmt.kind {
+	case kindShow, kindDescribe:
+		return true, true, nil
+	case kindExplain:
+		if analyzed, ok := stmt.getAnalyzedStatement(); ok {
+			readOnly, _, err := validateSingleQuery(analyzed)
+			return readOnly, readOnly, err
+		}
+		return true, true, nil
+	case kindUse:
+		return true, false, nil
+	default:
+		return base.ValidateSQLForEditor(storepb.Engine_POSTGRES, statement)
+	}
+}
@@ -0,0 +1,41 @@
+package trino
+
+import (
+	"context"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterGetQuerySpan(storepb.Engine_TRINO, GetQuerySpan)
+}
+
+// GetQuerySpan returns the query span for the given statement.
+// The database is the catalog, and the tables of the other catalogs are resolved by the placeholder schemas
+// so that the source columns of the federated queries belong to the right catalogs.
+func GetQuerySpan(ctx context.Context, gCtx base.GetQuerySpanContext, statement, database, schema string, ignoreCaseSensitive bool) (*base.QuerySpan, error) {
+	// The SHOW, DESCRIBE, EXPLAIN and USE statements return the metadata or the query plan only.
+	if stmt := newTrinoStatement(statement); stmt.kind != kindOther {
+		return &base.QuerySpan{
+			SourceColumns: base.SourceColumnSet{},
+			Results:       []base.QuerySpanResult{},
+		}, nil
+	}
+
+	rewritten, placeholders, err := rewriteCatalogReferences(statement, database)
+	if err != nil {
+		// Leave the syntax errors to the PostgreSQL query span extractor.
+		return pgparser.GetQuerySpan(ctx, gCtx, statement, database, schema, ignoreCaseSensitive)
+	}
+	if len(placeholders) == 0 {
+		return pgparser.GetQuerySpan(ctx, gCtx, statement, database, schema, ignoreCaseSensitive)
+	}
+	span, err := pgparser.GetQuerySpan(ctx, newCatalogQuerySpanContext(gCtx, database, placeholders), rewritten, database, schema, ignoreCaseSensitive)
+	if err != nil {
+		return nil, err
+	}
+	restoreCatalogReferences(span, placeholders)
+	return span, nil
+}
@@ -0,0 +1,85 @@
+package trino
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetQuerySpan(t *testing.T) {
+	catalogs := map[string]*storepb.DatabaseSchemaMetadata{
+		"hive": {
+			Name: "hive",
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Name: "web",
+					Tables: []*storepb.TableMetadata{
+						{
+							Name: "page_views",
+							Columns: []*storepb.ColumnMetadata{
+								{Name: "user_id"},
+								{Name: "url"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"postgresql": {
+			Name: "postgresql",
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Name: "public",
+					Tables: []*storepb.TableMetadata{
+						{
+							Name: "users",
+							Columns: []*storepb.ColumnMetadata{
+								{Name: "id"},
+								{Name: "email"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	gCtx := base.GetQuerySpanContext{
+		GetDatabaseMetadataFunc: func(_ context.Context, _, databaseName string) (string, *model.DatabaseMetadata, error) {
+			metadata, ok := catalogs[databaseName]
+			if !ok {
+				return "", nil, errors.Errorf("database %q not found", databaseName)
+			}
+			return databaseName, model.NewDatabaseMetadata(metadata), nil
+		},
+		ListDatabaseNamesFunc: func(_ context.Context, _ string) ([]string, error) {
+			return []string{"hive", "postgresql"}, nil
+		},
+	}
+	url := base.ColumnResource{Database: "hive", Schema: "web", Table: "page_views", Column: "url"}
+	email := base.ColumnResource{Database: "postgresql", Schema: "public", Table: "users", Column: "email"}
+
+	a := require.New(t)
+
+	span, err := GetQuerySpan(context.TODO(), gCtx, `SELECT v.url, u.email FROM page_views v JOIN postgresql."public".users u ON v.user_id = u.id`, "hive", "web", false)
+	a.NoError(err)
+	a.Len(span.Results, 2)
+	a.Equal("url", span.Results[0].Name)
+	a.Equal(base.SourceColumnSet{url: true}, span.Results[0].SourceColumns)
+	a.Equal("email", span.Results[1].Name)
+	a.Equal(base.SourceColumnSet{email: true}, span.Results[1].SourceColumns)
+
+	span, err = GetQuerySpan(context.TODO(), gCtx, `SELECT email FROM postgresql.public.users`, "hive", "web", false)
+	a.NoError(err)
+	a.Len(span.Results, 1)
+	a.Equal(base.SourceColumnSet{email: true}, span.Results[0].SourceColumns)
+
+	span, err = GetQuerySpan(context.TODO(), gCtx, `SHOW TABLES FROM postgresql.public`, "hive", "web", false)
+	a.NoError(err)
+	a.Empty(span.Results)
+}
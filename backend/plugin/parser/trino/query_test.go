@@ -0,0 +1,71 @@
+package trino
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestValidateSQLForEditor(t *testing.T) {
+	type testData struct {
+		sql      string
+		valid    bool
+		allQuery bool
+	}
+	tests := []testData{
+		{
+			sql:      `SELECT * FROM hive.web.page_views`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `SHOW CATALOGS; SHOW SCHEMAS FROM hive; DESCRIBE hive.web.page_views`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `EXPLAIN INSERT INTO t VALUES (1)`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `EXPLAIN ANALYZE VERBOSE SELECT count(*) FROM t`,
+			valid:    true,
+			allQuery: true,
+		},
+		{
+			sql:      `EXPLAIN ANALYZE DELETE FROM t`,
+			valid:    false,
+			allQuery: false,
+		},
+		{
+			sql:      `USE hive.web; SELECT 1`,
+			valid:    true,
+			allQuery: false,
+		},
+		{
+			sql:      `INSERT INTO t SELECT * FROM hive.web.page_views`,
+			valid:    false,
+			allQuery: false,
+		},
+	}
+
+	for _, test := range tests {
+		gotValid, gotAllQuery, err := validateQuery(test.sql)
+		require.NoError(t, err, test.sql)
+		require.Equal(t, test.valid, gotValid, test.sql)
+		require.Equal(t, test.allQuery, gotAllQuery, test.sql)
+	}
+}
+
+func TestExtractResourceList(t *testing.T) {
+	resources, err := ExtractResourceList("hive", "web", `SELECT * FROM page_views v JOIN postgresql.public.users u ON v.user_id = u.id JOIN web.sessions s ON s.id = v.session_id`)
+	require.NoError(t, err)
+	require.Equal(t, []base.SchemaResource{
+		{Database: "hive", Schema: "web", Table: "page_views"},
+		{Database: "hive", Schema: "web", Table: "sessions"},
+		{Database: "postgresql", Schema: "public", Table: "users"},
+	}, resources)
+}
@@ -0,0 +1,97 @@
+// Package trino provides the parser support for Trino.
+// Trino follows the ANSI SQL dialect close to PostgreSQL, so the statements are handled by the PostgreSQL parser,
+// except the Trino specific statements such as SHOW and DESCRIBE, and the catalog qualified names of the federated queries.
+package trino
+
+import (
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	parser "github.com/bytebase/postgresql-parser"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterSplitterFunc(storepb.Engine_TRINO, SplitSQL)
+}
+
+// SplitSQL splits the given SQL statement into multiple SQL statements.
+func SplitSQL(statement string) ([]base.SingleSQL, error) {
+	return pgparser.SplitSQL(statement)
+}
+
+// statementKind is the kind of the Trino specific statements which are not supported by the PostgreSQL parser.
+type statementKind int
+
+const (
+	// kindOther is the statement supported by the PostgreSQL parser.
+	kindOther statementKind = iota
+	// kindShow is the SHOW statement, such as `SHOW CATALOGS` and `SHOW CREATE TABLE t`.
+	kindShow
+	// kindDescribe is the DESCRIBE statement for a table.
+	kindDescribe
+	// kindExplain is the EXPLAIN statement, which runs the statement for EXPLAIN ANALYZE.
+	kindExplain
+	// kindUse is the USE statement changing the catalog and schema of the session.
+	kindUse
+)
+
+// trinoStatement is a single Trino statement with the tokens on the default channel.
+type trinoStatement struct {
+	kind   statementKind
+	tokens []antlr.Token
+}
+
+func newTrinoStatement(statement string) *trinoStatement {
+	lexer := parser.NewPostgreSQLLexer(antlr.NewInputStream(statement))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+	s := &trinoStatement{}
+	for _, token := range stream.GetAllTokens() {
+		if token.GetChannel() != antlr.TokenDefaultChannel || token.GetTokenType() == antlr.TokenEOF {
+			continue
+		}
+		s.tokens = append(s.tokens, token)
+	}
+	if len(s.tokens) == 0 {
+		return s
+	}
+	switch strings.ToUpper(s.tokens[0].GetText()) {
+	case "SHOW":
+		s.kind = kindShow
+	case "DESCRIBE", "DESC":
+		s.kind = kindDescribe
+	case "EXPLAIN":
+		s.kind = kindExplain
+	case "USE":
+		s.kind = kindUse
+	}
+	return s
+}
+
+// CanBeSubquery returns whether the statement can be a subquery, which is false for the Trino specific statements,
+// such as SHOW, DESCRIBE and EXPLAIN.
+func CanBeSubquery(statement string) bool {
+	return newTrinoStatement(statement).kind == kindOther
+}
+
+// getAnalyzedStatement returns the statement run by EXPLAIN ANALYZE [VERBOSE], or false for the other statements.
+func (s *trinoStatement) getAnalyzedStatement() (string, bool) {
+	if s.kind != kindExplain || len(s.tokens) < 3 || !strings.EqualFold(s.tokens[1].GetText(), "ANALYZE") {
+		return "", false
+	}
+	start := 2
+	if strings.EqualFold(s.tokens[start].GetText(), "VERBOSE") {
+		start++
+	}
+	if start >= len(s.tokens) {
+		return "", false
+	}
+	stream := s.tokens[start].GetInputStream()
+	return stream.GetText(s.tokens[start].GetStart(), s.tokens[len(s.tokens)-1].GetStop()), true
+}
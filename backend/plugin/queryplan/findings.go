@@ -0,0 +1,149 @@
+package queryplan
+
+import (
+	"fmt"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// largeTableRows is the number of rows from which a full scan on the table is reported.
+	largeTableRows = 100000
+	// rowEstimateMismatchFactor is the factor between the estimated and actual rows from which the estimate is reported.
+	rowEstimateMismatchFactor = 10
+	// rowEstimateMismatchMinRows skips the misestimates of the small results.
+	rowEstimateMismatchMinRows = 100
+	// largeNestedLoopRows is the number of outer rows from which a nested loop join is reported.
+	largeNestedLoopRows = 10000
+)
+
+// TableRowsFunc returns the number of rows of the table in the synced schema, or 0 if it's unknown.
+type TableRowsFunc func(schema, table string) int64
+
+// Analyze finds the common problems in the query plan, such as the full scans on large tables,
+// the misestimated rows and the nested loop joins over large inputs.
+func Analyze(plan *v1pb.QueryPlan, getTableRows TableRowsFunc) []*v1pb.QueryPlanFinding {
+	var findings []*v1pb.QueryPlanFinding
+	var walk func(node *v1pb.QueryPlanNode)
+	walk = func(node *v1pb.QueryPlanNode) {
+		if finding := checkFullScan(node, getTableRows); finding != nil {
+			findings = append(findings, finding)
+		}
+		if finding := checkRowEstimate(node); finding != nil {
+			findings = append(findings, finding)
+		}
+		findings = append(findings, checkNestedLoop(node)...)
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	for _, node := range plan.GetNodes() {
+		walk(node)
+	}
+	return findings
+}
+
+func checkFullScan(node *v1pb.QueryPlanNode, getTableRows TableRowsFunc) *v1pb.QueryPlanFinding {
+	if node.Type != v1pb.QueryPlanNode_FULL_SCAN || node.Table == "" {
+		return nil
+	}
+	var tableRows float64
+	if getTableRows != nil {
+		tableRows = float64(getTableRows(node.Schema, node.Table))
+	}
+	// The rows returned by the scan are the lower bound of the table rows if the table is not synced.
+	if tableRows == 0 {
+		tableRows = max(node.EstimatedRows, node.GetActualRows())
+	}
+	if tableRows < largeTableRows {
+		return nil
+	}
+	table := node.Table
+	if node.Schema != "" {
+		table = fmt.Sprintf("%s.%s", node.Schema, node.Table)
+	}
+	return &v1pb.QueryPlanFinding{
+		Type:   v1pb.QueryPlanFinding_FULL_SCAN_ON_LARGE_TABLE,
+		Status: v1pb.Advice_WARNING,
+		NodeId: node.Id,
+		Title:  "Full scan on large table",
+		Content: fmt.Sprintf("%q scans the table %q with about %.0f rows. Consider adding an index on the filtered columns.",
+			node.Operator, table, tableRows),
+	}
+}
+
+func checkRowEstimate(node *v1pb.QueryPlanNode) *v1pb.QueryPlanFinding {
+	if node.ActualRows == nil {
+		return nil
+	}
+	// The node is never executed.
+	if node.Loops != nil && *node.Loops == 0 {
+		return nil
+	}
+	estimated, actual := node.EstimatedRows, *node.ActualRows
+	high, low := max(estimated, actual), min(estimated, actual)
+	if high < rowEstimateMismatchMinRows || high < rowEstimateMismatchFactor*max(low, 1) {
+		return nil
+	}
+	return &v1pb.QueryPlanFinding{
+		Type:   v1pb.QueryPlanFinding_ROW_ESTIMATE_MISMATCH,
+		Status: v1pb.Advice_WARNING,
+		NodeId: node.Id,
+		Title:  "Row estimate mismatch",
+		Content: fmt.Sprintf("%q is estimated to return %.0f rows but actually returns %.0f rows. The statistics might be stale, consider analyzing the tables.",
+			node.Operator, estimated, actual),
+	}
+}
+
+func checkNestedLoop(node *v1pb.QueryPlanNode) []*v1pb.QueryPlanFinding {
+	if node.Type != v1pb.QueryPlanNode_NESTED_LOOP_JOIN || len(node.Children) < 2 {
+		return nil
+	}
+	var findings []*v1pb.QueryPlanFinding
+	// Some databases, such as MySQL, join more than two inputs in one nested loop,
+	// where each input is looped over the rows joined by the previous inputs.
+	outerRows := getOutputRows(node.Children[0])
+	for _, inner := range node.Children[1:] {
+		if outerRows >= largeNestedLoopRows {
+			finding := &v1pb.QueryPlanFinding{
+				Type:   v1pb.QueryPlanFinding_NESTED_LOOP_OVER_LARGE_INPUT,
+				Status: v1pb.Advice_WARNING,
+				NodeId: node.Id,
+				Title:  "Nested loop over large input",
+				Content: fmt.Sprintf("%q loops over about %.0f outer rows. Consider a hash or merge join, or reducing the outer rows.",
+					node.Operator, outerRows),
+			}
+			if scan := findFullScan(inner); scan != nil {
+				finding.Status = v1pb.Advice_ERROR
+				finding.Content = fmt.Sprintf("%q loops over about %.0f outer rows and scans the table %q for each of them. Consider adding an index on the join columns of %q.",
+					node.Operator, outerRows, scan.Table, scan.Table)
+			}
+			findings = append(findings, finding)
+		}
+		outerRows *= max(getOutputRows(inner), 1)
+	}
+	return findings
+}
+
+// getOutputRows returns the total rows returned by the node, preferring the actual rows.
+func getOutputRows(node *v1pb.QueryPlanNode) float64 {
+	if node.ActualRows == nil {
+		return node.EstimatedRows
+	}
+	if node.Loops != nil {
+		return *node.ActualRows * float64(*node.Loops)
+	}
+	return *node.ActualRows
+}
+
+func findFullScan(node *v1pb.QueryPlanNode) *v1pb.QueryPlanNode {
+	if node.Type == v1pb.QueryPlanNode_FULL_SCAN && node.Table != "" {
+		return node
+	}
+	for _, child := range node.Children {
+		if scan := findFullScan(child); scan != nil {
+			return scan
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package queryplan
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// xmlElement is the generic element of the showplan XML in SQL Server.
+type xmlElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr    `xml:",any,attr"`
+	Children []*xmlElement `xml:",any"`
+}
+
+func (e *xmlElement) attr(name string) string {
+	for _, attr := range e.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (e *xmlElement) floatAttr(name string) (float64, bool) {
+	v, err := strconv.ParseFloat(e.attr(name), 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// walk visits the descendants of the element in preorder, and skips the descendants of the element if visit returns false.
+func (e *xmlElement) walk(visit func(element *xmlElement) bool) {
+	for _, child := range e.Children {
+		if visit(child) {
+			child.walk(visit)
+		}
+	}
+}
+
+func parseMSSQLPlan(rawPlan string) (*v1pb.QueryPlan, error) {
+	var root xmlElement
+	if err := xml.Unmarshal([]byte(rawPlan), &root); err != nil {
+		return nil, err
+	}
+	plan := &v1pb.QueryPlan{}
+	var elapsedTime float64
+	root.walk(func(element *xmlElement) bool {
+		switch element.XMLName.Local {
+		case "RelOp":
+			plan.Nodes = append(plan.Nodes, convertMSSQLRelOp(element))
+			return false
+		case "QueryTimeStats":
+			if v, ok := element.floatAttr("ElapsedTime"); ok {
+				elapsedTime += v
+				plan.ExecutionTime = millisecondsToDuration(elapsedTime)
+			}
+		}
+		return true
+	})
+	if len(plan.Nodes) == 0 {
+		return nil, errors.New("no RelOp found")
+	}
+	return plan, nil
+}
+
+func convertMSSQLRelOp(relOp *xmlElement) *v1pb.QueryPlanNode {
+	physicalOp := relOp.attr("PhysicalOp")
+	node := &v1pb.QueryPlanNode{
+		Type:     getMSSQLNodeType(physicalOp, relOp.attr("LogicalOp")),
+		Operator: physicalOp,
+	}
+	node.EstimatedRows, _ = relOp.floatAttr("EstimateRows")
+	node.EstimatedCost, _ = relOp.floatAttr("EstimatedTotalSubtreeCost")
+
+	var detail detailBuilder
+	if logicalOp := relOp.attr("LogicalOp"); logicalOp != physicalOp {
+		detail.add("Logical Operation", logicalOp)
+	}
+	var hasRunTime, hasReads bool
+	var actualRows, executions, elapsed, logicalReads, physicalReads float64
+	// The properties of the operator are in the descendants before the child operators.
+	relOp.walk(func(element *xmlElement) bool {
+		switch element.XMLName.Local {
+		case "RelOp":
+			node.Children = append(node.Children, convertMSSQLRelOp(element))
+			return false
+		case "Object":
+			if node.Table == "" {
+				node.Schema = trimMSSQLBrackets(element.attr("Schema"))
+				node.Table = trimMSSQLBrackets(element.attr("Table"))
+				node.Index = trimMSSQLBrackets(element.attr("Index"))
+			}
+		case "RunTimeCountersPerThread":
+			hasRunTime = true
+			rows, _ := element.floatAttr("ActualRows")
+			actualRows += rows
+			count, _ := element.floatAttr("ActualExecutions")
+			executions += count
+			// The threads run in parallel, so the elapsed time is the slowest thread.
+			if v, ok := element.floatAttr("ActualElapsedms"); ok {
+				elapsed = max(elapsed, v)
+			}
+			if v, ok := element.floatAttr("ActualLogicalReads"); ok {
+				hasReads = true
+				logicalReads += v
+			}
+			if v, ok := element.floatAttr("ActualPhysicalReads"); ok {
+				hasReads = true
+				physicalReads += v
+			}
+		case "Predicate":
+			detail.add("Predicate", getMSSQLScalarStrings(element))
+			return false
+		case "SeekPredicates":
+			detail.add("Seek Predicates", getMSSQLScalarStrings(element))
+			return false
+		}
+		return true
+	})
+
+	if hasRunTime {
+		// The estimated rows are the rows of each execution.
+		rowsPerExecution := actualRows
+		if executions > 0 {
+			rowsPerExecution = actualRows / executions
+		}
+		loops := int64(executions)
+		node.ActualRows = &rowsPerExecution
+		node.Loops = &loops
+		node.ActualTime = millisecondsToDuration(elapsed)
+	}
+	if hasReads {
+		hit, read := int64(logicalReads-physicalReads), int64(physicalReads)
+		node.BufferHitBlocks = &hit
+		node.BufferReadBlocks = &read
+	}
+	node.Detail = detail.String()
+	return node
+}
+
+func getMSSQLScalarStrings(element *xmlElement) string {
+	var result []string
+	element.walk(func(element *xmlElement) bool {
+		if s := element.attr("ScalarString"); s != "" {
+			result = append(result, s)
+			return false
+		}
+		return true
+	})
+	return strings.Join(result, ", ")
+}
+
+func trimMSSQLBrackets(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(name, "["), "]")
+}
+
+func getMSSQLNodeType(physicalOp, logicalOp string) v1pb.QueryPlanNode_Type {
+	switch physicalOp {
+	case "Table Scan", "Clustered Index Scan", "Index Scan", "Columnstore Index Scan":
+		return v1pb.QueryPlanNode_FULL_SCAN
+	case "Clustered Index Seek", "Index Seek", "Key Lookup", "RID Lookup":
+		return v1pb.QueryPlanNode_INDEX_SCAN
+	case "Nested Loops":
+		return v1pb.QueryPlanNode_NESTED_LOOP_JOIN
+	case "Hash Match":
+		if strings.Contains(logicalOp, "Join") || strings.Contains(logicalOp, "Semi") {
+			return v1pb.QueryPlanNode_HASH_JOIN
+		}
+		return v1pb.QueryPlanNode_AGGREGATE
+	case "Merge Join":
+		return v1pb.QueryPlanNode_MERGE_JOIN
+	case "Sort":
+		return v1pb.QueryPlanNode_SORT
+	case "Stream Aggregate":
+		return v1pb.QueryPlanNode_AGGREGATE
+	case "Filter":
+		return v1pb.QueryPlanNode_FILTER
+	case "Top":
+		return v1pb.QueryPlanNode_LIMIT
+	default:
+		return v1pb.QueryPlanNode_OTHER
+	}
+}
@@ -0,0 +1,313 @@
+package queryplan
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	// mysqlTreeLineRegexp matches the line of EXPLAIN ANALYZE in MySQL, such as
+	// "-> Table scan on t  (cost=1.25 rows=10) (actual time=0.033..0.04 rows=10 loops=1)".
+	mysqlTreeLineRegexp = regexp.MustCompile(`^(\s*)-> (.*?)(?:\s+\(cost=([0-9.e+]+)(?:\.\.([0-9.e+]+))? rows=([0-9.e+]+)\))?(?:\s+\((?:actual time=([0-9.e+]+)\.\.([0-9.e+]+) rows=([0-9.e+]+) loops=([0-9]+)|never executed)\))?\s*$`)
+	// mysqlTreeTableRegexp matches the table and index in the line, such as "Index lookup on t using idx_a (a=1)".
+	mysqlTreeTableRegexp = regexp.MustCompile("\\bon `?([^\\s`]+)`?(?: using `?([^\\s`]+)`?)?")
+
+	// mysqlOperations are the operations wrapping the tables in EXPLAIN FORMAT=JSON.
+	mysqlOperations = []struct {
+		key      string
+		nodeType v1pb.QueryPlanNode_Type
+	}{
+		{key: "ordering_operation", nodeType: v1pb.QueryPlanNode_SORT},
+		{key: "grouping_operation", nodeType: v1pb.QueryPlanNode_AGGREGATE},
+		{key: "duplicates_removal", nodeType: v1pb.QueryPlanNode_OTHER},
+		{key: "windowing", nodeType: v1pb.QueryPlanNode_OTHER},
+	}
+	mysqlSubqueryKeys = []string{
+		"attached_subqueries",
+		"optimized_away_subqueries",
+		"select_list_subqueries",
+		"having_subqueries",
+		"order_by_subqueries",
+		"group_by_subqueries",
+	}
+)
+
+// parseMySQLPlan parses the plan of EXPLAIN FORMAT=JSON, or the tree of EXPLAIN ANALYZE.
+func parseMySQLPlan(rawPlan string) (*v1pb.QueryPlan, error) {
+	if strings.HasPrefix(strings.TrimSpace(rawPlan), "->") {
+		return parseMySQLTreePlan(rawPlan)
+	}
+	var root map[string]any
+	if err := json.Unmarshal([]byte(rawPlan), &root); err != nil {
+		return nil, err
+	}
+	queryBlock, ok := root["query_block"].(map[string]any)
+	if !ok {
+		return nil, errors.New("query_block not found")
+	}
+	return &v1pb.QueryPlan{Nodes: []*v1pb.QueryPlanNode{convertMySQLQueryBlock(queryBlock)}}, nil
+}
+
+func convertMySQLQueryBlock(queryBlock map[string]any) *v1pb.QueryPlanNode {
+	node := &v1pb.QueryPlanNode{
+		Type:     v1pb.QueryPlanNode_OTHER,
+		Operator: "query_block",
+		Children: convertMySQLOperations(queryBlock),
+	}
+	if selectID, ok := getNumber(queryBlock["select_id"]); ok {
+		node.Detail = fmt.Sprintf("select_id: %.0f", selectID)
+	}
+	if costInfo, ok := queryBlock["cost_info"].(map[string]any); ok {
+		node.EstimatedCost, _ = getNumber(costInfo["query_cost"])
+	}
+	return node
+}
+
+// convertMySQLOperations converts the tables and the operations on them in the object.
+func convertMySQLOperations(object map[string]any) []*v1pb.QueryPlanNode {
+	var nodes []*v1pb.QueryPlanNode
+	if table, ok := object["table"].(map[string]any); ok {
+		nodes = append(nodes, convertMySQLTable(table))
+	}
+	if items, ok := object["nested_loop"].([]any); ok {
+		join := &v1pb.QueryPlanNode{
+			Type:     v1pb.QueryPlanNode_NESTED_LOOP_JOIN,
+			Operator: "nested_loop",
+		}
+		for _, item := range items {
+			if item, ok := item.(map[string]any); ok {
+				join.Children = append(join.Children, convertMySQLOperations(item)...)
+			}
+		}
+		// The prefix cost of the last table is the cost of the join.
+		if len(join.Children) > 0 {
+			join.EstimatedCost = join.Children[len(join.Children)-1].EstimatedCost
+		}
+		nodes = append(nodes, join)
+	}
+	for _, operation := range mysqlOperations {
+		value, ok := object[operation.key].(map[string]any)
+		if !ok {
+			continue
+		}
+		// The ordering operation without filesort reads the rows in the index order.
+		if usingFilesort, _ := value["using_filesort"].(bool); operation.key == "ordering_operation" && !usingFilesort {
+			nodes = append(nodes, convertMySQLOperations(value)...)
+			continue
+		}
+		node := &v1pb.QueryPlanNode{
+			Type:     operation.nodeType,
+			Operator: operation.key,
+			Children: convertMySQLOperations(value),
+		}
+		if usingTemporaryTable, _ := value["using_temporary_table"].(bool); usingTemporaryTable {
+			node.Detail = "using_temporary_table: true"
+		}
+		for _, child := range node.Children {
+			node.EstimatedCost = max(node.EstimatedCost, child.EstimatedCost)
+		}
+		nodes = append(nodes, node)
+	}
+	if union, ok := object["union_result"].(map[string]any); ok {
+		node := &v1pb.QueryPlanNode{
+			Type:     v1pb.QueryPlanNode_OTHER,
+			Operator: "union_result",
+			Table:    getString(union, "table_name"),
+		}
+		specifications, _ := union["query_specifications"].([]any)
+		node.Children = append(node.Children, convertMySQLSubqueries(specifications)...)
+		nodes = append(nodes, node)
+	}
+	for _, key := range mysqlSubqueryKeys {
+		subqueries, _ := object[key].([]any)
+		nodes = append(nodes, convertMySQLSubqueries(subqueries)...)
+	}
+	return nodes
+}
+
+func convertMySQLSubqueries(subqueries []any) []*v1pb.QueryPlanNode {
+	var nodes []*v1pb.QueryPlanNode
+	for _, subquery := range subqueries {
+		subquery, ok := subquery.(map[string]any)
+		if !ok {
+			continue
+		}
+		if queryBlock, ok := subquery["query_block"].(map[string]any); ok {
+			nodes = append(nodes, convertMySQLQueryBlock(queryBlock))
+		}
+	}
+	return nodes
+}
+
+func convertMySQLTable(table map[string]any) *v1pb.QueryPlanNode {
+	accessType := getString(table, "access_type")
+	node := &v1pb.QueryPlanNode{
+		Type:     getMySQLAccessType(accessType),
+		Operator: accessType,
+		Table:    getString(table, "table_name"),
+		Index:    getString(table, "key"),
+	}
+	// The estimated rows returned by the table are the rows examined and filtered by the condition.
+	rowsExamined, _ := getNumber(table["rows_examined_per_scan"])
+	filtered, ok := getNumber(table["filtered"])
+	if !ok {
+		filtered = 100
+	}
+	node.EstimatedRows = rowsExamined * filtered / 100
+	if costInfo, ok := table["cost_info"].(map[string]any); ok {
+		node.EstimatedCost, _ = getNumber(costInfo["prefix_cost"])
+	}
+
+	var detail detailBuilder
+	if rowsExamined > 0 {
+		detail.add("rows_examined_per_scan", strconv.FormatFloat(rowsExamined, 'f', -1, 64))
+	}
+	detail.add("possible_keys", strings.Join(getStrings(table["possible_keys"]), ", "))
+	detail.add("ref", strings.Join(getStrings(table["ref"]), ", "))
+	detail.add("attached_condition", getString(table, "attached_condition"))
+	node.Detail = detail.String()
+
+	if subquery, ok := table["materialized_from_subquery"].(map[string]any); ok {
+		if queryBlock, ok := subquery["query_block"].(map[string]any); ok {
+			node.Children = append(node.Children, convertMySQLQueryBlock(queryBlock))
+		}
+	}
+	return node
+}
+
+func getMySQLAccessType(accessType string) v1pb.QueryPlanNode_Type {
+	switch accessType {
+	case "":
+		return v1pb.QueryPlanNode_OTHER
+	// The index access type is the full scan of the index tree.
+	case "ALL", "index":
+		return v1pb.QueryPlanNode_FULL_SCAN
+	default:
+		return v1pb.QueryPlanNode_INDEX_SCAN
+	}
+}
+
+func getStrings(value any) []string {
+	items, _ := value.([]any)
+	var result []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// parseMySQLTreePlan parses the tree of EXPLAIN ANALYZE, where each level is indented by 4 spaces.
+func parseMySQLTreePlan(rawPlan string) (*v1pb.QueryPlan, error) {
+	type levelNode struct {
+		indent int
+		node   *v1pb.QueryPlanNode
+	}
+	plan := &v1pb.QueryPlan{}
+	var stack []levelNode
+	for _, line := range strings.Split(rawPlan, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		matches := mysqlTreeLineRegexp.FindStringSubmatch(line)
+		if matches == nil {
+			// The multi-line description continues the previous node.
+			if len(stack) > 0 {
+				last := stack[len(stack)-1].node
+				last.Detail = last.Detail + "\n" + strings.TrimSpace(line)
+			}
+			continue
+		}
+		node := convertMySQLTreeLine(matches)
+		indent := len(matches[1])
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			plan.Nodes = append(plan.Nodes, node)
+		} else {
+			parent := stack[len(stack)-1].node
+			parent.Children = append(parent.Children, node)
+		}
+		stack = append(stack, levelNode{indent: indent, node: node})
+	}
+	if len(plan.Nodes) == 0 {
+		return nil, errors.New("no plan node found")
+	}
+	return plan, nil
+}
+
+func convertMySQLTreeLine(matches []string) *v1pb.QueryPlanNode {
+	description := matches[2]
+	operator := description
+	if i := strings.Index(operator, ":"); i >= 0 {
+		operator = operator[:i]
+	}
+	if i := strings.Index(operator, " on "); i >= 0 {
+		operator = operator[:i]
+	}
+	node := &v1pb.QueryPlanNode{
+		Type:     getMySQLTreeNodeType(strings.ToLower(operator)),
+		Operator: operator,
+		Detail:   description,
+	}
+	if tableMatches := mysqlTreeTableRegexp.FindStringSubmatch(description); tableMatches != nil {
+		node.Table = tableMatches[1]
+		node.Index = tableMatches[2]
+	}
+	// The total cost is the cost to return all rows, which is the end of the range if any.
+	if cost := matches[4]; cost != "" {
+		node.EstimatedCost, _ = strconv.ParseFloat(cost, 64)
+	} else if cost := matches[3]; cost != "" {
+		node.EstimatedCost, _ = strconv.ParseFloat(cost, 64)
+	}
+	if rows := matches[5]; rows != "" {
+		node.EstimatedRows, _ = strconv.ParseFloat(rows, 64)
+	}
+	if matches[9] != "" {
+		lastRowTime, _ := strconv.ParseFloat(matches[7], 64)
+		actualRows, _ := strconv.ParseFloat(matches[8], 64)
+		loops, _ := strconv.ParseInt(matches[9], 10, 64)
+		node.ActualRows = &actualRows
+		node.Loops = &loops
+		// The actual time is the average time of the loops.
+		node.ActualTime = millisecondsToDuration(lastRowTime * float64(loops))
+	} else if strings.HasSuffix(strings.TrimSpace(matches[0]), "(never executed)") {
+		var actualRows float64
+		var loops int64
+		node.ActualRows = &actualRows
+		node.Loops = &loops
+	}
+	return node
+}
+
+func getMySQLTreeNodeType(operator string) v1pb.QueryPlanNode_Type {
+	switch {
+	case strings.HasPrefix(operator, "table scan"), strings.HasPrefix(operator, "index scan"), strings.HasPrefix(operator, "covering index scan"):
+		return v1pb.QueryPlanNode_FULL_SCAN
+	case strings.Contains(operator, "index lookup"), strings.Contains(operator, "index range scan"), strings.HasPrefix(operator, "index skip scan"), strings.HasPrefix(operator, "constant row"):
+		return v1pb.QueryPlanNode_INDEX_SCAN
+	case strings.HasPrefix(operator, "nested loop"):
+		return v1pb.QueryPlanNode_NESTED_LOOP_JOIN
+	case strings.Contains(operator, "hash join"), strings.Contains(operator, "hash semijoin"), strings.Contains(operator, "hash antijoin"):
+		return v1pb.QueryPlanNode_HASH_JOIN
+	case strings.HasPrefix(operator, "sort"):
+		return v1pb.QueryPlanNode_SORT
+	case strings.Contains(operator, "aggregate"):
+		return v1pb.QueryPlanNode_AGGREGATE
+	case strings.HasPrefix(operator, "filter"):
+		return v1pb.QueryPlanNode_FILTER
+	case strings.HasPrefix(operator, "limit"):
+		return v1pb.QueryPlanNode_LIMIT
+	default:
+		return v1pb.QueryPlanNode_OTHER
+	}
+}
@@ -0,0 +1,128 @@
+package queryplan
+
+import (
+	"encoding/json"
+	"strings"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// pgExplain is the result of EXPLAIN (FORMAT JSON) in PostgreSQL.
+type pgExplain struct {
+	Plan          *pgPlanNode `json:"Plan"`
+	PlanningTime  *float64    `json:"Planning Time"`
+	ExecutionTime *float64    `json:"Execution Time"`
+}
+
+type pgPlanNode struct {
+	NodeType         string        `json:"Node Type"`
+	RelationName     string        `json:"Relation Name"`
+	Schema           string        `json:"Schema"`
+	Alias            string        `json:"Alias"`
+	IndexName        string        `json:"Index Name"`
+	JoinType         string        `json:"Join Type"`
+	Strategy         string        `json:"Strategy"`
+	TotalCost        float64       `json:"Total Cost"`
+	PlanRows         float64       `json:"Plan Rows"`
+	ActualRows       *float64      `json:"Actual Rows"`
+	ActualLoops      *float64      `json:"Actual Loops"`
+	ActualTotalTime  *float64      `json:"Actual Total Time"`
+	SharedHitBlocks  *int64        `json:"Shared Hit Blocks"`
+	SharedReadBlocks *int64        `json:"Shared Read Blocks"`
+	IndexCond        string        `json:"Index Cond"`
+	RecheckCond      string        `json:"Recheck Cond"`
+	Filter           string        `json:"Filter"`
+	HashCond         string        `json:"Hash Cond"`
+	MergeCond        string        `json:"Merge Cond"`
+	JoinFilter       string        `json:"Join Filter"`
+	SortKey          []string      `json:"Sort Key"`
+	GroupKey         []string      `json:"Group Key"`
+	Plans            []*pgPlanNode `json:"Plans"`
+}
+
+func parsePostgresPlan(rawPlan string) (*v1pb.QueryPlan, error) {
+	var explains []*pgExplain
+	if err := json.Unmarshal([]byte(rawPlan), &explains); err != nil {
+		return nil, err
+	}
+	plan := &v1pb.QueryPlan{}
+	for _, explain := range explains {
+		if explain.Plan != nil {
+			plan.Nodes = append(plan.Nodes, convertPostgresNode(explain.Plan))
+		}
+		if explain.PlanningTime != nil {
+			plan.PlanningTime = millisecondsToDuration(*explain.PlanningTime)
+		}
+		if explain.ExecutionTime != nil {
+			plan.ExecutionTime = millisecondsToDuration(*explain.ExecutionTime)
+		}
+	}
+	return plan, nil
+}
+
+func convertPostgresNode(pgNode *pgPlanNode) *v1pb.QueryPlanNode {
+	node := &v1pb.QueryPlanNode{
+		Type:             getPostgresNodeType(pgNode.NodeType),
+		Operator:         pgNode.NodeType,
+		Schema:           pgNode.Schema,
+		Table:            pgNode.RelationName,
+		Index:            pgNode.IndexName,
+		EstimatedRows:    pgNode.PlanRows,
+		EstimatedCost:    pgNode.TotalCost,
+		ActualRows:       pgNode.ActualRows,
+		BufferHitBlocks:  pgNode.SharedHitBlocks,
+		BufferReadBlocks: pgNode.SharedReadBlocks,
+	}
+	if pgNode.ActualLoops != nil {
+		loops := int64(*pgNode.ActualLoops)
+		node.Loops = &loops
+		// The actual total time is the average time of the loops.
+		if pgNode.ActualTotalTime != nil {
+			node.ActualTime = millisecondsToDuration(*pgNode.ActualTotalTime * *pgNode.ActualLoops)
+		}
+	}
+
+	var detail detailBuilder
+	if pgNode.Alias != "" && pgNode.Alias != pgNode.RelationName {
+		detail.add("Alias", pgNode.Alias)
+	}
+	detail.add("Join Type", pgNode.JoinType)
+	detail.add("Strategy", pgNode.Strategy)
+	detail.add("Index Cond", pgNode.IndexCond)
+	detail.add("Recheck Cond", pgNode.RecheckCond)
+	detail.add("Hash Cond", pgNode.HashCond)
+	detail.add("Merge Cond", pgNode.MergeCond)
+	detail.add("Join Filter", pgNode.JoinFilter)
+	detail.add("Filter", pgNode.Filter)
+	detail.add("Sort Key", strings.Join(pgNode.SortKey, ", "))
+	detail.add("Group Key", strings.Join(pgNode.GroupKey, ", "))
+	node.Detail = detail.String()
+
+	for _, child := range pgNode.Plans {
+		node.Children = append(node.Children, convertPostgresNode(child))
+	}
+	return node
+}
+
+func getPostgresNodeType(nodeType string) v1pb.QueryPlanNode_Type {
+	switch nodeType {
+	case "Seq Scan", "Parallel Seq Scan":
+		return v1pb.QueryPlanNode_FULL_SCAN
+	case "Index Scan", "Index Only Scan", "Bitmap Heap Scan", "Bitmap Index Scan", "Tid Scan", "Tid Range Scan":
+		return v1pb.QueryPlanNode_INDEX_SCAN
+	case "Nested Loop":
+		return v1pb.QueryPlanNode_NESTED_LOOP_JOIN
+	case "Hash Join":
+		return v1pb.QueryPlanNode_HASH_JOIN
+	case "Merge Join":
+		return v1pb.QueryPlanNode_MERGE_JOIN
+	case "Sort", "Incremental Sort":
+		return v1pb.QueryPlanNode_SORT
+	case "Aggregate", "GroupAggregate", "HashAggregate", "WindowAgg":
+		return v1pb.QueryPlanNode_AGGREGATE
+	case "Limit":
+		return v1pb.QueryPlanNode_LIMIT
+	default:
+		return v1pb.QueryPlanNode_OTHER
+	}
+}
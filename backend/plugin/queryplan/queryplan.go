@@ -0,0 +1,125 @@
+// Package queryplan normalizes the query plans of the databases into a common plan tree and finds the common problems in it.
+package queryplan
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// Parse parses the raw query plan returned by db.QueryPlanExplainer into the normalized plan.
+func Parse(engine storepb.Engine, rawPlan string) (*v1pb.QueryPlan, error) {
+	var plan *v1pb.QueryPlan
+	var err error
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		plan, err = parsePostgresPlan(rawPlan)
+	case storepb.Engine_MYSQL:
+		plan, err = parseMySQLPlan(rawPlan)
+	case storepb.Engine_TIDB:
+		plan, err = parseTiDBPlan(rawPlan)
+	case storepb.Engine_MSSQL:
+		plan, err = parseMSSQLPlan(rawPlan)
+	default:
+		return nil, errors.Errorf("query plan of %s is not supported", engine)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse query plan")
+	}
+	assignNodeIDs(plan)
+	return plan, nil
+}
+
+// IsEngineSupported returns true if the query plan of the engine can be parsed.
+func IsEngineSupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MSSQL:
+		return true
+	default:
+		return false
+	}
+}
+
+// assignNodeIDs sets the id of each node to its preorder index.
+func assignNodeIDs(plan *v1pb.QueryPlan) {
+	var id int32
+	var walk func(node *v1pb.QueryPlanNode)
+	walk = func(node *v1pb.QueryPlanNode) {
+		node.Id = id
+		id++
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	for _, node := range plan.Nodes {
+		walk(node)
+	}
+}
+
+func millisecondsToDuration(ms float64) *durationpb.Duration {
+	return durationpb.New(time.Duration(ms * float64(time.Millisecond)))
+}
+
+// flexibleFloat is the number which might be encoded as a JSON string, such as "10000.00".
+type flexibleFloat float64
+
+func (f *flexibleFloat) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" || s == "N/A" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse number %s", string(data))
+	}
+	*f = flexibleFloat(v)
+	return nil
+}
+
+// getNumber returns the number of the decoded JSON value, which might be a string.
+func getNumber(value any) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case string:
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, false
+		}
+		return v, true
+	case json.Number:
+		v, err := value.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func getString(object map[string]any, key string) string {
+	s, _ := object[key].(string)
+	return s
+}
+
+// detailBuilder builds the detail of a node from the named values.
+type detailBuilder []string
+
+func (d *detailBuilder) add(name, value string) {
+	if value != "" {
+		*d = append(*d, name+": "+value)
+	}
+}
+
+func (d *detailBuilder) String() string {
+	return strings.Join(*d, "\n")
+}
@@ -0,0 +1,253 @@
+package queryplan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestParsePostgres(t *testing.T) {
+	a := require.New(t)
+	rawPlan := `[{"Plan": {"Node Type": "Nested Loop", "Join Type": "Inner", "Total Cost": 2500.5, "Plan Rows": 10,
+		"Actual Rows": 50000, "Actual Loops": 1, "Actual Total Time": 120.5, "Plans": [
+			{"Node Type": "Seq Scan", "Relation Name": "orders", "Schema": "public", "Alias": "o", "Total Cost": 1800, "Plan Rows": 10,
+				"Actual Rows": 50000, "Actual Loops": 1, "Actual Total Time": 40, "Shared Hit Blocks": 100, "Shared Read Blocks": 20,
+				"Filter": "(status = 'paid'::text)"},
+			{"Node Type": "Index Scan", "Relation Name": "customers", "Schema": "public", "Index Name": "customers_pkey", "Total Cost": 0.3,
+				"Plan Rows": 1, "Actual Rows": 1, "Actual Loops": 50000, "Actual Total Time": 0.001, "Index Cond": "(id = o.customer_id)"}
+		]},
+		"Planning Time": 0.2, "Execution Time": 121}]`
+	plan, err := Parse(storepb.Engine_POSTGRES, rawPlan)
+	a.NoError(err)
+	a.Len(plan.Nodes, 1)
+	a.Equal(121*time.Millisecond, plan.ExecutionTime.AsDuration())
+
+	join := plan.Nodes[0]
+	a.Equal(v1pb.QueryPlanNode_NESTED_LOOP_JOIN, join.Type)
+	a.Equal("Join Type: Inner", join.Detail)
+	a.Len(join.Children, 2)
+
+	scan := join.Children[0]
+	a.Equal(int32(1), scan.Id)
+	a.Equal(v1pb.QueryPlanNode_FULL_SCAN, scan.Type)
+	a.Equal("public", scan.Schema)
+	a.Equal("orders", scan.Table)
+	a.Equal(float64(50000), scan.GetActualRows())
+	a.Equal(int64(100), scan.GetBufferHitBlocks())
+	a.Equal(int64(20), scan.GetBufferReadBlocks())
+	a.Equal("Alias: o\nFilter: (status = 'paid'::text)", scan.Detail)
+
+	lookup := join.Children[1]
+	a.Equal(int32(2), lookup.Id)
+	a.Equal(v1pb.QueryPlanNode_INDEX_SCAN, lookup.Type)
+	a.Equal("customers_pkey", lookup.Index)
+	a.Equal(int64(50000), lookup.GetLoops())
+	a.Equal(50*time.Millisecond, lookup.ActualTime.AsDuration())
+}
+
+func TestParseMySQL(t *testing.T) {
+	a := require.New(t)
+	rawPlan := `{"query_block": {"select_id": 1, "cost_info": {"query_cost": "120.50"},
+		"ordering_operation": {"using_filesort": true, "nested_loop": [
+			{"table": {"table_name": "o", "access_type": "ALL", "rows_examined_per_scan": 1000, "filtered": "10.00",
+				"cost_info": {"prefix_cost": "100.00"}, "attached_condition": "(o.status = 'paid')"}},
+			{"table": {"table_name": "c", "access_type": "eq_ref", "key": "PRIMARY", "possible_keys": ["PRIMARY"],
+				"ref": ["db.o.customer_id"], "rows_examined_per_scan": 1, "filtered": "100.00", "cost_info": {"prefix_cost": "120.50"}}}
+		]}}}`
+	plan, err := Parse(storepb.Engine_MYSQL, rawPlan)
+	a.NoError(err)
+	a.Len(plan.Nodes, 1)
+
+	queryBlock := plan.Nodes[0]
+	a.Equal(120.5, queryBlock.EstimatedCost)
+	a.Len(queryBlock.Children, 1)
+	sort := queryBlock.Children[0]
+	a.Equal(v1pb.QueryPlanNode_SORT, sort.Type)
+	a.Len(sort.Children, 1)
+	join := sort.Children[0]
+	a.Equal(v1pb.QueryPlanNode_NESTED_LOOP_JOIN, join.Type)
+	a.Equal(120.5, join.EstimatedCost)
+	a.Len(join.Children, 2)
+
+	scan := join.Children[0]
+	a.Equal(v1pb.QueryPlanNode_FULL_SCAN, scan.Type)
+	a.Equal("o", scan.Table)
+	a.Equal(float64(100), scan.EstimatedRows)
+	a.Equal("rows_examined_per_scan: 1000\nattached_condition: (o.status = 'paid')", scan.Detail)
+	lookup := join.Children[1]
+	a.Equal(v1pb.QueryPlanNode_INDEX_SCAN, lookup.Type)
+	a.Equal("PRIMARY", lookup.Index)
+	a.Equal(int32(4), lookup.Id)
+}
+
+func TestParseMySQLTree(t *testing.T) {
+	a := require.New(t)
+	rawPlan := `-> Limit: 10 row(s)  (cost=3.50 rows=10) (actual time=0.050..0.060 rows=10 loops=1)
+    -> Nested loop inner join  (cost=3.50 rows=10) (actual time=0.048..0.058 rows=10 loops=1)
+        -> Filter: (t1.a = 1)  (cost=1.25 rows=1) (actual time=0.040..0.045 rows=10 loops=1)
+            -> Table scan on t1  (cost=1.25 rows=10) (actual time=0.033..0.040 rows=10 loops=1)
+        -> Index lookup on t2 using idx_a (a=t1.a)  (cost=0.26 rows=1) (actual time=0.001..0.002 rows=1 loops=10)
+    -> Index lookup on t3 using idx_b (b=1)  (cost=0.35 rows=1) (never executed)
+`
+	plan, err := Parse(storepb.Engine_MYSQL, rawPlan)
+	a.NoError(err)
+	a.Len(plan.Nodes, 1)
+
+	limit := plan.Nodes[0]
+	a.Equal(v1pb.QueryPlanNode_LIMIT, limit.Type)
+	a.Equal("Limit", limit.Operator)
+	a.Len(limit.Children, 2)
+
+	join := limit.Children[0]
+	a.Equal(v1pb.QueryPlanNode_NESTED_LOOP_JOIN, join.Type)
+	a.Len(join.Children, 2)
+	filter := join.Children[0]
+	a.Equal(v1pb.QueryPlanNode_FILTER, filter.Type)
+	a.Len(filter.Children, 1)
+	scan := filter.Children[0]
+	a.Equal(v1pb.QueryPlanNode_FULL_SCAN, scan.Type)
+	a.Equal("t1", scan.Table)
+	a.Equal(1.25, scan.EstimatedCost)
+	a.Equal(float64(10), scan.GetActualRows())
+
+	lookup := join.Children[1]
+	a.Equal(v1pb.QueryPlanNode_INDEX_SCAN, lookup.Type)
+	a.Equal("t2", lookup.Table)
+	a.Equal("idx_a", lookup.Index)
+	a.Equal(int64(10), lookup.GetLoops())
+	a.Equal(20*time.Microsecond, lookup.ActualTime.AsDuration())
+
+	neverExecuted := limit.Children[1]
+	a.Equal("t3", neverExecuted.Table)
+	a.Equal(int64(0), neverExecuted.GetLoops())
+}
+
+func TestParseTiDB(t *testing.T) {
+	a := require.New(t)
+	rawPlan := `[{"id": "IndexJoin_12", "estRows": "12.50", "actRows": "20000", "taskType": "root",
+		"executeInfo": "time:15.2ms, loops:21", "operatorInfo": "inner join, inner:IndexLookUp_11",
+		"subOperators": [
+			{"id": "TableReader_25(Build)", "estRows": "10.00", "actRows": "20000", "taskType": "root", "subOperators": [
+				{"id": "TableFullScan_24", "estRows": "10.00", "actRows": "20000", "taskType": "cop[tikv]",
+					"accessObject": "table:t1", "operatorInfo": "keep order:false, stats:pseudo"}
+			]},
+			{"id": "IndexLookUp_11(Probe)", "estRows": "12.50", "taskType": "root", "subOperators": [
+				{"id": "IndexRangeScan_9(Build)", "estRows": "12.50", "taskType": "cop[tikv]",
+					"accessObject": "table:t2, index:idx_a(a)"}
+			]}
+		]}]`
+	plan, err := Parse(storepb.Engine_TIDB, rawPlan)
+	a.NoError(err)
+	a.Len(plan.Nodes, 1)
+
+	join := plan.Nodes[0]
+	a.Equal(v1pb.QueryPlanNode_NESTED_LOOP_JOIN, join.Type)
+	a.Equal("IndexJoin", join.Operator)
+	a.Equal(12.5, join.EstimatedRows)
+	a.Equal(float64(20000), join.GetActualRows())
+	a.Nil(join.Loops)
+	a.Equal(15200*time.Microsecond, join.ActualTime.AsDuration())
+
+	scan := join.Children[0].Children[0]
+	a.Equal(v1pb.QueryPlanNode_FULL_SCAN, scan.Type)
+	a.Equal("TableFullScan", scan.Operator)
+	a.Equal("t1", scan.Table)
+	a.Equal("Task: cop[tikv]\nOperator Info: keep order:false, stats:pseudo", scan.Detail)
+
+	rangeScan := join.Children[1].Children[0]
+	a.Equal(v1pb.QueryPlanNode_INDEX_SCAN, rangeScan.Type)
+	a.Equal("IndexRangeScan", rangeScan.Operator)
+	a.Equal("t2", rangeScan.Table)
+	a.Equal("idx_a", rangeScan.Index)
+}
+
+func TestParseMSSQL(t *testing.T) {
+	a := require.New(t)
+	rawPlan := `<ShowPlanXML xmlns="http://schemas.microsoft.com/sqlserver/2004/07/showplan" Version="1.5">
+<BatchSequence><Batch><Statements><StmtSimple StatementText="SELECT * FROM orders o JOIN customers c ON o.customer_id = c.id">
+<QueryPlan><QueryTimeStats CpuTime="10" ElapsedTime="12" />
+<RelOp NodeId="0" PhysicalOp="Nested Loops" LogicalOp="Inner Join" EstimateRows="100" EstimatedTotalSubtreeCost="1.5">
+	<RunTimeInformation><RunTimeCountersPerThread Thread="0" ActualRows="100" ActualExecutions="1" ActualElapsedms="11" /></RunTimeInformation>
+	<NestedLoops Optimized="0">
+		<RelOp NodeId="1" PhysicalOp="Clustered Index Scan" LogicalOp="Clustered Index Scan" EstimateRows="100" EstimatedTotalSubtreeCost="1.2">
+			<RunTimeInformation><RunTimeCountersPerThread Thread="0" ActualRows="100" ActualExecutions="1" ActualElapsedms="5" ActualLogicalReads="30" ActualPhysicalReads="10" /></RunTimeInformation>
+			<IndexScan Ordered="0">
+				<Object Database="[db]" Schema="[dbo]" Table="[orders]" Index="[PK_orders]" />
+				<Predicate><ScalarOperator ScalarString="[db].[dbo].[orders].[status]=N'paid'" /></Predicate>
+			</IndexScan>
+		</RelOp>
+		<RelOp NodeId="2" PhysicalOp="Clustered Index Seek" LogicalOp="Clustered Index Seek" EstimateRows="1" EstimatedTotalSubtreeCost="0.3">
+			<RunTimeInformation><RunTimeCountersPerThread Thread="0" ActualRows="100" ActualExecutions="100" ActualElapsedms="2" /></RunTimeInformation>
+			<IndexScan Ordered="1">
+				<Object Database="[db]" Schema="[dbo]" Table="[customers]" Index="[PK_customers]" />
+			</IndexScan>
+		</RelOp>
+	</NestedLoops>
+</RelOp>
+</QueryPlan></StmtSimple></Statements></Batch></BatchSequence></ShowPlanXML>`
+	plan, err := Parse(storepb.Engine_MSSQL, rawPlan)
+	a.NoError(err)
+	a.Len(plan.Nodes, 1)
+	a.Equal(12*time.Millisecond, plan.ExecutionTime.AsDuration())
+
+	join := plan.Nodes[0]
+	a.Equal(v1pb.QueryPlanNode_NESTED_LOOP_JOIN, join.Type)
+	a.Equal("Logical Operation: Inner Join", join.Detail)
+	a.Len(join.Children, 2)
+
+	scan := join.Children[0]
+	a.Equal(v1pb.QueryPlanNode_FULL_SCAN, scan.Type)
+	a.Equal("dbo", scan.Schema)
+	a.Equal("orders", scan.Table)
+	a.Equal("PK_orders", scan.Index)
+	a.Equal(int64(20), scan.GetBufferHitBlocks())
+	a.Equal(int64(10), scan.GetBufferReadBlocks())
+	a.Equal("Predicate: [db].[dbo].[orders].[status]=N'paid'", scan.Detail)
+
+	seek := join.Children[1]
+	a.Equal(v1pb.QueryPlanNode_INDEX_SCAN, seek.Type)
+	a.Equal(float64(1), seek.GetActualRows())
+	a.Equal(int64(100), seek.GetLoops())
+}
+
+func TestParseUnsupportedEngine(t *testing.T) {
+	_, err := Parse(storepb.Engine_ORACLE, "")
+	require.Error(t, err)
+}
+
+func TestAnalyze(t *testing.T) {
+	a := require.New(t)
+	rawPlan := `[{"Plan": {"Node Type": "Nested Loop", "Plan Rows": 100, "Actual Rows": 50000, "Actual Loops": 1, "Plans": [
+		{"Node Type": "Seq Scan", "Relation Name": "orders", "Schema": "public", "Plan Rows": 100, "Actual Rows": 50000, "Actual Loops": 1},
+		{"Node Type": "Seq Scan", "Relation Name": "customers", "Schema": "public", "Plan Rows": 1, "Actual Rows": 1, "Actual Loops": 50000}
+	]}}]`
+	plan, err := Parse(storepb.Engine_POSTGRES, rawPlan)
+	a.NoError(err)
+	tableRows := map[string]int64{
+		"orders":    1000000,
+		"customers": 1000,
+	}
+	findings := Analyze(plan, func(_, table string) int64 {
+		return tableRows[table]
+	})
+
+	type finding struct {
+		findingType v1pb.QueryPlanFinding_Type
+		status      v1pb.Advice_Status
+		nodeID      int32
+	}
+	var got []finding
+	for _, f := range findings {
+		got = append(got, finding{findingType: f.Type, status: f.Status, nodeID: f.NodeId})
+	}
+	a.Equal([]finding{
+		{findingType: v1pb.QueryPlanFinding_ROW_ESTIMATE_MISMATCH, status: v1pb.Advice_WARNING, nodeID: 0},
+		{findingType: v1pb.QueryPlanFinding_NESTED_LOOP_OVER_LARGE_INPUT, status: v1pb.Advice_ERROR, nodeID: 0},
+		{findingType: v1pb.QueryPlanFinding_FULL_SCAN_ON_LARGE_TABLE, status: v1pb.Advice_WARNING, nodeID: 1},
+		{findingType: v1pb.QueryPlanFinding_ROW_ESTIMATE_MISMATCH, status: v1pb.Advice_WARNING, nodeID: 1},
+	}, got)
+}
@@ -0,0 +1,123 @@
+package queryplan
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	// tidbOperatorIDRegexp matches the operator id, such as "IndexRangeScan_9(Build)".
+	tidbOperatorIDRegexp  = regexp.MustCompile(`^(.*?)_[0-9]+(?:\((\w+)\))?$`)
+	tidbExecuteTimeRegexp = regexp.MustCompile(`(?:^|[\s,{])time:([0-9.]+[a-zµ]+)`)
+)
+
+// tidbPlanNode is the node of EXPLAIN FORMAT = 'tidb_json' in TiDB.
+type tidbPlanNode struct {
+	ID           string          `json:"id"`
+	EstRows      flexibleFloat   `json:"estRows"`
+	ActRows      *flexibleFloat  `json:"actRows"`
+	EstCost      flexibleFloat   `json:"estCost"`
+	TaskType     string          `json:"taskType"`
+	AccessObject string          `json:"accessObject"`
+	ExecuteInfo  string          `json:"executeInfo"`
+	OperatorInfo string          `json:"operatorInfo"`
+	MemoryInfo   string          `json:"memoryInfo"`
+	SubOperators []*tidbPlanNode `json:"subOperators"`
+}
+
+func parseTiDBPlan(rawPlan string) (*v1pb.QueryPlan, error) {
+	var tidbNodes []*tidbPlanNode
+	if err := json.Unmarshal([]byte(rawPlan), &tidbNodes); err != nil {
+		return nil, err
+	}
+	plan := &v1pb.QueryPlan{}
+	for _, tidbNode := range tidbNodes {
+		plan.Nodes = append(plan.Nodes, convertTiDBNode(tidbNode))
+	}
+	return plan, nil
+}
+
+func convertTiDBNode(tidbNode *tidbPlanNode) *v1pb.QueryPlanNode {
+	operator, role := tidbNode.ID, ""
+	if matches := tidbOperatorIDRegexp.FindStringSubmatch(tidbNode.ID); matches != nil {
+		operator, role = matches[1], matches[2]
+	}
+	node := &v1pb.QueryPlanNode{
+		Type:          getTiDBNodeType(operator),
+		Operator:      operator,
+		EstimatedRows: float64(tidbNode.EstRows),
+		EstimatedCost: float64(tidbNode.EstCost),
+	}
+	// The access object is like "table:t, index:idx_a(a)".
+	for _, item := range strings.Split(tidbNode.AccessObject, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "table":
+			node.Table = value
+		case "index":
+			if i := strings.Index(value, "("); i >= 0 {
+				value = value[:i]
+			}
+			node.Index = value
+		}
+	}
+	// The actual rows of TiDB are the total rows of all loops as the estimated rows.
+	// The loops in the execute info are the number of the batches, so they're not reported.
+	if tidbNode.ActRows != nil {
+		actualRows := float64(*tidbNode.ActRows)
+		node.ActualRows = &actualRows
+	}
+	if matches := tidbExecuteTimeRegexp.FindStringSubmatch(tidbNode.ExecuteInfo); matches != nil {
+		if d, err := time.ParseDuration(matches[1]); err == nil {
+			node.ActualTime = durationpb.New(d)
+		}
+	}
+
+	var detail detailBuilder
+	detail.add("Role", role)
+	detail.add("Task", tidbNode.TaskType)
+	detail.add("Operator Info", tidbNode.OperatorInfo)
+	detail.add("Execute Info", tidbNode.ExecuteInfo)
+	detail.add("Memory", tidbNode.MemoryInfo)
+	node.Detail = detail.String()
+
+	for _, child := range tidbNode.SubOperators {
+		node.Children = append(node.Children, convertTiDBNode(child))
+	}
+	return node
+}
+
+func getTiDBNodeType(operator string) v1pb.QueryPlanNode_Type {
+	switch operator {
+	case "TableFullScan", "IndexFullScan":
+		return v1pb.QueryPlanNode_FULL_SCAN
+	case "TableRangeScan", "TableRowIDScan", "IndexRangeScan", "IndexLookUp", "IndexMerge", "PointGet", "BatchPointGet":
+		return v1pb.QueryPlanNode_INDEX_SCAN
+	// The index joins look up the inner table for the batches of the outer rows.
+	case "IndexJoin", "IndexHashJoin", "IndexMergeJoin", "Apply":
+		return v1pb.QueryPlanNode_NESTED_LOOP_JOIN
+	case "HashJoin":
+		return v1pb.QueryPlanNode_HASH_JOIN
+	case "MergeJoin":
+		return v1pb.QueryPlanNode_MERGE_JOIN
+	case "Sort", "TopN":
+		return v1pb.QueryPlanNode_SORT
+	case "HashAgg", "StreamAgg":
+		return v1pb.QueryPlanNode_AGGREGATE
+	case "Selection":
+		return v1pb.QueryPlanNode_FILTER
+	case "Limit":
+		return v1pb.QueryPlanNode_LIMIT
+	default:
+		return v1pb.QueryPlanNode_OTHER
+	}
+}
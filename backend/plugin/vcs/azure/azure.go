@@ -400,6 +400,60 @@ func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, lastMe
 	return p.ListPullRequestFileInCommit(ctx, repositoryID, lastMergeCommitID, 0)
 }
 
+// CompareCommits lists the file diffs from the fromCommit to the toCommit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, fromCommit, toCommit string) ([]*vcs.FileDiff, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("baseVersion", fromCommit)
+	values.Set("baseVersionType", "commit")
+	values.Set("targetVersion", toCommit)
+	values.Set("targetVersionType", "commit")
+	// TODO: GET the diffs pagenated if more than 2000 files are changed.
+	values.Set("$top", "2000")
+	url := fmt.Sprintf("%s/diffs/commits?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	}
+	if code != http.StatusOK {
+		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, body)
+	}
+
+	changes := new(changesResponse)
+	if err := json.Unmarshal([]byte(body), changes); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal body")
+	}
+	var diffs []*vcs.FileDiff
+	for _, change := range changes.Changes {
+		if change.Item == nil || change.Item.GitObjectType != "blob" {
+			continue
+		}
+		// The change type is a comma separated flags, e.g. "edit, rename".
+		diffType := vcs.FileDiffTypeModified
+		switch {
+		case strings.Contains(change.ChangeType, "add"):
+			diffType = vcs.FileDiffTypeAdded
+		case strings.Contains(change.ChangeType, "delete"):
+			diffType = vcs.FileDiffTypeRemoved
+		}
+		diffs = append(diffs, &vcs.FileDiff{
+			Path: change.Item.Path,
+			Type: diffType,
+		})
+	}
+	return diffs, nil
+}
+
 type Comment struct {
 	Content     string `json:"content"`
 	CommentType string `json:"commentType"`
@@ -527,6 +581,333 @@ func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+// PullRequestStatusContext is the API message for the context of Azure DevOps pull request status.
+type PullRequestStatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre"`
+}
+
+// PullRequestStatus is the API message for Azure DevOps pull request status.
+type PullRequestStatus struct {
+	State       string                    `json:"state"`
+	Description string                    `json:"description,omitempty"`
+	TargetURL   string                    `json:"targetUrl,omitempty"`
+	Context     *PullRequestStatusContext `json:"context"`
+	// IterationID binds the status to the pull request iteration, so that it's reset by the later pushes.
+	IterationID int `json:"iterationId,omitempty"`
+}
+
+// PullRequestIteration is the API message for Azure DevOps pull request iteration.
+type PullRequestIteration struct {
+	ID              int                              `json:"id"`
+	SourceRefCommit *PullRequestEventLastMergeCommit `json:"sourceRefCommit"`
+}
+
+// PublishReviewResult publishes the review result as the pull request status
+// of the iteration with the reviewed source commit.
+// The branch policy can require the status to succeed before completing the pull request.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-statuses/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) PublishReviewResult(ctx context.Context, repositoryID, pullRequestID string, result *vcs.ReviewResult) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	iterationID, err := p.getPullRequestIterationID(ctx, apiURL, pullRequestID, result.CommitID)
+	if err != nil {
+		return err
+	}
+
+	state := "succeeded"
+	if result.State == vcs.ReviewStateFailure {
+		state = "failed"
+	}
+	payload, err := json.Marshal(&PullRequestStatus{
+		State:       state,
+		Description: result.Title,
+		TargetURL:   result.DetailsURL,
+		Context: &PullRequestStatusContext{
+			Name:  result.Name,
+			Genre: "bytebase",
+		},
+		IterationID: iterationID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request status")
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/statuses?%s", apiURL, pullRequestID, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code != http.StatusOK && code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request status, code: %v, body: %s", code, body)
+	}
+	return nil
+}
+
+// getPullRequestIterationID returns the latest pull request iteration with the source commit,
+// or 0 if the commit is unknown, which publishes the status for the whole pull request.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-iterations/list?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) getPullRequestIterationID(ctx context.Context, apiURL, pullRequestID, commitID string) (int, error) {
+	if commitID == "" {
+		return 0, nil
+	}
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/pullRequests/%s/iterations?%s", apiURL, pullRequestID, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return 0, errors.Wrapf(err, "GET %s", url)
+	}
+	if code != http.StatusOK {
+		return 0, errors.Errorf("failed to list pull request iterations, code: %v, body: %s", code, body)
+	}
+
+	var resp struct {
+		Value []*PullRequestIteration `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return 0, errors.Wrap(err, "unmarshal pull request iterations")
+	}
+	iterationID := 0
+	for _, iteration := range resp.Value {
+		if iteration.SourceRefCommit != nil && iteration.SourceRefCommit.CommitID == commitID {
+			iterationID = max(iterationID, iteration.ID)
+		}
+	}
+	return iterationID, nil
+}
+
+// emptyObjectID is the object ID to create a new ref in Azure DevOps.
+const emptyObjectID = "0000000000000000000000000000000000000000"
+
+// RefUpdate is the API message for updating the Azure DevOps ref.
+type RefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId,omitempty"`
+}
+
+// CreateBranch creates the branch from the given commit.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/refs/update-refs?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, fromCommit string) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal([]*RefUpdate{
+		{
+			Name:        fmt.Sprintf("refs/heads/%s", branchName),
+			OldObjectID: emptyObjectID,
+			NewObjectID: fromCommit,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal ref update")
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	url := fmt.Sprintf("%s/refs?%s", apiURL, values.Encode())
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("non-200 POST %s status code %d with body %q", url, code, body)
+	}
+	return nil
+}
+
+// PushItem is the API message for the item in the Azure DevOps push change.
+type PushItem struct {
+	Path string `json:"path"`
+}
+
+// PushNewContent is the API message for the new content in the Azure DevOps push change.
+type PushNewContent struct {
+	Content     string `json:"content"`
+	ContentType string `json:"contentType"`
+}
+
+// PushChange is the API message for the change in the Azure DevOps push commit.
+type PushChange struct {
+	// ChangeType is "add" or "edit".
+	ChangeType string          `json:"changeType"`
+	Item       PushItem        `json:"item"`
+	NewContent *PushNewContent `json:"newContent"`
+}
+
+// PushCommit is the API message for the commit in the Azure DevOps push.
+type PushCommit struct {
+	Comment string        `json:"comment"`
+	Changes []*PushChange `json:"changes"`
+}
+
+// PushCreate is the API message for creating the Azure DevOps push.
+type PushCreate struct {
+	RefUpdates []*RefUpdate  `json:"refUpdates"`
+	Commits    []*PushCommit `json:"commits"`
+}
+
+// CommitFile creates or overwrites the file on the branch with a new commit.
+// The push requires the current commit of the branch and whether the file exists.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pushes/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) CommitFile(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return err
+	}
+	branch, err := p.GetBranch(ctx, repositoryID, commit.Branch)
+	if err != nil {
+		return err
+	}
+	filePath := fmt.Sprintf("/%s", strings.TrimPrefix(commit.Path, "/"))
+	pushValues := &url.Values{}
+	pushValues.Set("api-version", "7.0")
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("path", filePath)
+	values.Set("versionDescriptor.versionType", "branch")
+	values.Set("versionDescriptor.version", commit.Branch)
+	url := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", url)
+	}
+	changeType := "edit"
+	switch {
+	case code == http.StatusNotFound:
+		changeType = "add"
+	case code >= 300:
+		return errors.Errorf("non-200 GET %s status code %d with body %q", url, code, body)
+	}
+
+	payload, err := json.Marshal(&PushCreate{
+		RefUpdates: []*RefUpdate{
+			{
+				Name:        fmt.Sprintf("refs/heads/%s", commit.Branch),
+				OldObjectID: branch.LastCommitID,
+			},
+		},
+		Commits: []*PushCommit{
+			{
+				Comment: commit.Message,
+				Changes: []*PushChange{
+					{
+						ChangeType: changeType,
+						Item:       PushItem{Path: filePath},
+						NewContent: &PushNewContent{Content: commit.Content, ContentType: "rawtext"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal push create")
+	}
+	url = fmt.Sprintf("%s/pushes?%s", apiURL, pushValues.Encode())
+	code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("non-200 POST %s status code %d with body %q", url, code, body)
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating the Azure DevOps pull request.
+type PullRequestCreate struct {
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+}
+
+// PullRequestList is the API message for listing the Azure DevOps pull requests.
+type PullRequestList struct {
+	Value []*PullRequestResource `json:"value"`
+}
+
+// UpsertPullRequest returns the active pull request from the source branch to the target branch,
+// or creates one if it doesn't exist.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/get-pull-requests?view=azure-devops-rest-7.0&tabs=HTTP
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create?view=azure-devops-rest-7.0&tabs=HTTP
+func (p *Provider) UpsertPullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	organizationName, projectName, repoID, err := getAzureRepositoryIDs(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	getPullRequest := func(pr *PullRequestResource) *vcs.PullRequest {
+		return &vcs.PullRequest{
+			ID:  fmt.Sprintf("%d", pr.PullRequestID),
+			URL: fmt.Sprintf("%s/%s/%s/_git/%s/pullrequest/%d", p.instanceURL, organizationName, projectName, repoID, pr.PullRequestID),
+		}
+	}
+	sourceRefName := fmt.Sprintf("refs/heads/%s", create.SourceBranch)
+	targetRefName := fmt.Sprintf("refs/heads/%s", create.TargetBranch)
+	createValues := &url.Values{}
+	createValues.Set("api-version", "7.0")
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("searchCriteria.status", "active")
+	values.Set("searchCriteria.sourceRefName", sourceRefName)
+	values.Set("searchCriteria.targetRefName", targetRefName)
+	url := fmt.Sprintf("%s/pullrequests?%s", apiURL, values.Encode())
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, body)
+	}
+	prs := new(PullRequestList)
+	if err := json.Unmarshal([]byte(body), prs); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull requests")
+	}
+	if len(prs.Value) > 0 {
+		return getPullRequest(prs.Value[0]), nil
+	}
+
+	payload, err := json.Marshal(&PullRequestCreate{
+		SourceRefName: sourceRefName,
+		TargetRefName: targetRefName,
+		Title:         create.Title,
+		Description:   create.Description,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+	url = fmt.Sprintf("%s/pullrequests?%s", apiURL, createValues.Encode())
+	code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("non-200 POST %s status code %d with body %q", url, code, body)
+	}
+	pr := new(PullRequestResource)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request")
+	}
+	return getPullRequest(pr), nil
+}
+
 // CreateWebhook creates a webhook in the organization, and returns the webhook ID which can be used in PatchWebhook.
 // API Version 7.0 do not specify the OAuth scope for creating webhook explicitly, but it works.
 //
@@ -0,0 +1,100 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+const testRepositoryPath = "/org/project/_apis/git/repositories/repo"
+
+func newTestProvider(t *testing.T, handler http.Handler) vcs.Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{InstanceURL: server.URL, AuthToken: "test-token"})
+}
+
+func TestPublishReviewResult(t *testing.T) {
+	a := require.New(t)
+	var statuses []*PullRequestStatus
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+testRepositoryPath+"/pullRequests/7/iterations", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "7.0", r.URL.Query().Get("api-version"))
+		_, _ = w.Write([]byte(`{"count": 3, "value": [
+			{"id": 1, "sourceRefCommit": {"commitId": "oldsha"}},
+			{"id": 2, "sourceRefCommit": {"commitId": "sourcesha"}},
+			{"id": 3, "sourceRefCommit": {"commitId": "newsha"}}
+		]}`))
+	})
+	mux.HandleFunc("POST "+testRepositoryPath+"/pullRequests/7/statuses", func(w http.ResponseWriter, r *http.Request) {
+		// The personal access token is sent with the basic authentication.
+		_, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "test-token", password)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		status := &PullRequestStatus{}
+		assert.NoError(t, json.Unmarshal(body, status))
+		statuses = append(statuses, status)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	})
+	p := newTestProvider(t, mux)
+	ctx := context.Background()
+
+	a.NoError(p.PublishReviewResult(ctx, "org/project/repo", "7", &vcs.ReviewResult{
+		Name:       "Bytebase SQL Review",
+		CommitID:   "sourcesha",
+		State:      vcs.ReviewStateFailure,
+		Title:      "1 error, 0 warnings",
+		DetailsURL: "https://bytebase.example.com/plans/1",
+	}))
+	// The status of the unknown commit is published for the whole pull request.
+	a.NoError(p.PublishReviewResult(ctx, "org/project/repo", "7", &vcs.ReviewResult{
+		Name:     "Bytebase SQL Review",
+		CommitID: "unknownsha",
+		State:    vcs.ReviewStateSuccess,
+	}))
+	a.Equal([]*PullRequestStatus{
+		{
+			State:       "failed",
+			Description: "1 error, 0 warnings",
+			TargetURL:   "https://bytebase.example.com/plans/1",
+			Context:     &PullRequestStatusContext{Name: "Bytebase SQL Review", Genre: "bytebase"},
+			IterationID: 2,
+		},
+		{
+			State:   "succeeded",
+			Context: &PullRequestStatusContext{Name: "Bytebase SQL Review", Genre: "bytebase"},
+		},
+	}, statuses)
+
+	a.Error(p.PublishReviewResult(ctx, "org/project/missing", "7", &vcs.ReviewResult{CommitID: "sourcesha"}))
+	a.Error(p.PublishReviewResult(ctx, "repo", "7", &vcs.ReviewResult{}))
+}
+
+func TestPullRequestEventSourceCommit(t *testing.T) {
+	a := require.New(t)
+	event := &PullRequestEvent{}
+	a.NoError(json.Unmarshal([]byte(`{
+		"eventType": "git.pullrequest.updated",
+		"resource": {
+			"pullRequestId": 7,
+			"lastMergeCommit": {"commitId": "mergesha"},
+			"lastMergeSourceCommit": {"commitId": "sourcesha"}
+		}
+	}`), event))
+	a.Equal(PullRequestEventUpdated, event.EventType)
+	// The review result is published for the source commit rather than the merge commit.
+	a.Equal("sourcesha", event.Resource.LastMergeSourceCommit.CommitID)
+	a.Equal("mergesha", event.Resource.LastMergeCommit.CommitID)
+}
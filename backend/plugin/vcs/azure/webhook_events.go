@@ -33,7 +33,9 @@ type PullRequestResource struct {
 	// PR merge status, we only care the "succeeded".
 	MergeStatus     string                           `json:"mergeStatus"`
 	LastMergeCommit *PullRequestEventLastMergeCommit `json:"lastMergeCommit"`
-	CreatedBy       *PullRequestCreatedBy            `json:"createdBy"`
+	// LastMergeSourceCommit is the head commit of the source branch.
+	LastMergeSourceCommit *PullRequestEventLastMergeCommit `json:"lastMergeSourceCommit"`
+	CreatedBy             *PullRequestCreatedBy            `json:"createdBy"`
 }
 
 type PullRequestEventType string
@@ -89,3 +91,32 @@ type WebhookCreateOrUpdate struct {
 	PublisherID      string                       `json:"publisherId"`
 	PublisherInputs  WebhookCreatePublisherInputs `json:"publisherInputs"`
 }
+
+// PushEventType is the event type for code pushed.
+const PushEventType = "git.push"
+
+// PushRefUpdate is the API message for the ref updated by push.
+type PushRefUpdate struct {
+	// The full git ref, e.g. refs/heads/main or refs/tags/v1.0.0.
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId"`
+}
+
+// PushResource is the API message for push.
+type PushResource struct {
+	Repository *Repository           `json:"repository"`
+	RefUpdates []*PushRefUpdate      `json:"refUpdates"`
+	PushedBy   *PullRequestCreatedBy `json:"pushedBy"`
+	URL        string                `json:"url"`
+}
+
+// PushEvent is the API message for push webhook event.
+//
+// Docs: https://learn.microsoft.com/en-us/azure/devops/service-hooks/events?view=azure-devops#code-pushed
+type PushEvent struct {
+	// ID is the webhook message id.
+	ID        string        `json:"id"`
+	EventType string        `json:"eventType"`
+	Resource  *PushResource `json:"resource"`
+}
@@ -88,6 +88,7 @@ type CommitDiffStat struct {
 	// The status of the diff stat object, possible values are "added", "removed",
 	// "modified", "renamed".
 	Status string     `json:"status"`
+	Old    CommitFile `json:"old"`
 	New    CommitFile `json:"new"`
 }
 
@@ -240,6 +241,8 @@ type Target struct {
 
 // Branch is the API message for Bitbucket Cloud branch.
 type Branch struct {
+	// Type is "branch" or "tag" in the webhook push change.
+	Type   string `json:"type"`
 	Name   string `json:"name"`
 	Target Target `json:"target"`
 }
@@ -321,6 +324,37 @@ func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRe
 	return files, nil
 }
 
+// CompareCommits lists the file diffs from the fromCommit to the toCommit.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commits/#api-repositories-workspace-repo-slug-diffstat-spec-get
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, fromCommit, toCommit string) ([]*vcs.FileDiff, error) {
+	var bbcDiffs []*CommitDiffStat
+	// The spec "{to}..{from}" is the diff of the to commit against the from commit.
+	next := fmt.Sprintf("%s/repositories/%s/diffstat/%s..%s?pagelen=%d", p.APIURL(p.instanceURL), repositoryID, toCommit, fromCommit, apiPageSize)
+	for next != "" {
+		var err error
+		var diffs []*CommitDiffStat
+		diffs, next, err = p.fetchPaginatedDiffFileList(ctx, next)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		bbcDiffs = append(bbcDiffs, diffs...)
+	}
+
+	var diffs []*vcs.FileDiff
+	for _, d := range bbcDiffs {
+		switch d.Status {
+		case "added":
+			diffs = append(diffs, &vcs.FileDiff{Path: d.New.Path, Type: vcs.FileDiffTypeAdded})
+		case "removed":
+			diffs = append(diffs, &vcs.FileDiff{Path: d.Old.Path, Type: vcs.FileDiffTypeRemoved})
+		default:
+			diffs = append(diffs, &vcs.FileDiff{Path: d.New.Path, Type: vcs.FileDiffTypeModified})
+		}
+	}
+	return diffs, nil
+}
+
 type Comment struct {
 	ID      int64          `json:"id,omitempty"`
 	Content CommentContent `json:"content"`
@@ -431,6 +465,139 @@ func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, p
 	return nil
 }
 
+const (
+	// reportAnnotationBatchSize is the maximum number of annotations in one report annotations request.
+	reportAnnotationBatchSize = 100
+	// reportAnnotationLimit is the maximum number of annotations of one report.
+	reportAnnotationLimit = 1000
+)
+
+// BuildStatus is the API message for Bitbucket Cloud commit build status.
+type BuildStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// Report is the API message for Bitbucket Cloud commit report.
+type Report struct {
+	Title      string `json:"title"`
+	Details    string `json:"details"`
+	ReportType string `json:"report_type"`
+	Reporter   string `json:"reporter"`
+	Result     string `json:"result"`
+	Link       string `json:"link,omitempty"`
+}
+
+// ReportAnnotation is the API message for Bitbucket Cloud commit report annotation.
+type ReportAnnotation struct {
+	ExternalID     string `json:"external_id"`
+	AnnotationType string `json:"annotation_type"`
+	Path           string `json:"path"`
+	Line           int    `json:"line,omitempty"`
+	Summary        string `json:"summary"`
+	Details        string `json:"details,omitempty"`
+	Severity       string `json:"severity"`
+}
+
+// PublishReviewResult publishes the review result as the build status of the pull request head commit,
+// and a code insights report with the annotations.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commit-statuses/#api-repositories-workspace-repo-slug-commit-commit-statuses-build-post
+func (p *Provider) PublishReviewResult(ctx context.Context, repositoryID, _ string, result *vcs.ReviewResult) error {
+	key := getReviewKey(result.Name)
+	state, reportResult := "SUCCESSFUL", "PASSED"
+	if result.State == vcs.ReviewStateFailure {
+		state, reportResult = "FAILED", "FAILED"
+	}
+	commitURL := fmt.Sprintf("%s/repositories/%s/commit/%s", p.APIURL(p.instanceURL), repositoryID, result.CommitID)
+
+	if err := p.send(ctx, internal.Post, fmt.Sprintf("%s/statuses/build", commitURL), &BuildStatus{
+		Key:         key,
+		State:       state,
+		Name:        result.Name,
+		URL:         result.DetailsURL,
+		Description: result.Title,
+	}, "create build status"); err != nil {
+		return err
+	}
+
+	// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-put
+	reportURL := fmt.Sprintf("%s/reports/%s", commitURL, key)
+	if err := p.send(ctx, internal.Put, reportURL, &Report{
+		Title:      result.Name,
+		Details:    result.Summary,
+		ReportType: "BUG",
+		Reporter:   "Bytebase",
+		Result:     reportResult,
+		Link:       result.DetailsURL,
+	}, "create report"); err != nil {
+		return err
+	}
+
+	var annotations []*ReportAnnotation
+	for i, annotation := range result.Annotations[:min(len(result.Annotations), reportAnnotationLimit)] {
+		annotations = append(annotations, &ReportAnnotation{
+			ExternalID:     fmt.Sprintf("%s-%d", key, i+1),
+			AnnotationType: "CODE_SMELL",
+			Path:           annotation.Path,
+			Line:           annotation.Line,
+			Summary:        annotation.Title,
+			Details:        annotation.Message,
+			Severity:       getAnnotationSeverity(annotation.Level),
+		})
+	}
+	// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-reports/#api-repositories-workspace-repo-slug-commit-commit-reports-reportid-annotations-post
+	for i := 0; i < len(annotations); i += reportAnnotationBatchSize {
+		batch := annotations[i:min(len(annotations), i+reportAnnotationBatchSize)]
+		if err := p.send(ctx, internal.Post, fmt.Sprintf("%s/annotations", reportURL), batch, "create report annotations"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Provider) send(ctx context.Context, send func(context.Context, string, string, []byte) (int, string, error), url string, message any, action string) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal request body to %s", action)
+	}
+	code, body, err := send(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "%s through URL %s", action, url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to %s through URL %s", action, url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to %s through URL %s, status code: %d, body: %s",
+			action,
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// getReviewKey returns the build status key and report ID for the review name,
+// e.g. "Bytebase SQL Review" becomes "bytebase-sql-review".
+func getReviewKey(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+}
+
+func getAnnotationSeverity(level vcs.ReviewAnnotationLevel) string {
+	switch level {
+	case vcs.ReviewAnnotationLevelFailure:
+		return "HIGH"
+	case vcs.ReviewAnnotationLevelWarning:
+		return "MEDIUM"
+	default:
+		return "LOW"
+	}
+}
+
 // Link is the API message for link.
 type Link struct {
 	Href string `json:"href"`
@@ -499,6 +666,140 @@ type Webhook struct {
 	UUID string `json:"uuid"`
 }
 
+// BranchCreate is the API message for creating a Bitbucket Cloud branch.
+type BranchCreate struct {
+	Name   string `json:"name"`
+	Target Target `json:"target"`
+}
+
+// CreateBranch creates the branch from the given commit.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/#api-repositories-workspace-repo-slug-refs-branches-post
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, fromCommit string) error {
+	payload, err := json.Marshal(&BranchCreate{
+		Name:   branchName,
+		Target: Target{Hash: fromCommit},
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+	url := fmt.Sprintf("%s/repositories/%s/refs/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitFile creates or overwrites the file on the branch with a new commit.
+// The form field name is the file path and the value is the file content.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-post
+func (p *Provider) CommitFile(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	form := url.Values{}
+	form.Set(commit.Path, commit.Content)
+	form.Set("message", commit.Message)
+	form.Set("branch", commit.Branch)
+	url := fmt.Sprintf("%s/repositories/%s/src", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.PostWithHeader(ctx, url, p.getAuthorization(),
+		map[string]string{
+			"Content-Type": "application/x-www-form-urlencoded",
+		},
+		[]byte(form.Encode()),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestBranch is the API message for the source or destination of Bitbucket Cloud pull request.
+type PullRequestBranch struct {
+	Branch EventBranchName `json:"branch"`
+}
+
+// PullRequestCreate is the API message for creating a Bitbucket Cloud pull request.
+type PullRequestCreate struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Source      PullRequestBranch `json:"source"`
+	Destination PullRequestBranch `json:"destination"`
+}
+
+// PullRequestList is the API message for listing Bitbucket Cloud pull requests.
+type PullRequestList struct {
+	Values []*EventPullRequest `json:"values"`
+}
+
+// UpsertPullRequest returns the open pull request from the source branch to the target branch,
+// or creates one if it doesn't exist.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-get
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-pullrequests-post
+func (p *Provider) UpsertPullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	query := fmt.Sprintf(`source.branch.name="%s" AND destination.branch.name="%s"`, create.SourceBranch, create.TargetBranch)
+	url := fmt.Sprintf("%s/repositories/%s/pullrequests?state=OPEN&q=%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(query))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	prs := new(PullRequestList)
+	if err := json.Unmarshal([]byte(body), prs); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull requests")
+	}
+	if len(prs.Values) > 0 {
+		return &vcs.PullRequest{ID: strconv.Itoa(prs.Values[0].ID), URL: prs.Values[0].Links.HTML.Href}, nil
+	}
+
+	payload, err := json.Marshal(&PullRequestCreate{
+		Title:       create.Title,
+		Description: create.Description,
+		Source:      PullRequestBranch{Branch: EventBranchName{Name: create.SourceBranch}},
+		Destination: PullRequestBranch{Branch: EventBranchName{Name: create.TargetBranch}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+	url = fmt.Sprintf("%s/repositories/%s/pullrequests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	pr := new(EventPullRequest)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request")
+	}
+	return &vcs.PullRequest{ID: strconv.Itoa(pr.ID), URL: pr.Links.HTML.Href}, nil
+}
+
 // CreateWebhook creates a webhook in the repository with given payload.
 //
 // Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/#api-repositories-workspace-repo-slug-hooks-post
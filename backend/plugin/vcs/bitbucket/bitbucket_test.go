@@ -0,0 +1,149 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+const testCommitPath = "/2.0/repositories/workspace/db/commit/headsha"
+
+func newTestProvider(t *testing.T, handler http.Handler) vcs.Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{InstanceURL: server.URL, AuthToken: "user:app-password"})
+}
+
+// decodeRequestBody decodes the JSON request body in the handler.
+// The handlers run in the server goroutines, so they report the failures with assert rather than require.
+func decodeRequestBody(t *testing.T, w http.ResponseWriter, r *http.Request, v any) bool {
+	body, err := io.ReadAll(r.Body)
+	if !assert.NoError(t, err) || !assert.NoError(t, json.Unmarshal(body, v)) {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func TestPublishReviewResult(t *testing.T) {
+	a := require.New(t)
+	var status *BuildStatus
+	var report *Report
+	var batches [][]*ReportAnnotation
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+testCommitPath+"/statuses/build", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:app-password")), r.Header.Get("Authorization"))
+		status = &BuildStatus{}
+		if !decodeRequestBody(t, w, r, status) {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("PUT "+testCommitPath+"/reports/bytebase-sql-review", func(w http.ResponseWriter, r *http.Request) {
+		report = &Report{}
+		if !decodeRequestBody(t, w, r, report) {
+			return
+		}
+	})
+	mux.HandleFunc("POST "+testCommitPath+"/reports/bytebase-sql-review/annotations", func(w http.ResponseWriter, r *http.Request) {
+		var batch []*ReportAnnotation
+		if !decodeRequestBody(t, w, r, &batch) {
+			return
+		}
+		batches = append(batches, batch)
+	})
+	p := newTestProvider(t, mux)
+
+	annotations := []*vcs.ReviewAnnotation{
+		{Path: "migrations/0001_init.sql", Line: 1, Level: vcs.ReviewAnnotationLevelFailure, Title: "statement-syntax-error", Message: "Syntax error"},
+		{Path: "migrations/0001_init.sql", Line: 2, Level: vcs.ReviewAnnotationLevelWarning, Title: "column-no-null"},
+	}
+	for i := len(annotations); i < reportAnnotationLimit+50; i++ {
+		annotations = append(annotations, &vcs.ReviewAnnotation{
+			Path:  "migrations/0002_add.sql",
+			Line:  i,
+			Level: vcs.ReviewAnnotationLevelNotice,
+			Title: fmt.Sprintf("rule %d", i),
+		})
+	}
+	a.NoError(p.PublishReviewResult(context.Background(), "workspace/db", "7", &vcs.ReviewResult{
+		Name:        "Bytebase SQL Review",
+		CommitID:    "headsha",
+		State:       vcs.ReviewStateFailure,
+		Title:       "1 error, 1049 warnings",
+		Summary:     "See the details in Bytebase",
+		DetailsURL:  "https://bytebase.example.com/plans/1",
+		Annotations: annotations,
+	}))
+
+	a.Equal(&BuildStatus{
+		Key:         "bytebase-sql-review",
+		State:       "FAILED",
+		Name:        "Bytebase SQL Review",
+		URL:         "https://bytebase.example.com/plans/1",
+		Description: "1 error, 1049 warnings",
+	}, status)
+	a.Equal(&Report{
+		Title:      "Bytebase SQL Review",
+		Details:    "See the details in Bytebase",
+		ReportType: "BUG",
+		Reporter:   "Bytebase",
+		Result:     "FAILED",
+		Link:       "https://bytebase.example.com/plans/1",
+	}, report)
+
+	// The annotations are posted in batches up to the report limit.
+	a.Len(batches, reportAnnotationLimit/reportAnnotationBatchSize)
+	total := 0
+	for _, batch := range batches {
+		a.LessOrEqual(len(batch), reportAnnotationBatchSize)
+		total += len(batch)
+	}
+	a.Equal(reportAnnotationLimit, total)
+	a.Equal(&ReportAnnotation{
+		ExternalID:     "bytebase-sql-review-1",
+		AnnotationType: "CODE_SMELL",
+		Path:           "migrations/0001_init.sql",
+		Line:           1,
+		Summary:        "statement-syntax-error",
+		Details:        "Syntax error",
+		Severity:       "HIGH",
+	}, batches[0][0])
+	a.Equal("MEDIUM", batches[0][1].Severity)
+	a.Equal("LOW", batches[0][2].Severity)
+	a.Equal(fmt.Sprintf("bytebase-sql-review-%d", reportAnnotationLimit), batches[len(batches)-1][reportAnnotationBatchSize-1].ExternalID)
+}
+
+func TestPublishReviewResultError(t *testing.T) {
+	a := require.New(t)
+	reported := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+testCommitPath+"/statuses/build", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error": {"message": "Access denied"}}`))
+	})
+	mux.HandleFunc("PUT "+testCommitPath+"/reports/bytebase-sql-review", func(_ http.ResponseWriter, _ *http.Request) {
+		reported = true
+	})
+	p := newTestProvider(t, mux)
+
+	err := p.PublishReviewResult(context.Background(), "workspace/db", "7", &vcs.ReviewResult{Name: "Bytebase SQL Review", CommitID: "headsha"})
+	a.ErrorContains(err, "Access denied")
+	a.False(reported)
+}
+
+func TestGetReviewKey(t *testing.T) {
+	a := require.New(t)
+	a.Equal("bytebase-sql-review", getReviewKey(" Bytebase SQL Review "))
+}
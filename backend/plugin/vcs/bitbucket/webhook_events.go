@@ -49,3 +49,7 @@ type EventLinks struct {
 type EventHTML struct {
 	Href string `json:"href"`
 }
+
+// RepoPushEvent is the X-Event-Key for pushes to the repository, including branches and tags.
+// The payload is WebhookPushEvent.
+const RepoPushEvent = "repo:push"
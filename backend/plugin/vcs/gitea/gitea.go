@@ -0,0 +1,772 @@
+// Package gitea is the plugin for Gitea and its fork Forgejo.
+package gitea
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// apiPageSize is the default page size when making API requests.
+	// Gitea caps the page size by the MAX_RESPONSE_ITEMS setting, which is 50 by default.
+	apiPageSize = 50
+)
+
+func init() {
+	vcs.Register(storepb.VCSType_GITEA, newProvider)
+}
+
+var _ vcs.Provider = (*Provider)(nil)
+
+// Provider is a Gitea VCS provider.
+type Provider struct {
+	client      *http.Client
+	instanceURL string
+	authToken   string
+}
+
+func newProvider(config vcs.ProviderConfig) vcs.Provider {
+	return &Provider{
+		client:      &http.Client{},
+		instanceURL: config.InstanceURL,
+		authToken:   config.AuthToken,
+	}
+}
+
+// APIURL returns the API URL path of Gitea.
+func (*Provider) APIURL(instanceURL string) string {
+	return fmt.Sprintf("%s/api/v1", strings.TrimSuffix(instanceURL, "/"))
+}
+
+// Repository represents a Gitea API response for a repository.
+type Repository struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	HTMLURL     string `json:"html_url"`
+	Permissions struct {
+		Admin bool `json:"admin"`
+	} `json:"permissions"`
+}
+
+// FetchRepositoryList fetches all repositories where the authenticated user
+// has admin permissions, which is required to create webhook in the repository.
+//
+// The repository ID is the full name "owner/repo", which is used to locate the
+// repository in the Gitea API.
+//
+// Docs: https://gitea.com/api/swagger#/user/userCurrentListRepos
+func (p *Provider) FetchRepositoryList(ctx context.Context, listAll bool) ([]*vcs.Repository, error) {
+	var giteaRepos []Repository
+	page := 1
+	for {
+		repos, hasNextPage, err := p.fetchPaginatedRepositoryList(ctx, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetch paginated list")
+		}
+		giteaRepos = append(giteaRepos, repos...)
+
+		if !hasNextPage || !listAll {
+			break
+		}
+		page++
+	}
+
+	var allRepos []*vcs.Repository
+	for _, r := range giteaRepos {
+		if !r.Permissions.Admin {
+			continue
+		}
+		allRepos = append(allRepos,
+			&vcs.Repository{
+				ID:       r.FullName,
+				Name:     r.Name,
+				FullPath: r.FullName,
+				WebURL:   r.HTMLURL,
+			},
+		)
+	}
+	return allRepos, nil
+}
+
+// fetchPaginatedRepositoryList fetches repositories where the authenticated
+// user has access to in given page. It returns the paginated results along
+// with a boolean indicating whether the next page exists.
+func (p *Provider) fetchPaginatedRepositoryList(ctx context.Context, page int) (repos []Repository, hasNextPage bool, err error) {
+	url := fmt.Sprintf("%s/user/repos?page=%d&limit=%d", p.APIURL(p.instanceURL), page, apiPageSize)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, false, common.Errorf(common.NotFound, "failed to fetch repository list from URL %s", url)
+	} else if code >= 300 {
+		return nil, false,
+			errors.Errorf("failed to fetch repository list from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+
+	if err := json.Unmarshal([]byte(body), &repos); err != nil {
+		return nil, false, errors.Wrap(err, "unmarshal")
+	}
+	return repos, len(repos) >= apiPageSize, nil
+}
+
+// ReadFileContent reads the content of the given file in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetRawFile
+func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo vcs.RefInfo) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/raw/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, vcs.EscapeFilePath(filePath), url.QueryEscape(refInfo.RefName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return "", errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to read file content from URL %s", url)
+	} else if code >= 300 {
+		return "",
+			errors.Errorf("failed to read file content from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+	}
+	return body, nil
+}
+
+// Branch is the API message for Gitea branch.
+type Branch struct {
+	Name   string       `json:"name"`
+	Commit BranchCommit `json:"commit"`
+}
+
+// BranchCommit is the API message for the head commit of Gitea branch.
+type BranchCommit struct {
+	ID string `json:"id"`
+}
+
+// GetBranch gets the given branch in the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetBranch
+func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName string) (*vcs.BranchInfo, error) {
+	url := fmt.Sprintf("%s/repos/%s/branches/%s", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(branchName))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get branch from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get branch from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	branch := new(Branch)
+	if err := json.Unmarshal([]byte(body), branch); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	return &vcs.BranchInfo{
+		Name:         branch.Name,
+		LastCommitID: branch.Commit.ID,
+	}, nil
+}
+
+// PullRequestBranch is the API message for the base or head branch of Gitea pull request.
+type PullRequestBranch struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// PullRequest is the API message for Gitea pull request.
+type PullRequest struct {
+	Number  int               `json:"number"`
+	HTMLURL string            `json:"html_url"`
+	Title   string            `json:"title"`
+	Body    string            `json:"body"`
+	Merged  bool              `json:"merged"`
+	Base    PullRequestBranch `json:"base"`
+	Head    PullRequestBranch `json:"head"`
+}
+
+// PullRequestFile is the API message for files in Gitea pull request.
+type PullRequestFile struct {
+	FileName string `json:"filename"`
+	// The file status in Gitea PR.
+	// Available values: "added", "deleted", "modified", "renamed", "copied", "changed", "unchanged"
+	Status string `json:"status"`
+}
+
+// ListPullRequestFile lists the changed files in the pull request.
+// The files don't carry the commit, so the head commit of the pull request is used.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequestFiles
+func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestFile, error) {
+	pr, err := p.getPullRequest(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	var allPRFiles []PullRequestFile
+	page := 1
+	for {
+		fileList, err := p.listPaginatedPullRequestFile(ctx, repositoryID, pullRequestID, page)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list pull request file")
+		}
+		allPRFiles = append(allPRFiles, fileList...)
+		if len(fileList) < apiPageSize {
+			break
+		}
+		page++
+	}
+
+	var res []*vcs.PullRequestFile
+	for _, file := range allPRFiles {
+		// Get web url for changed file in the PR.
+		var fileHash string
+		hash := sha1.New()
+		if _, err := hash.Write([]byte(file.FileName)); err == nil {
+			fileHash = hex.EncodeToString(hash.Sum(nil))
+		}
+
+		res = append(res, &vcs.PullRequestFile{
+			Path:         file.FileName,
+			LastCommitID: pr.Head.SHA,
+			IsDeleted:    file.Status == "deleted",
+			// Web URL for file in PR:
+			// {PR web URL}/files#diff-{sha1 for file path}
+			// Web URL for file with a specific line in PR:
+			// {PR web URL}/files#diff-{sha1 for file path}R{line}
+			WebURL: fmt.Sprintf("%s/files#diff-%s", pr.HTMLURL, fileHash),
+		})
+	}
+	return res, nil
+}
+
+// getPullRequest gets the pull request.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoGetPullRequest
+func (p *Provider) getPullRequest(ctx context.Context, repositoryID, pullRequestID string) (*PullRequest, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get pull request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get pull request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	pr := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request")
+	}
+	return pr, nil
+}
+
+// listPaginatedPullRequestFile lists the changed files in the pull request with pagination.
+func (p *Provider) listPaginatedPullRequestFile(ctx context.Context, repositoryID, pullRequestID string, page int) ([]PullRequestFile, error) {
+	url := fmt.Sprintf("%s/repos/%s/pulls/%s/files?page=%d&limit=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, page, apiPageSize)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request file from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request file from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var prFiles []PullRequestFile
+	if err := json.Unmarshal([]byte(body), &prFiles); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request files")
+	}
+	return prFiles, nil
+}
+
+// CompareCommit is the API message for Gitea commits comparison.
+type CompareCommit struct {
+	Commits []*CompareCommitItem `json:"commits"`
+}
+
+// CompareCommitItem is the API message for the commit in Gitea commits comparison.
+type CompareCommitItem struct {
+	SHA   string             `json:"sha"`
+	Files []*PullRequestFile `json:"files"`
+}
+
+// CompareCommits lists the file diffs from the fromCommit to the toCommit.
+// Gitea lists the affected files per commit, so the diffs are folded in the commit order.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCompareDiff
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, fromCommit, toCommit string) ([]*vcs.FileDiff, error) {
+	url := fmt.Sprintf("%s/repos/%s/compare/%s...%s", p.APIURL(p.instanceURL), repositoryID, fromCommit, toCommit)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to compare commits from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var compare CompareCommit
+	if err := json.Unmarshal([]byte(body), &compare); err != nil {
+		return nil, errors.Wrap(err, "unmarshal compare commits")
+	}
+	var paths []string
+	diffTypes := map[string]vcs.FileDiffType{}
+	for _, commit := range compare.Commits {
+		for _, file := range commit.Files {
+			prev, ok := diffTypes[file.FileName]
+			if !ok {
+				paths = append(paths, file.FileName)
+			}
+			switch file.Status {
+			case "added":
+				diffTypes[file.FileName] = vcs.FileDiffTypeAdded
+			case "removed", "deleted":
+				diffTypes[file.FileName] = vcs.FileDiffTypeRemoved
+			default:
+				// The file added in the previous commit is still new to the fromCommit.
+				if prev != vcs.FileDiffTypeAdded {
+					diffTypes[file.FileName] = vcs.FileDiffTypeModified
+				}
+			}
+		}
+	}
+	var diffs []*vcs.FileDiff
+	for _, path := range paths {
+		diffs = append(diffs, &vcs.FileDiff{Path: path, Type: diffTypes[path]})
+	}
+	return diffs, nil
+}
+
+// Comment is the API message for Gitea issue comment.
+type Comment struct {
+	ID   int64  `json:"id,omitempty"`
+	Body string `json:"body"`
+}
+
+// CreatePullRequestComment creates a comment on the pull request.
+// Like GitHub, the pull request shares the comments with the issue of the same index.
+//
+// Docs: https://gitea.com/api/swagger#/issue/issueCreateComment
+func (p *Provider) CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error {
+	commentCreatePayload, err := json.Marshal(Comment{Body: comment})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), commentCreatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create pull request comment through URL %s", url)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create pull request comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// ListPullRequestComments lists comments in a pull request.
+//
+// Docs: https://gitea.com/api/swagger#/issue/issueGetComments
+func (p *Provider) ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*vcs.PullRequestComment, error) {
+	url := fmt.Sprintf("%s/repos/%s/issues/%s/comments", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list pull request comments from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list pull request comments from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var comments []Comment
+	if err := json.Unmarshal([]byte(body), &comments); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request comments")
+	}
+
+	var res []*vcs.PullRequestComment
+	for _, comment := range comments {
+		res = append(res, &vcs.PullRequestComment{
+			ID:      strconv.FormatInt(comment.ID, 10),
+			Content: comment.Body,
+		})
+	}
+	return res, nil
+}
+
+// UpdatePullRequestComment updates a comment in a pull request.
+//
+// Docs: https://gitea.com/api/swagger#/issue/issueEditComment
+func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, _ string, comment *vcs.PullRequestComment) error {
+	commentUpdatePayload, err := json.Marshal(Comment{Body: comment.Content})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for updating pull request comment")
+	}
+	url := fmt.Sprintf("%s/repos/%s/issues/comments/%s", p.APIURL(p.instanceURL), repositoryID, comment.ID)
+	code, body, err := internal.Patch(ctx, url, p.getAuthorization(), commentUpdatePayload)
+	if err != nil {
+		return errors.Wrapf(err, "PATCH %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "cannot found pull request comment through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to update pull request comment through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitStatus is the API message for Gitea commit status.
+type CommitStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// PublishReviewResult publishes the review result as the commit status of the pull request head commit.
+// The branch protection can require the status check to pass before merging.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateStatus
+func (p *Provider) PublishReviewResult(ctx context.Context, repositoryID, _ string, result *vcs.ReviewResult) error {
+	state := "success"
+	if result.State == vcs.ReviewStateFailure {
+		state = "failure"
+	}
+	payload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		TargetURL:   result.DetailsURL,
+		Description: result.Title,
+		Context:     result.Name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, result.CommitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// WebhookInfo represents a Gitea API response for the webhook information.
+type WebhookInfo struct {
+	ID int64 `json:"id"`
+}
+
+// BranchCreate is the API message for creating Gitea branch.
+type BranchCreate struct {
+	NewBranchName string `json:"new_branch_name"`
+	// OldRefName can be a branch, tag or commit.
+	OldRefName string `json:"old_ref_name"`
+}
+
+// CreateBranch creates the branch from the given commit.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateBranch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, fromCommit string) error {
+	payload, err := json.Marshal(&BranchCreate{
+		NewBranchName: branchName,
+		OldRefName:    fromCommit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+	url := fmt.Sprintf("%s/repos/%s/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// FileContent is the API message for Gitea file metadata in the contents API.
+type FileContent struct {
+	SHA string `json:"sha"`
+}
+
+// FileCommit is the API message for creating or updating Gitea file.
+type FileCommit struct {
+	// Content is the base64 encoded file content.
+	Content string `json:"content"`
+	Message string `json:"message"`
+	Branch  string `json:"branch"`
+	// SHA is the blob SHA of the file to update.
+	SHA string `json:"sha,omitempty"`
+}
+
+// CommitFile creates or overwrites the file on the branch with a new commit.
+// Gitea uses POST to create the file and PUT with the blob SHA to update the existing file.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateFile
+// Docs: https://gitea.com/api/swagger#/repository/repoUpdateFile
+func (p *Provider) CommitFile(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, vcs.EscapeFilePath(commit.Path), url.QueryEscape(commit.Branch))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", url)
+	}
+	var sha string
+	switch {
+	case code == http.StatusNotFound:
+	case code >= 300:
+		return errors.Errorf("failed to get file from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	default:
+		file := new(FileContent)
+		if err := json.Unmarshal([]byte(body), file); err != nil {
+			return errors.Wrap(err, "unmarshal file content")
+		}
+		sha = file.SHA
+	}
+
+	payload, err := json.Marshal(&FileCommit{
+		Content: base64.StdEncoding.EncodeToString([]byte(commit.Content)),
+		Message: commit.Message,
+		Branch:  commit.Branch,
+		SHA:     sha,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal file commit")
+	}
+	url = fmt.Sprintf("%s/repos/%s/contents/%s", p.APIURL(p.instanceURL), repositoryID, vcs.EscapeFilePath(commit.Path))
+	if sha == "" {
+		code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	} else {
+		code, body, err = internal.Put(ctx, url, p.getAuthorization(), payload)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "commit file %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating Gitea pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// UpsertPullRequest returns the open pull request from the source branch to the target branch,
+// or creates one if it doesn't exist.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoListPullRequests
+// Docs: https://gitea.com/api/swagger#/repository/repoCreatePullRequest
+func (p *Provider) UpsertPullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/pulls?state=open&page=%d&limit=%d", p.APIURL(p.instanceURL), repositoryID, page, apiPageSize)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code >= 300 {
+			return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+		var prs []*PullRequest
+		if err := json.Unmarshal([]byte(body), &prs); err != nil {
+			return nil, errors.Wrap(err, "unmarshal pull requests")
+		}
+		for _, pr := range prs {
+			if pr.Head.Ref == create.SourceBranch && pr.Base.Ref == create.TargetBranch {
+				return &vcs.PullRequest{ID: strconv.Itoa(pr.Number), URL: pr.HTMLURL}, nil
+			}
+		}
+		if len(prs) < apiPageSize {
+			break
+		}
+	}
+
+	payload, err := json.Marshal(&PullRequestCreate{
+		Title: create.Title,
+		Body:  create.Description,
+		Head:  create.SourceBranch,
+		Base:  create.TargetBranch,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	pr := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request")
+	}
+	return &vcs.PullRequest{ID: strconv.Itoa(pr.Number), URL: pr.HTMLURL}, nil
+}
+
+// WebhookConfig represents the Gitea API message for webhook configuration.
+type WebhookConfig struct {
+	// URL is the URL to which the payloads will be delivered.
+	URL string `json:"url"`
+	// ContentType is the media type used to serialize the payloads, "json" or "form".
+	ContentType string `json:"content_type"`
+	// Secret is the secret will be used as the key to generate the HMAC hex digest
+	// value for delivery signature headers.
+	Secret string `json:"secret,omitempty"`
+}
+
+// WebhookCreate represents a Gitea API request for creating a webhook.
+type WebhookCreate struct {
+	// Type is the webhook type, "gitea" for the JSON payloads of Gitea.
+	Type   string        `json:"type"`
+	Config WebhookConfig `json:"config"`
+	// Events determines what events the hook is triggered for.
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+// CreateWebhook creates a webhook in the repository with given payload.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoCreateHook
+func (p *Provider) CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/hooks", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return "", errors.Wrapf(err, "POST %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return "", common.Errorf(common.NotFound, "failed to create webhook through URL %s", url)
+	}
+	if code != http.StatusCreated {
+		return "", errors.Errorf("failed to create webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var webhookInfo WebhookInfo
+	if err = json.Unmarshal([]byte(body), &webhookInfo); err != nil {
+		return "", errors.Wrap(err, "unmarshal body")
+	}
+	return strconv.FormatInt(webhookInfo.ID, 10), nil
+}
+
+// DeleteWebhook deletes the webhook from the repository.
+//
+// Docs: https://gitea.com/api/swagger#/repository/repoDeleteHook
+func (p *Provider) DeleteWebhook(ctx context.Context, repositoryID, webhookID string) error {
+	url := fmt.Sprintf("%s/repos/%s/hooks/%s", p.APIURL(p.instanceURL), repositoryID, webhookID)
+	code, body, err := internal.Delete(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "DELETE %s", url)
+	}
+
+	if code == http.StatusNotFound {
+		return nil // It is OK if the webhook has already gone
+	} else if code >= 300 {
+		return errors.Errorf("failed to delete webhook through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+func (p *Provider) getAuthorization() string {
+	return fmt.Sprintf("token %s", p.authToken)
+}
@@ -0,0 +1,283 @@
+package gitea
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func newTestProvider(t *testing.T, handler http.Handler) vcs.Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{InstanceURL: server.URL, AuthToken: "test-token"})
+}
+
+// decodeRequestBody decodes the JSON request body in the handler.
+// The handlers run in the server goroutines, so they report the failures with assert rather than require.
+func decodeRequestBody(t *testing.T, w http.ResponseWriter, r *http.Request, v any) bool {
+	body, err := io.ReadAll(r.Body)
+	if !assert.NoError(t, err) || !assert.NoError(t, json.Unmarshal(body, v)) {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func TestFetchRepositoryList(t *testing.T) {
+	a := require.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/user/repos", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token test-token", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[
+			{"id": 1, "name": "db", "full_name": "octocat/db", "html_url": "https://gitea.com/octocat/db", "permissions": {"admin": true}},
+			{"id": 2, "name": "readonly", "full_name": "octocat/readonly", "html_url": "https://gitea.com/octocat/readonly", "permissions": {"admin": false}}
+		]`))
+	})
+	p := newTestProvider(t, mux)
+
+	repos, err := p.FetchRepositoryList(context.Background(), true)
+	a.NoError(err)
+	a.Equal([]*vcs.Repository{
+		{
+			ID:       "octocat/db",
+			Name:     "db",
+			FullPath: "octocat/db",
+			WebURL:   "https://gitea.com/octocat/db",
+		},
+	}, repos)
+}
+
+func TestReadFileContent(t *testing.T) {
+	a := require.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/raw/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/repos/octocat/db/raw/migrations/0001%20init.sql", r.URL.EscapedPath())
+		assert.Equal(t, "abc123", r.URL.Query().Get("ref"))
+		_, _ = w.Write([]byte("CREATE TABLE t(id INT);"))
+	})
+	p := newTestProvider(t, mux)
+
+	content, err := p.ReadFileContent(context.Background(), "octocat/db", "migrations/0001 init.sql", vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: "abc123"})
+	a.NoError(err)
+	a.Equal("CREATE TABLE t(id INT);", content)
+
+	_, err = p.ReadFileContent(context.Background(), "octocat/missing", "a.sql", vcs.RefInfo{RefType: vcs.RefTypeCommit, RefName: "abc123"})
+	a.Error(err)
+}
+
+func TestListPullRequestFile(t *testing.T) {
+	a := require.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/pulls/7", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"number": 7, "html_url": "https://gitea.com/octocat/db/pulls/7", "head": {"ref": "feature", "sha": "headsha"}}`))
+	})
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/pulls/7/files", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"filename": "migrations/0001_init.sql", "status": "added"},
+			{"filename": "migrations/0000_old.sql", "status": "deleted"}
+		]`))
+	})
+	p := newTestProvider(t, mux)
+
+	files, err := p.ListPullRequestFile(context.Background(), "octocat/db", "7")
+	a.NoError(err)
+	a.Len(files, 2)
+	a.Equal("migrations/0001_init.sql", files[0].Path)
+	a.Equal("headsha", files[0].LastCommitID)
+	a.False(files[0].IsDeleted)
+	hash := sha1.Sum([]byte("migrations/0001_init.sql"))
+	a.Equal("https://gitea.com/octocat/db/pulls/7/files#diff-"+hex.EncodeToString(hash[:]), files[0].WebURL)
+	a.True(files[1].IsDeleted)
+}
+
+func TestPullRequestComment(t *testing.T) {
+	a := require.New(t)
+	comments := map[string]string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/repos/octocat/db/issues/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		var comment Comment
+		if !decodeRequestBody(t, w, r, &comment) {
+			return
+		}
+		comments["1"] = comment.Body
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	})
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/issues/7/comments", func(w http.ResponseWriter, _ *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode([]Comment{{ID: 1, Body: comments["1"]}}))
+	})
+	mux.HandleFunc("PATCH /api/v1/repos/octocat/db/issues/comments/1", func(w http.ResponseWriter, r *http.Request) {
+		var comment Comment
+		if !decodeRequestBody(t, w, r, &comment) {
+			return
+		}
+		comments["1"] = comment.Body
+	})
+	p := newTestProvider(t, mux)
+	ctx := context.Background()
+
+	a.NoError(p.CreatePullRequestComment(ctx, "octocat/db", "7", "SQL review passed"))
+	list, err := p.ListPullRequestComments(ctx, "octocat/db", "7")
+	a.NoError(err)
+	a.Equal([]*vcs.PullRequestComment{{ID: "1", Content: "SQL review passed"}}, list)
+
+	a.NoError(p.UpdatePullRequestComment(ctx, "octocat/db", "7", &vcs.PullRequestComment{ID: "1", Content: "1 errors, 0 warnings"}))
+	a.Equal("1 errors, 0 warnings", comments["1"])
+}
+
+func TestWebhook(t *testing.T) {
+	a := require.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/repos/octocat/db/hooks", func(w http.ResponseWriter, r *http.Request) {
+		var webhook WebhookCreate
+		if !decodeRequestBody(t, w, r, &webhook) {
+			return
+		}
+		assert.Equal(t, "gitea", webhook.Type)
+		assert.Equal(t, []string{"pull_request"}, webhook.Events)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 42}`))
+	})
+	mux.HandleFunc("DELETE /api/v1/repos/octocat/db/hooks/42", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	p := newTestProvider(t, mux)
+	ctx := context.Background()
+
+	payload, err := json.Marshal(&WebhookCreate{
+		Type:   "gitea",
+		Config: WebhookConfig{URL: "https://bytebase.example.com/hook/1", ContentType: "json", Secret: "secret"},
+		Events: []string{"pull_request"},
+		Active: true,
+	})
+	a.NoError(err)
+	id, err := p.CreateWebhook(ctx, "octocat/db", payload)
+	a.NoError(err)
+	a.Equal("42", id)
+	a.NoError(p.DeleteWebhook(ctx, "octocat/db", id))
+	// Deleting the webhook already gone is OK.
+	a.NoError(p.DeleteWebhook(ctx, "octocat/db", "43"))
+}
+
+func TestCompareCommits(t *testing.T) {
+	a := require.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/compare/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/repos/octocat/db/compare/fromsha...tosha", r.URL.Path)
+		_, _ = w.Write([]byte(`{"commits": [
+			{"sha": "c1", "files": [{"filename": "migrations/0002_add.sql", "status": "added"}, {"filename": "migrations/0000_old.sql", "status": "removed"}]},
+			{"sha": "c2", "files": [{"filename": "migrations/0002_add.sql", "status": "modified"}, {"filename": "README.md", "status": "modified"}]}
+		]}`))
+	})
+	p := newTestProvider(t, mux)
+
+	diffs, err := p.CompareCommits(context.Background(), "octocat/db", "fromsha", "tosha")
+	a.NoError(err)
+	a.Equal([]*vcs.FileDiff{
+		{Path: "migrations/0002_add.sql", Type: vcs.FileDiffTypeAdded},
+		{Path: "migrations/0000_old.sql", Type: vcs.FileDiffTypeRemoved},
+		{Path: "README.md", Type: vcs.FileDiffTypeModified},
+	}, diffs)
+}
+
+func TestCommitFile(t *testing.T) {
+	a := require.New(t)
+	files := map[string]string{"schema/prod/db/LATEST.sql": "blobsha"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/contents/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bytebase/schema", r.URL.Query().Get("ref"))
+		sha, ok := files[strings.TrimPrefix(r.URL.Path, "/api/v1/repos/octocat/db/contents/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"sha": %q}`, sha)))
+	})
+	commitFile := func(w http.ResponseWriter, r *http.Request) {
+		var commit FileCommit
+		if !decodeRequestBody(t, w, r, &commit) {
+			return
+		}
+		assert.Equal(t, "bytebase/schema", commit.Branch)
+		content, err := base64.StdEncoding.DecodeString(commit.Content)
+		assert.NoError(t, err)
+		assert.Equal(t, "CREATE TABLE t(id INT);", string(content))
+		path := strings.TrimPrefix(r.URL.Path, "/api/v1/repos/octocat/db/contents/")
+		if r.Method == http.MethodPut {
+			assert.Equal(t, files[path], commit.SHA)
+		} else {
+			assert.Empty(t, commit.SHA)
+			w.WriteHeader(http.StatusCreated)
+		}
+		files[path] = "newsha"
+	}
+	mux.HandleFunc("POST /api/v1/repos/octocat/db/contents/", commitFile)
+	mux.HandleFunc("PUT /api/v1/repos/octocat/db/contents/", commitFile)
+	p := newTestProvider(t, mux)
+	ctx := context.Background()
+
+	for _, path := range []string{"schema/prod/db/LATEST.sql", "schema/test/db/LATEST.sql"} {
+		a.NoError(p.CommitFile(ctx, "octocat/db", &vcs.FileCommitCreate{
+			Branch:  "bytebase/schema",
+			Path:    path,
+			Content: "CREATE TABLE t(id INT);",
+			Message: "Update schema",
+		}))
+		a.Equal("newsha", files[path])
+	}
+}
+
+func TestUpsertPullRequest(t *testing.T) {
+	a := require.New(t)
+	var prs []*PullRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/repos/octocat/db/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		assert.NoError(t, json.NewEncoder(w).Encode(prs))
+	})
+	mux.HandleFunc("POST /api/v1/repos/octocat/db/pulls", func(w http.ResponseWriter, r *http.Request) {
+		var create PullRequestCreate
+		if !decodeRequestBody(t, w, r, &create) {
+			return
+		}
+		pr := &PullRequest{
+			Number:  len(prs) + 1,
+			HTMLURL: fmt.Sprintf("https://gitea.com/octocat/db/pulls/%d", len(prs)+1),
+			Title:   create.Title,
+			Base:    PullRequestBranch{Ref: create.Base},
+			Head:    PullRequestBranch{Ref: create.Head},
+		}
+		prs = append(prs, pr)
+		w.WriteHeader(http.StatusCreated)
+		assert.NoError(t, json.NewEncoder(w).Encode(pr))
+	})
+	p := newTestProvider(t, mux)
+	ctx := context.Background()
+
+	create := &vcs.PullRequestCreate{
+		Title:        "Update schema",
+		SourceBranch: "bytebase/schema",
+		TargetBranch: "main",
+	}
+	pr, err := p.UpsertPullRequest(ctx, "octocat/db", create)
+	a.NoError(err)
+	a.Equal(&vcs.PullRequest{ID: "1", URL: "https://gitea.com/octocat/db/pulls/1"}, pr)
+	// The open pull request is reused.
+	pr, err = p.UpsertPullRequest(ctx, "octocat/db", create)
+	a.NoError(err)
+	a.Equal("1", pr.ID)
+	a.Len(prs, 1)
+}
@@ -0,0 +1,41 @@
+package gitea
+
+type PullRequestEventType string
+
+const (
+	// A pull request was created.
+	PullRequestEventOpened PullRequestEventType = "opened"
+	// A pull request was reopened.
+	PullRequestEventReopened PullRequestEventType = "reopened"
+	// A pull request was closed. If merged is true in the "pull_request", the pull request was merged.
+	PullRequestEventClosed PullRequestEventType = "closed"
+	// New commits were pushed to the head branch of a pull request.
+	PullRequestEventSynchronized PullRequestEventType = "synchronized"
+)
+
+// PullRequestPushEvent is the json message for pull request event.
+// Forgejo sends the same payload.
+// Docs: https://docs.gitea.com/usage/webhooks#event-information
+type PullRequestPushEvent struct {
+	Action      PullRequestEventType `json:"action"`
+	Number      int                  `json:"number"`
+	PullRequest PullRequest          `json:"pull_request"`
+	Sender      EventUser            `json:"sender"`
+}
+
+// EventUser is the user who triggers the webhook event.
+type EventUser struct {
+	Login string `json:"login"`
+	Email string `json:"email"`
+}
+
+// PushEvent is the json message for push event, which is sent for both branches and tags.
+// Docs: https://docs.gitea.com/usage/webhooks#event-information
+type PushEvent struct {
+	// The full git ref that was pushed, e.g. refs/heads/main or refs/tags/v1.0.0.
+	Ref        string    `json:"ref"`
+	Before     string    `json:"before"`
+	After      string    `json:"after"`
+	CompareURL string    `json:"compare_url"`
+	Pusher     EventUser `json:"pusher"`
+}
@@ -4,6 +4,7 @@ package github
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -345,6 +346,60 @@ func (p *Provider) listPaginatedPullRequestFile(ctx context.Context, repositoryI
 	return prFiles, nil
 }
 
+// compareFilesLimit is the maximum number of the changed files listed in the commits comparison.
+const compareFilesLimit = 300
+
+// CompareCommit is the API message for GitHub commits comparison.
+type CompareCommit struct {
+	Files []PullRequestFile `json:"files"`
+}
+
+// CompareCommits lists the file diffs from the fromCommit to the toCommit.
+//
+// Docs: https://docs.github.com/en/rest/commits/commits?apiVersion=2022-11-28#compare-two-commits
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, fromCommit, toCommit string) ([]*vcs.FileDiff, error) {
+	// The pagination applies to the commits, and the changed files are only listed on the first page.
+	// The commits are not used, so only one is listed.
+	url := fmt.Sprintf("%s/repos/%s/compare/%s...%s?per_page=1", p.APIURL(p.instanceURL), repositoryID, fromCommit, toCommit)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to compare commits from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var compare CompareCommit
+	if err := json.Unmarshal([]byte(body), &compare); err != nil {
+		return nil, errors.Wrap(err, "unmarshal compare commits")
+	}
+	// GitHub lists at most compareFilesLimit files, the rest are silently dropped.
+	if len(compare.Files) >= compareFilesLimit {
+		return nil, errors.Errorf("too many changed files between %s and %s, GitHub lists at most %d files", fromCommit, toCommit, compareFilesLimit)
+	}
+	var diffs []*vcs.FileDiff
+	for _, file := range compare.Files {
+		diffType := vcs.FileDiffTypeModified
+		switch file.Status {
+		case "added", "copied":
+			diffType = vcs.FileDiffTypeAdded
+		case "removed":
+			diffType = vcs.FileDiffTypeRemoved
+		}
+		diffs = append(diffs, &vcs.FileDiff{
+			Path: file.FileName,
+			Type: diffType,
+		})
+	}
+	return diffs, nil
+}
+
 type Comment struct {
 	ID   int    `json:"id,omitempty"`
 	Body string `json:"body"`
@@ -448,6 +503,194 @@ func (p *Provider) UpdatePullRequestComment(ctx context.Context, repositoryID, _
 	return nil
 }
 
+const (
+	// checkRunAnnotationLimit is the maximum number of annotations in one check run request.
+	checkRunAnnotationLimit = 50
+	// commitStatusDescriptionLimit is the maximum length of the commit status description.
+	commitStatusDescriptionLimit = 140
+	// checkRunSummaryLimit is the maximum length of the check run output summary.
+	checkRunSummaryLimit = 65535
+)
+
+// CheckRun is the API message for GitHub check run.
+type CheckRun struct {
+	ID         int64          `json:"id,omitempty"`
+	Name       string         `json:"name,omitempty"`
+	HeadSHA    string         `json:"head_sha,omitempty"`
+	Status     string         `json:"status,omitempty"`
+	Conclusion string         `json:"conclusion,omitempty"`
+	DetailsURL string         `json:"details_url,omitempty"`
+	Output     CheckRunOutput `json:"output"`
+}
+
+// CheckRunOutput is the API message for GitHub check run output.
+type CheckRunOutput struct {
+	Title       string                `json:"title"`
+	Summary     string                `json:"summary"`
+	Annotations []*CheckRunAnnotation `json:"annotations,omitempty"`
+}
+
+// CheckRunAnnotation is the API message for GitHub check run annotation.
+type CheckRunAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+}
+
+// CommitStatus is the API message for GitHub commit status.
+type CommitStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context"`
+}
+
+// PublishReviewResult publishes the review result as a check run with annotations.
+// The check run API is only available to GitHub Apps, so we fall back to the commit status
+// if the token is not allowed to create check runs.
+//
+// Docs: https://docs.github.com/en/rest/checks/runs?apiVersion=2022-11-28#create-a-check-run
+func (p *Provider) PublishReviewResult(ctx context.Context, repositoryID, _ string, result *vcs.ReviewResult) error {
+	var annotations []*CheckRunAnnotation
+	for _, annotation := range result.Annotations {
+		line := max(annotation.Line, 1)
+		// The annotation message is required.
+		message := annotation.Message
+		if message == "" {
+			message = annotation.Title
+		}
+		annotations = append(annotations, &CheckRunAnnotation{
+			Path:            annotation.Path,
+			StartLine:       line,
+			EndLine:         line,
+			AnnotationLevel: string(annotation.Level),
+			Title:           annotation.Title,
+			Message:         message,
+		})
+	}
+	conclusion := "success"
+	if result.State == vcs.ReviewStateFailure {
+		conclusion = "failure"
+	}
+	summary, _ := common.TruncateString(result.Summary, checkRunSummaryLimit)
+
+	checkRun := &CheckRun{
+		Name:       result.Name,
+		HeadSHA:    result.CommitID,
+		Status:     "completed",
+		Conclusion: conclusion,
+		DetailsURL: result.DetailsURL,
+		Output: CheckRunOutput{
+			Title:       result.Title,
+			Summary:     summary,
+			Annotations: annotations[:min(len(annotations), checkRunAnnotationLimit)],
+		},
+	}
+	url := fmt.Sprintf("%s/repos/%s/check-runs", p.APIURL(p.instanceURL), repositoryID)
+	created, code, body, err := p.sendCheckRun(ctx, internal.Post, url, checkRun)
+	if err != nil {
+		return err
+	}
+	if code == http.StatusForbidden || code == http.StatusNotFound {
+		slog.Debug("Failed to create check run, fallback to commit status",
+			slog.String("repository", repositoryID),
+			slog.Int("code", code),
+			slog.String("body", body),
+		)
+		return p.createCommitStatus(ctx, repositoryID, result)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create check run through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	// The annotations beyond the limit are appended by updating the check run.
+	url = fmt.Sprintf("%s/repos/%s/check-runs/%d", p.APIURL(p.instanceURL), repositoryID, created.ID)
+	for i := checkRunAnnotationLimit; i < len(annotations); i += checkRunAnnotationLimit {
+		update := &CheckRun{
+			Output: CheckRunOutput{
+				Title:       result.Title,
+				Summary:     summary,
+				Annotations: annotations[i:min(len(annotations), i+checkRunAnnotationLimit)],
+			},
+		}
+		_, code, body, err := p.sendCheckRun(ctx, internal.Patch, url, update)
+		if err != nil {
+			return err
+		}
+		if code >= 300 {
+			return errors.Errorf("failed to update check run through URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+	}
+	return nil
+}
+
+// sendCheckRun sends the check run and returns the response check run on success.
+func (p *Provider) sendCheckRun(ctx context.Context, send func(context.Context, string, string, []byte) (int, string, error), url string, checkRun *CheckRun) (*CheckRun, int, string, error) {
+	payload, err := json.Marshal(checkRun)
+	if err != nil {
+		return nil, 0, "", errors.Wrap(err, "failed to marshal request body for check run")
+	}
+	code, body, err := send(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return nil, 0, "", errors.Wrapf(err, "send check run to %s", url)
+	}
+	if code >= 300 {
+		return nil, code, body, nil
+	}
+	var resp CheckRun
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return nil, 0, "", errors.Wrap(err, "unmarshal check run")
+	}
+	return &resp, code, body, nil
+}
+
+// createCommitStatus creates the commit status for the review result.
+//
+// Docs: https://docs.github.com/en/rest/commits/statuses?apiVersion=2022-11-28#create-a-commit-status
+func (p *Provider) createCommitStatus(ctx context.Context, repositoryID string, result *vcs.ReviewResult) error {
+	state := "success"
+	if result.State == vcs.ReviewStateFailure {
+		state = "failure"
+	}
+	description, _ := common.TruncateString(result.Title, commitStatusDescriptionLimit)
+	payload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		TargetURL:   result.DetailsURL,
+		Description: description,
+		Context:     result.Name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/repos/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, result.CommitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	}
+	if code != http.StatusCreated {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
 // Branch is the API message for GitHub branch.
 type Branch struct {
 	Ref    string          `json:"ref"`
@@ -497,7 +740,167 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 
 // PullRequest is the API message for GitHub pull request.
 type PullRequest struct {
-	HTMLURL string `json:"html_url"`
+	Number  int         `json:"number"`
+	HTMLURL string      `json:"html_url"`
+	Head    EventBranch `json:"head"`
+}
+
+// ReferenceCreate is the API message for creating a GitHub reference.
+type ReferenceCreate struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// CreateBranch creates the branch from the given commit.
+//
+// Docs: https://docs.github.com/en/rest/git/refs#create-a-reference
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, fromCommit string) error {
+	payload, err := json.Marshal(&ReferenceCreate{
+		Ref: fmt.Sprintf("refs/heads/%s", branchName),
+		SHA: fromCommit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal reference create")
+	}
+	url := fmt.Sprintf("%s/repos/%s/git/refs", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// FileContent is the API message for GitHub file metadata in the contents API.
+type FileContent struct {
+	SHA string `json:"sha"`
+}
+
+// CommitFile creates or overwrites the file on the branch with a new commit.
+// The blob SHA of the existing file is required to overwrite it.
+//
+// Docs: https://docs.github.com/en/rest/repos/contents#create-or-update-file-contents
+func (p *Provider) CommitFile(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, vcs.EscapeFilePath(commit.Path), url.QueryEscape(commit.Branch))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", url)
+	}
+	var sha string
+	switch {
+	case code == http.StatusNotFound:
+	case code >= 300:
+		return errors.Errorf("failed to get file from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	default:
+		file := new(FileContent)
+		if err := json.Unmarshal([]byte(body), file); err != nil {
+			return errors.Wrap(err, "unmarshal file content")
+		}
+		sha = file.SHA
+	}
+
+	payload, err := json.Marshal(&FileCommit{
+		Message: commit.Message,
+		Content: base64.StdEncoding.EncodeToString([]byte(commit.Content)),
+		SHA:     sha,
+		Branch:  commit.Branch,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal file commit")
+	}
+	url = fmt.Sprintf("%s/repos/%s/contents/%s", p.APIURL(p.instanceURL), repositoryID, vcs.EscapeFilePath(commit.Path))
+	code, body, err = internal.Put(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "PUT %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// PullRequestCreate is the API message for creating a GitHub pull request.
+type PullRequestCreate struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+}
+
+// UpsertPullRequest returns the open pull request from the source branch to the target branch,
+// or creates one if it doesn't exist.
+//
+// Docs: https://docs.github.com/en/rest/pulls/pulls#list-pull-requests
+// Docs: https://docs.github.com/en/rest/pulls/pulls#create-a-pull-request
+func (p *Provider) UpsertPullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	// The head filter requires the owner login, so filter the head branch by ourselves.
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/repos/%s/pulls?state=open&base=%s&per_page=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(create.TargetBranch), apiPageSize, page)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code >= 300 {
+			return nil, errors.Errorf("failed to list pull requests from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+		var prs []*PullRequest
+		if err := json.Unmarshal([]byte(body), &prs); err != nil {
+			return nil, errors.Wrap(err, "unmarshal pull requests")
+		}
+		for _, pr := range prs {
+			if pr.Head.Ref == create.SourceBranch {
+				return &vcs.PullRequest{ID: strconv.Itoa(pr.Number), URL: pr.HTMLURL}, nil
+			}
+		}
+		if len(prs) < apiPageSize {
+			break
+		}
+	}
+
+	payload, err := json.Marshal(&PullRequestCreate{
+		Title: create.Title,
+		Body:  create.Description,
+		Head:  create.SourceBranch,
+		Base:  create.TargetBranch,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal pull request create")
+	}
+	url := fmt.Sprintf("%s/repos/%s/pulls", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to create pull request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	pr := new(PullRequest)
+	if err := json.Unmarshal([]byte(body), pr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal pull request")
+	}
+	return &vcs.PullRequest{ID: strconv.Itoa(pr.Number), URL: pr.HTMLURL}, nil
 }
 
 // CreateWebhook creates a webhook in the repository with given payload.
@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func newTestProvider(t *testing.T, handler http.Handler) vcs.Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{InstanceURL: server.URL, AuthToken: "test-token"})
+}
+
+// decodeRequestBody decodes the JSON request body in the handler.
+// The handlers run in the server goroutines, so they report the failures with assert rather than require.
+func decodeRequestBody(t *testing.T, w http.ResponseWriter, r *http.Request, v any) bool {
+	body, err := io.ReadAll(r.Body)
+	if !assert.NoError(t, err) || !assert.NoError(t, json.Unmarshal(body, v)) {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func TestPublishReviewResultCheckRun(t *testing.T) {
+	a := require.New(t)
+	var created *CheckRun
+	var updates []*CheckRun
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v3/repos/octocat/db/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		created = &CheckRun{}
+		if !decodeRequestBody(t, w, r, created) {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 42}`))
+	})
+	mux.HandleFunc("PATCH /api/v3/repos/octocat/db/check-runs/42", func(w http.ResponseWriter, r *http.Request) {
+		update := &CheckRun{}
+		if !decodeRequestBody(t, w, r, update) {
+			return
+		}
+		updates = append(updates, update)
+		_, _ = w.Write([]byte(`{"id": 42}`))
+	})
+	p := newTestProvider(t, mux)
+
+	var annotations []*vcs.ReviewAnnotation
+	for i := 1; i <= 120; i++ {
+		annotations = append(annotations, &vcs.ReviewAnnotation{
+			Path:  "migrations/0001_init.sql",
+			Line:  i - 1,
+			Level: vcs.ReviewAnnotationLevelWarning,
+			Title: fmt.Sprintf("rule %d", i),
+		})
+	}
+	// The summary is truncated by characters without breaking the multi-byte ones.
+	summary := strings.Repeat("表", checkRunSummaryLimit+10)
+	a.NoError(p.PublishReviewResult(context.Background(), "octocat/db", "7", &vcs.ReviewResult{
+		Name:        "Bytebase SQL Review",
+		CommitID:    "headsha",
+		State:       vcs.ReviewStateFailure,
+		Title:       "120 warnings",
+		Summary:     summary,
+		DetailsURL:  "https://bytebase.example.com/plans/1",
+		Annotations: annotations,
+	}))
+
+	a.NotNil(created)
+	a.Equal("Bytebase SQL Review", created.Name)
+	a.Equal("headsha", created.HeadSHA)
+	a.Equal("completed", created.Status)
+	a.Equal("failure", created.Conclusion)
+	a.Equal("https://bytebase.example.com/plans/1", created.DetailsURL)
+	a.Equal("120 warnings", created.Output.Title)
+	a.True(utf8.ValidString(created.Output.Summary))
+	a.Equal(checkRunSummaryLimit, utf8.RuneCountInString(created.Output.Summary))
+	a.Len(created.Output.Annotations, checkRunAnnotationLimit)
+	a.Equal(&CheckRunAnnotation{
+		Path:            "migrations/0001_init.sql",
+		StartLine:       1,
+		EndLine:         1,
+		AnnotationLevel: "warning",
+		Title:           "rule 1",
+		Message:         "rule 1",
+	}, created.Output.Annotations[0])
+
+	// The annotations beyond the limit are appended in batches.
+	a.Len(updates, 2)
+	a.Len(updates[0].Output.Annotations, checkRunAnnotationLimit)
+	a.Equal("rule 51", updates[0].Output.Annotations[0].Title)
+	a.Len(updates[1].Output.Annotations, 20)
+	a.Equal("rule 120", updates[1].Output.Annotations[19].Title)
+	a.Equal(created.Output.Summary, updates[1].Output.Summary)
+}
+
+func TestPublishReviewResultCommitStatus(t *testing.T) {
+	a := require.New(t)
+	var status *CommitStatus
+	mux := http.NewServeMux()
+	// The check runs are only available to GitHub Apps.
+	mux.HandleFunc("POST /api/v3/repos/octocat/db/check-runs", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "Resource not accessible by personal access token"}`))
+	})
+	mux.HandleFunc("POST /api/v3/repos/octocat/db/statuses/headsha", func(w http.ResponseWriter, r *http.Request) {
+		status = &CommitStatus{}
+		if !decodeRequestBody(t, w, r, status) {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	})
+	p := newTestProvider(t, mux)
+
+	title := strings.Repeat("é", commitStatusDescriptionLimit+1)
+	a.NoError(p.PublishReviewResult(context.Background(), "octocat/db", "7", &vcs.ReviewResult{
+		Name:       "Bytebase SQL Review",
+		CommitID:   "headsha",
+		State:      vcs.ReviewStateSuccess,
+		Title:      title,
+		DetailsURL: "https://bytebase.example.com/plans/1",
+	}))
+	a.Equal(&CommitStatus{
+		State:       "success",
+		TargetURL:   "https://bytebase.example.com/plans/1",
+		Description: strings.Repeat("é", commitStatusDescriptionLimit),
+		Context:     "Bytebase SQL Review",
+	}, status)
+}
+
+func TestPublishReviewResultError(t *testing.T) {
+	a := require.New(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v3/repos/octocat/db/check-runs", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message": "No commit found for SHA: headsha"}`))
+	})
+	p := newTestProvider(t, mux)
+
+	err := p.PublishReviewResult(context.Background(), "octocat/db", "7", &vcs.ReviewResult{Name: "Bytebase SQL Review", CommitID: "headsha"})
+	a.ErrorContains(err, "No commit found")
+}
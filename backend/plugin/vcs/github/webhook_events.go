@@ -34,3 +34,22 @@ type EventBranch struct {
 	Ref string `json:"ref"`
 	SHA string `json:"sha"`
 }
+
+// PushEvent is the json message for push event.
+// Docs: https://docs.github.com/en/webhooks/webhook-events-and-payloads#push
+type PushEvent struct {
+	// The full git ref that was pushed, e.g. refs/heads/main or refs/tags/v1.0.0.
+	Ref string `json:"ref"`
+	// The SHA of the most recent commit on ref before the push.
+	Before string `json:"before"`
+	// The SHA of the most recent commit on ref after the push.
+	After   string      `json:"after"`
+	Compare string      `json:"compare"`
+	Deleted bool        `json:"deleted"`
+	Pusher  EventPusher `json:"pusher"`
+}
+
+type EventPusher struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
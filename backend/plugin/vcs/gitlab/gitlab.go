@@ -7,9 +7,11 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -42,6 +44,8 @@ type WebhookType string
 const (
 	// WebhookPush is the webhook type for push.
 	WebhookPush WebhookType = "push"
+	// WebhookTagPush is the webhook type for tag push.
+	WebhookTagPush WebhookType = "tag_push"
 )
 
 // WebhookInfo represents a GitLab API response for the webhook information.
@@ -55,6 +59,7 @@ type WebhookCreate struct {
 	SecretToken string `json:"token"`
 	// This is set to true
 	PushEvents          bool `json:"push_events"`
+	TagPushEvents       bool `json:"tag_push_events"`
 	NoteEvents          bool `json:"note_events"`
 	MergeRequestsEvents bool `json:"merge_requests_events"`
 	// For now, there is no native dry run DDL support in mysql/postgres. One may wonder if we could wrap the DDL
@@ -98,6 +103,7 @@ type WebhookPushEvent struct {
 	Before     string          `json:"before"`
 	After      string          `json:"after"`
 	AuthorName string          `json:"user_name"`
+	UserEmail  string          `json:"user_email"`
 	Project    WebhookProject  `json:"project"`
 	CommitList []WebhookCommit `json:"commits"`
 }
@@ -305,6 +311,51 @@ func (p *Provider) ListPullRequestFile(ctx context.Context, repositoryID, pullRe
 	return res, nil
 }
 
+// CompareCommit is the API message for GitLab commits comparison.
+type CompareCommit struct {
+	Diffs []MergeRequestFile `json:"diffs"`
+}
+
+// CompareCommits lists the file diffs from the fromCommit to the toCommit.
+//
+// Docs: https://docs.gitlab.com/ee/api/repositories.html#compare-branches-tags-or-commits
+func (p *Provider) CompareCommits(ctx context.Context, repositoryID, fromCommit, toCommit string) ([]*vcs.FileDiff, error) {
+	url := fmt.Sprintf("%s/projects/%s/repository/compare?from=%s&to=%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(fromCommit), url.QueryEscape(toCommit))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to compare commits from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to compare commits from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var compare CompareCommit
+	if err := json.Unmarshal([]byte(body), &compare); err != nil {
+		return nil, errors.Wrap(err, "unmarshal compare commits")
+	}
+	var diffs []*vcs.FileDiff
+	for _, file := range compare.Diffs {
+		diffType := vcs.FileDiffTypeModified
+		switch {
+		case file.NewFile:
+			diffType = vcs.FileDiffTypeAdded
+		case file.DeletedFile:
+			diffType = vcs.FileDiffTypeRemoved
+		}
+		diffs = append(diffs, &vcs.FileDiff{
+			Path: file.NewPath,
+			Type: diffType,
+		})
+	}
+	return diffs, nil
+}
+
 type Comment struct {
 	ID   int64  `json:"id"`
 	Body string `json:"body"`
@@ -449,7 +500,344 @@ func (p *Provider) GetBranch(ctx context.Context, repositoryID, branchName strin
 
 // MergeRequest is the API message for GitLab merge request.
 type MergeRequest struct {
-	WebURL string `json:"web_url"`
+	IID      int      `json:"iid"`
+	WebURL   string   `json:"web_url"`
+	DiffRefs DiffRefs `json:"diff_refs"`
+}
+
+// CommitStatus is the API message for GitLab commit status.
+type CommitStatus struct {
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// DiffRefs is the API message for the diff refs of GitLab merge request.
+type DiffRefs struct {
+	BaseSHA  string `json:"base_sha"`
+	HeadSHA  string `json:"head_sha"`
+	StartSHA string `json:"start_sha"`
+}
+
+// DiscussionPosition is the API message for the position of GitLab merge request discussion.
+type DiscussionPosition struct {
+	PositionType string `json:"position_type"`
+	BaseSHA      string `json:"base_sha"`
+	HeadSHA      string `json:"head_sha"`
+	StartSHA     string `json:"start_sha"`
+	NewPath      string `json:"new_path"`
+	NewLine      int    `json:"new_line"`
+}
+
+// DiscussionNote is the API message for the note of GitLab merge request discussion.
+type DiscussionNote struct {
+	Body     string              `json:"body"`
+	Position *DiscussionPosition `json:"position,omitempty"`
+}
+
+// Discussion is the API message for GitLab merge request discussion.
+type Discussion struct {
+	Notes []*DiscussionNote `json:"notes"`
+}
+
+// DiscussionCreate is the API message for creating GitLab merge request discussion.
+type DiscussionCreate struct {
+	Body     string              `json:"body"`
+	Position *DiscussionPosition `json:"position"`
+}
+
+// PublishReviewResult publishes the review result as the commit status of the merge request
+// head commit, and creates discussions on the offending lines.
+//
+// Docs: https://docs.gitlab.com/ee/api/commits.html#set-the-pipeline-status-of-a-commit
+func (p *Provider) PublishReviewResult(ctx context.Context, repositoryID, pullRequestID string, result *vcs.ReviewResult) error {
+	state := "success"
+	if result.State == vcs.ReviewStateFailure {
+		state = "failed"
+	}
+	payload, err := json.Marshal(&CommitStatus{
+		State:       state,
+		Name:        result.Name,
+		TargetURL:   result.DetailsURL,
+		Description: result.Title,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request body for creating commit status")
+	}
+	url := fmt.Sprintf("%s/projects/%s/statuses/%s", p.APIURL(p.instanceURL), repositoryID, result.CommitID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code == http.StatusNotFound {
+		return common.Errorf(common.NotFound, "failed to create commit status through URL %s", url)
+	} else if code >= 300 {
+		return errors.Errorf("failed to create commit status through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	if len(result.Annotations) == 0 {
+		return nil
+	}
+	return p.createReviewDiscussions(ctx, repositoryID, pullRequestID, result.Annotations)
+}
+
+// createReviewDiscussions creates the merge request discussions on the annotated lines.
+// The annotation already discussed on the same line is skipped, so that reviewing
+// the same commit again doesn't duplicate the discussions.
+//
+// Docs: https://docs.gitlab.com/ee/api/discussions.html#create-a-new-thread-in-the-merge-request-diff
+func (p *Provider) createReviewDiscussions(ctx context.Context, repositoryID, pullRequestID string, annotations []*vcs.ReviewAnnotation) error {
+	mr, err := p.getMergeRequest(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return err
+	}
+	discussions, err := p.listMergeRequestDiscussions(ctx, repositoryID, pullRequestID)
+	if err != nil {
+		return err
+	}
+	existed := map[string]bool{}
+	for _, discussion := range discussions {
+		for _, note := range discussion.Notes {
+			if note.Position == nil {
+				continue
+			}
+			existed[fmt.Sprintf("%s:%d:%s", note.Position.NewPath, note.Position.NewLine, note.Body)] = true
+		}
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/discussions", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	for _, annotation := range annotations {
+		if annotation.Line <= 0 {
+			continue
+		}
+		noteBody := fmt.Sprintf("**%s** %s\n\n%s", strings.ToUpper(string(annotation.Level)), annotation.Title, annotation.Message)
+		if existed[fmt.Sprintf("%s:%d:%s", annotation.Path, annotation.Line, noteBody)] {
+			continue
+		}
+		payload, err := json.Marshal(&DiscussionCreate{
+			Body: noteBody,
+			Position: &DiscussionPosition{
+				PositionType: "text",
+				BaseSHA:      mr.DiffRefs.BaseSHA,
+				HeadSHA:      mr.DiffRefs.HeadSHA,
+				StartSHA:     mr.DiffRefs.StartSHA,
+				NewPath:      annotation.Path,
+				NewLine:      annotation.Line,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body for creating discussion")
+		}
+		code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+		if err != nil {
+			return errors.Wrapf(err, "POST %s", url)
+		}
+		// GitLab rejects the position outside of the diff with 400, skip these lines.
+		if code == http.StatusBadRequest {
+			slog.Debug("Skip the discussion outside of the merge request diff",
+				slog.String("path", annotation.Path),
+				slog.Int("line", annotation.Line),
+				slog.String("body", body),
+			)
+			continue
+		}
+		if code != http.StatusCreated {
+			return errors.Errorf("failed to create discussion through URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+	}
+	return nil
+}
+
+// getMergeRequest gets the merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+func (p *Provider) getMergeRequest(ctx context.Context, repositoryID, pullRequestID string) (*MergeRequest, error) {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests/%s", p.APIURL(p.instanceURL), repositoryID, pullRequestID)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to get merge request from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to get merge request from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	mr := &MergeRequest{}
+	if err := json.Unmarshal([]byte(body), mr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal merge request")
+	}
+	return mr, nil
+}
+
+// listMergeRequestDiscussions lists the discussions in the merge request.
+//
+// Docs: https://docs.gitlab.com/ee/api/discussions.html#list-project-merge-request-discussion-items
+func (p *Provider) listMergeRequestDiscussions(ctx context.Context, repositoryID, pullRequestID string) ([]*Discussion, error) {
+	var discussions []*Discussion
+	page := "1"
+	for page != "" {
+		url := fmt.Sprintf("%s/projects/%s/merge_requests/%s/discussions?page=%s&per_page=%d", p.APIURL(p.instanceURL), repositoryID, pullRequestID, page, apiPageSize)
+		code, header, body, err := internal.GetWithResponseHeader(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list merge request discussions from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list merge request discussions from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+
+		var pageDiscussions []*Discussion
+		if err := json.Unmarshal([]byte(body), &pageDiscussions); err != nil {
+			return nil, errors.Wrap(err, "unmarshal merge request discussions")
+		}
+		discussions = append(discussions, pageDiscussions...)
+		// The header is empty on the last page.
+		// Docs: https://docs.gitlab.com/ee/api/rest/index.html#other-pagination-headers
+		page = header.Get("X-Next-Page")
+	}
+	return discussions, nil
+}
+
+// CreateBranch creates the branch from the given commit.
+//
+// Docs: https://docs.gitlab.com/ee/api/branches.html#create-repository-branch
+func (p *Provider) CreateBranch(ctx context.Context, repositoryID, branchName, fromCommit string) error {
+	payload, err := json.Marshal(&BranchCreate{
+		Branch: branchName,
+		Ref:    fromCommit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal branch create")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/branches", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err := internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to create branch through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// CommitFile creates or overwrites the file on the branch with a new commit.
+// GitLab uses POST to create the file and PUT to update the existing file.
+//
+// Docs: https://docs.gitlab.com/ee/api/repository_files.html#create-new-file-in-repository
+// Docs: https://docs.gitlab.com/ee/api/repository_files.html#update-existing-file-in-repository
+func (p *Provider) CommitFile(ctx context.Context, repositoryID string, commit *vcs.FileCommitCreate) error {
+	exists := true
+	if _, err := p.readFile(ctx, repositoryID, commit.Path, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: commit.Branch}); err != nil {
+		if common.ErrorCode(err) != common.NotFound {
+			return err
+		}
+		exists = false
+	}
+
+	payload, err := json.Marshal(&FileCommit{
+		Branch:        commit.Branch,
+		Content:       commit.Content,
+		CommitMessage: commit.Message,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal file commit")
+	}
+	url := fmt.Sprintf("%s/projects/%s/repository/files/%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(commit.Path))
+	var code int
+	var body string
+	if exists {
+		code, body, err = internal.Put(ctx, url, p.getAuthorization(), payload)
+	} else {
+		code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "commit file %s", url)
+	}
+	if code >= 300 {
+		return errors.Errorf("failed to commit file through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	return nil
+}
+
+// UpsertPullRequest returns the open merge request from the source branch to the target branch,
+// or creates one if it doesn't exist.
+//
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests
+// Docs: https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
+func (p *Provider) UpsertPullRequest(ctx context.Context, repositoryID string, create *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	url := fmt.Sprintf("%s/projects/%s/merge_requests?state=opened&source_branch=%s&target_branch=%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(create.SourceBranch), url.QueryEscape(create.TargetBranch))
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to list merge requests from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	var mrs []*MergeRequest
+	if err := json.Unmarshal([]byte(body), &mrs); err != nil {
+		return nil, errors.Wrap(err, "unmarshal merge requests")
+	}
+	if len(mrs) > 0 {
+		return &vcs.PullRequest{ID: strconv.Itoa(mrs[0].IID), URL: mrs[0].WebURL}, nil
+	}
+
+	payload, err := json.Marshal(&MergeRequestCreate{
+		SourceBranch: create.SourceBranch,
+		TargetBranch: create.TargetBranch,
+		Title:        create.Title,
+		Description:  create.Description,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal merge request create")
+	}
+	url = fmt.Sprintf("%s/projects/%s/merge_requests", p.APIURL(p.instanceURL), repositoryID)
+	code, body, err = internal.Post(ctx, url, p.getAuthorization(), payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "POST %s", url)
+	}
+	if code >= 300 {
+		return nil, errors.Errorf("failed to create merge request through URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+	mr := new(MergeRequest)
+	if err := json.Unmarshal([]byte(body), mr); err != nil {
+		return nil, errors.Wrap(err, "unmarshal merge request")
+	}
+	return &vcs.PullRequest{ID: strconv.Itoa(mr.IID), URL: mr.WebURL}, nil
 }
 
 // CreateWebhook creates a webhook in the repository with given payload.
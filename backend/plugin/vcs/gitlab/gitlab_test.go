@@ -0,0 +1,144 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+)
+
+func newTestProvider(t *testing.T, handler http.Handler) vcs.Provider {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return newProvider(vcs.ProviderConfig{InstanceURL: server.URL, AuthToken: "test-token"})
+}
+
+// decodeRequestBody decodes the JSON request body in the handler.
+// The handlers run in the server goroutines, so they report the failures with assert rather than require.
+func decodeRequestBody(t *testing.T, w http.ResponseWriter, r *http.Request, v any) bool {
+	body, err := io.ReadAll(r.Body)
+	if !assert.NoError(t, err) || !assert.NoError(t, json.Unmarshal(body, v)) {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func TestPublishReviewResult(t *testing.T) {
+	a := require.New(t)
+	var status *CommitStatus
+	var discussions []*DiscussionCreate
+	var pages []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/12/statuses/headsha", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		status = &CommitStatus{}
+		if !decodeRequestBody(t, w, r, status) {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/12/merge_requests/7", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"iid": 7, "diff_refs": {"base_sha": "basesha", "head_sha": "headsha", "start_sha": "startsha"}}`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/12/merge_requests/7/discussions", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"notes": [{"body": "LGTM"}]}]`))
+		case "2":
+			// The discussion on the second page already exists.
+			_, _ = w.Write([]byte(`[{"notes": [{"body": "**WARNING** column-no-null\n\nColumn id is nullable", "position": {"new_path": "migrations/0001_init.sql", "new_line": 2}}]}]`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("POST /api/v4/projects/12/merge_requests/7/discussions", func(w http.ResponseWriter, r *http.Request) {
+		discussion := &DiscussionCreate{}
+		if !decodeRequestBody(t, w, r, discussion) {
+			return
+		}
+		// GitLab rejects the line outside of the diff.
+		if discussion.Position.NewLine == 100 {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message": "400 Bad request - Note {:line_code=>[\"can't be blank\"]}"}`))
+			return
+		}
+		discussions = append(discussions, discussion)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "1"}`))
+	})
+	p := newTestProvider(t, mux)
+
+	a.NoError(p.PublishReviewResult(context.Background(), "12", "7", &vcs.ReviewResult{
+		Name:       "Bytebase SQL Review",
+		CommitID:   "headsha",
+		State:      vcs.ReviewStateFailure,
+		Title:      "1 error, 1 warning",
+		DetailsURL: "https://bytebase.example.com/plans/1",
+		Annotations: []*vcs.ReviewAnnotation{
+			{Path: "migrations/0001_init.sql", Line: 1, Level: vcs.ReviewAnnotationLevelFailure, Title: "statement-syntax-error", Message: "Syntax error"},
+			{Path: "migrations/0001_init.sql", Line: 2, Level: vcs.ReviewAnnotationLevelWarning, Title: "column-no-null", Message: "Column id is nullable"},
+			{Path: "migrations/0001_init.sql", Line: 100, Level: vcs.ReviewAnnotationLevelWarning, Title: "outside"},
+			{Path: "migrations/0001_init.sql", Line: 0, Level: vcs.ReviewAnnotationLevelWarning, Title: "no line"},
+		},
+	}))
+
+	a.Equal(&CommitStatus{
+		State:       "failed",
+		Name:        "Bytebase SQL Review",
+		TargetURL:   "https://bytebase.example.com/plans/1",
+		Description: "1 error, 1 warning",
+	}, status)
+	a.Equal([]string{"1", "2"}, pages)
+	a.Equal([]*DiscussionCreate{
+		{
+			Body: "**FAILURE** statement-syntax-error\n\nSyntax error",
+			Position: &DiscussionPosition{
+				PositionType: "text",
+				BaseSHA:      "basesha",
+				HeadSHA:      "headsha",
+				StartSHA:     "startsha",
+				NewPath:      "migrations/0001_init.sql",
+				NewLine:      1,
+			},
+		},
+	}, discussions)
+}
+
+func TestPublishReviewResultWithoutAnnotations(t *testing.T) {
+	a := require.New(t)
+	var status *CommitStatus
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/12/statuses/headsha", func(w http.ResponseWriter, r *http.Request) {
+		status = &CommitStatus{}
+		if !decodeRequestBody(t, w, r, status) {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	p := newTestProvider(t, mux)
+
+	// The merge request and discussions are not requested without annotations.
+	a.NoError(p.PublishReviewResult(context.Background(), "12", "7", &vcs.ReviewResult{
+		Name:     "Bytebase SQL Review",
+		CommitID: "headsha",
+		State:    vcs.ReviewStateSuccess,
+		Title:    "SQL review passed",
+	}))
+	a.Equal("success", status.State)
+
+	err := p.PublishReviewResult(context.Background(), "12", "7", &vcs.ReviewResult{Name: "Bytebase SQL Review", CommitID: "missingsha"})
+	a.Error(err)
+}
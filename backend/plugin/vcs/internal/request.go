@@ -15,6 +15,12 @@ func Post(ctx context.Context, url string, authorization string, body []byte) (c
 	return request(ctx, http.MethodPost, url, authorization, nil, bytes.NewReader(body))
 }
 
+// PostWithHeader makes a HTTP POST request to the given URL with additional header.
+// The header can override the default "Content-Type: application/json".
+func PostWithHeader(ctx context.Context, url string, authorization string, header map[string]string, body []byte) (code int, respBody string, err error) {
+	return request(ctx, http.MethodPost, url, authorization, header, bytes.NewReader(body))
+}
+
 // Patch makes a HTTP PATCH request to the given URL.
 func Patch(ctx context.Context, url string, authorization string, body []byte) (code int, respBody string, err error) {
 	return request(ctx, http.MethodPatch, url, authorization, nil, bytes.NewReader(body))
@@ -35,15 +41,26 @@ func GetWithHeader(ctx context.Context, url string, authorization string, header
 	return request(ctx, http.MethodGet, url, authorization, header, bytes.NewReader(nil))
 }
 
+// GetWithResponseHeader makes a HTTP GET request to the given URL and returns the response header as well,
+// e.g. for the pagination headers.
+func GetWithResponseHeader(ctx context.Context, url string, authorization string) (code int, respHeader http.Header, respBody string, err error) {
+	return requestWithResponseHeader(ctx, http.MethodGet, url, authorization, nil, bytes.NewReader(nil))
+}
+
 // Delete makes a HTTP DELETE request to the given URL.
 func Delete(ctx context.Context, url string, authorization string) (code int, respBody string, err error) {
 	return request(ctx, http.MethodDelete, url, authorization, nil, bytes.NewReader(nil))
 }
 
 func request(ctx context.Context, method, url string, authorization string, header map[string]string, requestBody *bytes.Reader) (int, string, error) {
+	code, _, body, err := requestWithResponseHeader(ctx, method, url, authorization, header, requestBody)
+	return code, body, err
+}
+
+func requestWithResponseHeader(ctx context.Context, method, url string, authorization string, header map[string]string, requestBody *bytes.Reader) (int, http.Header, string, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url, requestBody)
 	if err != nil {
-		return 0, "", errors.Wrapf(err, "failed to build delete request for url %s", url)
+		return 0, nil, "", errors.Wrapf(err, "failed to build delete request for url %s", url)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Add("Authorization", authorization)
@@ -53,16 +70,16 @@ func request(ctx context.Context, method, url string, authorization string, head
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
-		return 0, "", errors.Wrapf(err, "failed to send delete request for url %s", url)
+		return 0, nil, "", errors.Wrapf(err, "failed to send delete request for url %s", url)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return 0, "", errors.Wrapf(err, "read delete %s response body with status code %d", url, resp.StatusCode)
+		return 0, nil, "", errors.Wrapf(err, "read delete %s response body with status code %d", url, resp.StatusCode)
 	}
 	if err := resp.Body.Close(); err != nil {
-		return 0, "", errors.Wrapf(err, "failed to close delete %s response body with status code %d", url, resp.StatusCode)
+		return 0, nil, "", errors.Wrapf(err, "failed to close delete %s response body with status code %d", url, resp.StatusCode)
 	}
-	return resp.StatusCode, string(respBody), nil
+	return resp.StatusCode, resp.Header, string(respBody), nil
 }
@@ -2,6 +2,7 @@
 package vcs
 
 import (
+	"net/url"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -17,3 +18,12 @@ func Branch(ref string) (string, error) {
 
 	return "", errors.Errorf("invalid Git ref: %s", ref)
 }
+
+// EscapeFilePath escapes each segment of the file path, keeping the "/" separators.
+func EscapeFilePath(filePath string) string {
+	segments := strings.Split(strings.TrimPrefix(filePath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -38,3 +38,8 @@ func TestBranch(t *testing.T) {
 		assert.Equal(t, result, test.want)
 	}
 }
+
+func TestEscapeFilePath(t *testing.T) {
+	assert.Equal(t, "migrations/0001%20init.sql", EscapeFilePath("/migrations/0001 init.sql"))
+	assert.Equal(t, "schema/a%3Fb/LATEST.sql", EscapeFilePath("schema/a?b/LATEST.sql"))
+}
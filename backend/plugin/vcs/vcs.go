@@ -75,6 +75,74 @@ type PullRequestComment struct {
 	Content string
 }
 
+// ReviewState is the overall state of a review result.
+type ReviewState string
+
+const (
+	// ReviewStateSuccess means the review passes.
+	ReviewStateSuccess ReviewState = "success"
+	// ReviewStateFailure means the review fails and should block the merge.
+	ReviewStateFailure ReviewState = "failure"
+)
+
+// ReviewAnnotationLevel is the level of a review annotation.
+type ReviewAnnotationLevel string
+
+const (
+	// ReviewAnnotationLevelNotice is the notice annotation level.
+	ReviewAnnotationLevelNotice ReviewAnnotationLevel = "notice"
+	// ReviewAnnotationLevelWarning is the warning annotation level.
+	ReviewAnnotationLevelWarning ReviewAnnotationLevel = "warning"
+	// ReviewAnnotationLevelFailure is the failure annotation level.
+	ReviewAnnotationLevelFailure ReviewAnnotationLevel = "failure"
+)
+
+// ReviewAnnotation is the review message on a line of the changed file.
+type ReviewAnnotation struct {
+	Path string
+	// Line is the 1-based line number in the file.
+	Line    int
+	Level   ReviewAnnotationLevel
+	Title   string
+	Message string
+}
+
+// ReviewResult is the review result for the head commit of a pull request.
+type ReviewResult struct {
+	// Name is the name of the check, such as "Bytebase SQL Review".
+	Name string
+	// CommitID is the head commit of the pull request.
+	CommitID    string
+	State       ReviewState
+	Title       string
+	Summary     string
+	DetailsURL  string
+	Annotations []*ReviewAnnotation
+}
+
+// FileCommitCreate is the API message for committing a file to a branch.
+type FileCommitCreate struct {
+	Branch string
+	// Path is the file path in the repository without the leading "/".
+	Path    string
+	Content string
+	Message string
+}
+
+// PullRequestCreate is the API message for creating a pull request.
+type PullRequestCreate struct {
+	Title        string
+	Description  string
+	SourceBranch string
+	TargetBranch string
+}
+
+// PullRequest is the API message for a pull request.
+type PullRequest struct {
+	ID  string
+	URL string
+}
+
 // Provider is the interface for VCS provider.
 type Provider interface {
 	// Returns the API URL for a given VCS instance URL
@@ -92,6 +160,19 @@ type Provider interface {
 	// ListPullRequestFile lists changed files in a pull request.
 	ListPullRequestFile(ctx context.Context, repositoryID, pullRequestID string) ([]*PullRequestFile, error)
 
+	// CompareCommits lists the file diffs from the fromCommit to the toCommit.
+	CompareCommits(ctx context.Context, repositoryID, fromCommit, toCommit string) ([]*FileDiff, error)
+
+	// CreateBranch creates the branch from the given commit.
+	CreateBranch(ctx context.Context, repositoryID, branchName, fromCommit string) error
+
+	// CommitFile creates or overwrites the file on the branch with a new commit.
+	CommitFile(ctx context.Context, repositoryID string, commit *FileCommitCreate) error
+
+	// UpsertPullRequest returns the open pull request from the source branch to the target branch,
+	// or creates one if it doesn't exist.
+	UpsertPullRequest(ctx context.Context, repositoryID string, create *PullRequestCreate) (*PullRequest, error)
+
 	// CreatePullRequestComment creates a pull request comment.
 	CreatePullRequestComment(ctx context.Context, repositoryID, pullRequestID, comment string) error
 
@@ -101,6 +182,10 @@ type Provider interface {
 	// ListPullRequestComments lists comments in a pull request.
 	ListPullRequestComments(ctx context.Context, repositoryID, pullRequestID string) ([]*PullRequestComment, error)
 
+	// PublishReviewResult publishes the review result as the commit status or check of the pull request,
+	// and attaches the annotations to the offending lines if the VCS supports it.
+	PublishReviewResult(ctx context.Context, repositoryID, pullRequestID string, result *ReviewResult) error
+
 	// Creates a webhook. Returns the created webhook ID on success.
 	CreateWebhook(ctx context.Context, repositoryID string, payload []byte) (string, error)
 
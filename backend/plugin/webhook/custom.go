@@ -2,13 +2,31 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
+	"regexp"
+	"strconv"
+	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// SignatureHeader is the header of the HMAC-SHA256 signature of the custom webhook request.
+	SignatureHeader = "X-Bytebase-Signature"
+	// SignatureTimestampHeader is the header of the unix timestamp when the custom webhook request is sent.
+	SignatureTimestampHeader = "X-Bytebase-Timestamp"
 )
 
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
 // CustomWebhookResponse is the API message for Custom webhook response.
 type CustomWebhookResponse struct {
 	Code    int    `json:"code"`
@@ -17,16 +35,19 @@ type CustomWebhookResponse struct {
 
 // CustomWebhookRequest is the API message for Custom webhook request.
 type CustomWebhookRequest struct {
-	Level        Level    `json:"level"`
-	ActivityType string   `json:"activity_type"`
-	Title        string   `json:"title"`
-	Description  string   `json:"description"`
-	Link         string   `json:"link"`
-	CreatorID    int      `json:"creator_id"`
-	CreatorName  string   `json:"creator_name"`
-	CreatedTS    int64    `json:"created_ts"`
-	Issue        *Issue   `json:"issue"`
-	Project      *Project `json:"project"`
+	Level        Level       `json:"level"`
+	ActivityType string      `json:"activity_type"`
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	Link         string      `json:"link"`
+	CreatorID    int         `json:"creator_id"`
+	CreatorName  string      `json:"creator_name"`
+	CreatedTS    int64       `json:"created_ts"`
+	Issue        *Issue      `json:"issue"`
+	Project      *Project    `json:"project"`
+	Stage        *Stage      `json:"stage,omitempty"`
+	TaskResult   *TaskResult `json:"task_result,omitempty"`
+	Database     *Database   `json:"database,omitempty"`
 }
 
 func init() {
@@ -37,23 +58,9 @@ func init() {
 type CustomReceiver struct{}
 
 func (*CustomReceiver) Post(context Context) error {
-	// TODO(p0ny): handle context.Task
-	payload := CustomWebhookRequest{
-		Level:        context.Level,
-		ActivityType: context.ActivityType,
-		Title:        context.Title,
-		Description:  context.Description,
-		Link:         context.Link,
-		CreatorID:    context.CreatorID,
-		CreatorName:  context.CreatorName,
-		CreatedTS:    context.CreatedTs,
-		Issue:        context.Issue,
-		Project:      context.Project,
-	}
-
-	body, err := json.Marshal(&payload)
+	body, err := getCustomPayload(context)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return errors.Wrapf(err, "failed to get webhook POST request payload to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
 		context.URL, bytes.NewBuffer(body))
@@ -62,9 +69,15 @@ func (*CustomReceiver) Post(context Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: Timeout,
+	for name, value := range context.CustomConfig.GetHeaders() {
+		req.Header.Set(name, value)
+	}
+	if secret := context.CustomConfig.GetSigningSecret(); secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(SignatureTimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, "sha256="+SignPayload(secret, timestamp, body))
 	}
+	client := NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
@@ -76,6 +89,14 @@ func (*CustomReceiver) Post(context Context) error {
 	}
 	defer resp.Body.Close()
 
+	// The endpoint of the templated payload is not necessarily built for Bytebase, so any 2xx response is accepted.
+	if context.CustomConfig.GetPayloadTemplate() != "" {
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+			return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+		}
+		return nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
 	}
@@ -91,3 +112,82 @@ func (*CustomReceiver) Post(context Context) error {
 
 	return nil
 }
+
+// SignPayload returns the hex encoded HMAC-SHA256 signature of "{timestamp}.{body}".
+func SignPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateCustomConfig validates the config of the custom webhook.
+func ValidateCustomConfig(config *storepb.CustomWebhookConfig) error {
+	if _, err := parsePayloadTemplate(config.PayloadTemplate); err != nil {
+		return errors.Wrapf(err, "invalid payload template")
+	}
+	for name := range config.Headers {
+		if !headerNameRegexp.MatchString(name) {
+			return errors.Errorf("invalid header name %q", name)
+		}
+		switch http.CanonicalHeaderKey(name) {
+		case SignatureHeader, SignatureTimestampHeader:
+			return errors.Errorf("header %q is reserved", name)
+		}
+	}
+	return nil
+}
+
+func parsePayloadTemplate(text string) (*template.Template, error) {
+	return template.New("payload").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(b), nil
+		},
+	}).Option("missingkey=error").Parse(text)
+}
+
+func getCustomPayload(context Context) ([]byte, error) {
+	if text := context.CustomConfig.GetPayloadTemplate(); text != "" {
+		tmpl, err := parsePayloadTemplate(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid payload template")
+		}
+		// The credentials must not be exposed to the template.
+		data := context
+		data.MentionEndUsers = nil
+		data.IMSetting = nil
+		data.CustomConfig = nil
+		data.Trace = nil
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, errors.Wrapf(err, "failed to execute payload template")
+		}
+		return buf.Bytes(), nil
+	}
+
+	payload := CustomWebhookRequest{
+		Level:        context.Level,
+		ActivityType: context.ActivityType,
+		Title:        context.Title,
+		Description:  context.Description,
+		Link:         context.Link,
+		CreatorID:    context.CreatorID,
+		CreatorName:  context.CreatorName,
+		CreatedTS:    context.CreatedTs,
+		Issue:        context.Issue,
+		Project:      context.Project,
+		Stage:        context.Stage,
+		TaskResult:   context.TaskResult,
+		Database:     context.Database,
+	}
+	body, err := json.Marshal(&payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+	return body, nil
+}
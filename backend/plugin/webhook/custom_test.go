@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCustomReceiverSignedTemplate(t *testing.T) {
+	a := require.New(t)
+	const secret = "s3cret"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		a.Equal(`{"text":"Issue \"a\" created","stage":"Prod"}`, string(body))
+		a.Equal("team-a", r.Header.Get("X-Team"))
+		timestamp := r.Header.Get(SignatureTimestampHeader)
+		a.NotEmpty(timestamp)
+		a.Equal("sha256="+SignPayload(secret, timestamp, body), r.Header.Get(SignatureHeader))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	err := Post("bb.plugin.webhook.custom", Context{
+		URL:   server.URL,
+		Title: `Issue "a" created`,
+		Stage: &Stage{Name: "Prod"},
+		CustomConfig: &storepb.CustomWebhookConfig{
+			SigningSecret:   secret,
+			PayloadTemplate: `{"text":{{json .Title}},"stage":{{json .Stage.Name}}}`,
+			Headers:         map[string]string{"X-Team": "team-a"},
+		},
+	})
+	a.NoError(err)
+}
+
+func TestValidateCustomConfig(t *testing.T) {
+	tests := []struct {
+		config  *storepb.CustomWebhookConfig
+		wantErr bool
+	}{
+		{
+			config: &storepb.CustomWebhookConfig{PayloadTemplate: `{"title":{{json .Title}}}`, Headers: map[string]string{"Authorization": "Bearer x"}},
+		},
+		{
+			config:  &storepb.CustomWebhookConfig{PayloadTemplate: `{{.Title`},
+			wantErr: true,
+		},
+		{
+			config:  &storepb.CustomWebhookConfig{Headers: map[string]string{"Bad Header": "x"}},
+			wantErr: true,
+		},
+		{
+			config:  &storepb.CustomWebhookConfig{Headers: map[string]string{"x-bytebase-signature": "x"}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		err := ValidateCustomConfig(test.config)
+		if test.wantErr {
+			require.Error(t, err, test.config)
+		} else {
+			require.NoError(t, err, test.config)
+		}
+	}
+}
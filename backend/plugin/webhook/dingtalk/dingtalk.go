@@ -43,6 +43,7 @@ func init() {
 }
 
 // DingTalkReceiver is the receiver for DingTalk.
+// The messages are not threaded because the custom robots can't reply to a message.
 type DingTalkReceiver struct {
 }
 
@@ -87,9 +88,7 @@ func (*DingTalkReceiver) Post(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
+	client := webhook.NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
@@ -6,16 +6,28 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
 )
 
 // DiscordWebhookResponse is the API message for Discord webhook response.
+// The message is returned if the webhook is executed with wait=true.
 type DiscordWebhookResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	// ChannelID is the channel of the created message, which is the thread if the message starts a thread.
+	ChannelID string `json:"channel_id"`
 }
 
+// discordForumOnlyThreadErrorCode is the error code returned for creating a thread in a non-forum channel.
+const discordForumOnlyThreadErrorCode = 220003
+
+// discordThreadNameLimit is the max length of the Discord thread name.
+const discordThreadNameLimit = 100
+
 // DiscordWebhookEmbedField is the API message for Discord webhook embed field.
 type DiscordWebhookEmbedField struct {
 	Name  string `json:"name"`
@@ -41,6 +53,8 @@ type DiscordWebhookEmbed struct {
 // DiscordWebhook is the API message for Discord webhook.
 type DiscordWebhook struct {
 	EmbedList []DiscordWebhookEmbed `json:"embeds"`
+	// ThreadName creates a thread post if the webhook channel is a forum channel.
+	ThreadName string `json:"thread_name,omitempty"`
 }
 
 func init() {
@@ -48,6 +62,8 @@ func init() {
 }
 
 // DiscordReceiver is the receiver for Discord.
+// The issue messages are posted to a thread if the webhook channel is a forum channel.
+// Discord webhooks can't create threads in the text channels, so the messages are posted to the channel there.
 type DiscordReceiver struct {
 }
 
@@ -82,43 +98,82 @@ func (*DiscordReceiver) Post(context Context) error {
 	post := DiscordWebhook{
 		EmbedList: embedList,
 	}
+	if context.Thread == nil {
+		_, err := postDiscordMessage(context, post, "")
+		return err
+	}
+	if context.Thread.Key != "" {
+		_, err := postDiscordMessage(context, post, context.Thread.Key)
+		return err
+	}
+	if context.Issue != nil {
+		post.ThreadName, _ = common.TruncateString(fmt.Sprintf("#%d %s", context.Issue.ID, context.Issue.Name), discordThreadNameLimit)
+	}
+	webhookResponse, err := postDiscordMessage(context, post, "")
+	if err != nil {
+		if webhookResponse == nil || webhookResponse.Code != discordForumOnlyThreadErrorCode {
+			return err
+		}
+		post.ThreadName = ""
+		_, err := postDiscordMessage(context, post, "")
+		return err
+	}
+	if post.ThreadName != "" {
+		context.Thread.Key = webhookResponse.ChannelID
+	}
+	return nil
+}
+
+// postDiscordMessage executes the webhook and waits for the created message.
+// The message is posted to the thread if threadID is set.
+// The response is also returned for the errors reported by Discord.
+func postDiscordMessage(context Context, post DiscordWebhook, threadID string) (*DiscordWebhookResponse, error) {
+	u, err := url.Parse(context.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse webhook URL %s", context.URL)
+	}
+	q := u.Query()
+	q.Set("wait", "true")
+	if threadID != "" {
+		q.Set("thread_id", threadID)
+	}
+	u.RawQuery = q.Encode()
+
 	body, err := json.Marshal(post)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+		return nil, errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
 	}
 	req, err := http.NewRequest("POST",
-		context.URL, bytes.NewBuffer(body))
+		u.String(), bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+		return nil, errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: Timeout,
-	}
+	client := NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+		return nil, errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+		return nil, errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
-	}
-
 	webhookResponse := &DiscordWebhookResponse{}
 	if err := json.Unmarshal(b, webhookResponse); err != nil {
-		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+		return nil, errors.Errorf("malformed webhook response from %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return webhookResponse, errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
 	}
 
 	if webhookResponse.Code != 0 {
-		return errors.Errorf("%s", webhookResponse.Message)
+		return webhookResponse, errors.Errorf("%s", webhookResponse.Message)
 	}
 
-	return nil
+	return webhookResponse, nil
 }
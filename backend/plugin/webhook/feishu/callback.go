@@ -0,0 +1,170 @@
+package feishu
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ActionApproveIssue is the action of the button to approve the issue.
+	ActionApproveIssue = "approve"
+	// ActionRejectIssue is the action of the button to reject the issue.
+	ActionRejectIssue = "reject"
+
+	// EventTypeCardActionTrigger is the event type of the card callback.
+	EventTypeCardActionTrigger = "card.action.trigger"
+	// TypeURLVerification is the type of the request to verify the callback URL.
+	TypeURLVerification = "url_verification"
+
+	// maxRequestAge is the maximum age of the callback request to prevent the replay attacks.
+	maxRequestAge = 5 * time.Minute
+)
+
+// CardActionValue is the value of the card button, which is sent back in the card callback.
+type CardActionValue struct {
+	Action string `json:"action"`
+	Issue  string `json:"issue"`
+}
+
+// CallbackRequest is the decrypted request of the card callback or the URL verification.
+// Docs: https://open.feishu.cn/document/uAjLw4CM/ukzMukzMukzM/feishu-cards/card-callback-communication
+type CallbackRequest struct {
+	// The fields of the URL verification.
+	Challenge string `json:"challenge"`
+	Token     string `json:"token"`
+	Type      string `json:"type"`
+
+	// The fields of the card callback.
+	Header struct {
+		EventType string `json:"event_type"`
+		Token     string `json:"token"`
+	} `json:"header"`
+	Event struct {
+		Operator struct {
+			OpenID string `json:"open_id"`
+		} `json:"operator"`
+		Action struct {
+			Value CardActionValue `json:"value"`
+		} `json:"action"`
+	} `json:"event"`
+}
+
+// CallbackToast is the toast shown to the user after the card callback.
+type CallbackToast struct {
+	// Type is one of "info", "success", "error" and "warning".
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+// CallbackResponse is the response of the card callback or the URL verification.
+type CallbackResponse struct {
+	Challenge string         `json:"challenge,omitempty"`
+	Toast     *CallbackToast `json:"toast,omitempty"`
+}
+
+type encryptedRequest struct {
+	Encrypt string `json:"encrypt"`
+}
+
+type getUserResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		User struct {
+			Email string `json:"email"`
+		} `json:"user"`
+	} `json:"data"`
+}
+
+// VerifyRequest verifies the signature and the timestamp of the request from Feishu.
+// Docs: https://open.feishu.cn/document/server-docs/event-subscription-guide/event-subscription-configure-/encrypt-key-encryption-configuration-case
+func VerifyRequest(encryptKey, timestamp, nonce, signature string, body []byte, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return errors.Errorf("request timestamp %q is too far from now", timestamp)
+	}
+	h := sha256.New()
+	_, _ = h.Write([]byte(timestamp + nonce + encryptKey))
+	_, _ = h.Write(body)
+	want := hex.EncodeToString(h.Sum(nil))
+	if subtle.ConstantTimeCompare([]byte(want), []byte(signature)) != 1 {
+		return errors.Errorf("invalid request signature")
+	}
+	return nil
+}
+
+// DecryptRequest decrypts the request body encrypted with the encrypt key.
+func DecryptRequest(encryptKey string, body []byte) (*CallbackRequest, error) {
+	var encrypted encryptedRequest
+	if err := json.Unmarshal(body, &encrypted); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal request")
+	}
+	if encrypted.Encrypt == "" {
+		return nil, errors.Errorf("request is not encrypted")
+	}
+	data, err := base64.StdEncoding.DecodeString(encrypted.Encrypt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode request")
+	}
+	if len(data) < aes.BlockSize || len(data)%aes.BlockSize != 0 {
+		return nil, errors.Errorf("invalid encrypted request size %d", len(data))
+	}
+	key := sha256.Sum256([]byte(encryptKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	iv, data := data[:aes.BlockSize], data[aes.BlockSize:]
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(data, data)
+	// Trim the PKCS#7 padding.
+	start, end := bytes.IndexByte(data, '{'), bytes.LastIndexByte(data, '}')
+	if start < 0 || end < start {
+		return nil, errors.Errorf("invalid decrypted request")
+	}
+
+	var request CallbackRequest
+	if err := json.Unmarshal(data[start:end+1], &request); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal decrypted request")
+	}
+	return &request, nil
+}
+
+// GetEmailByID returns the email of the Feishu user by the open ID.
+func GetEmailByID(ctx context.Context, appID, appSecret, openID string) (string, error) {
+	return newProvider(appID, appSecret).getEmailByID(ctx, openID)
+}
+
+// https://open.feishu.cn/document/server-docs/contact-v3/user/get
+func (p *provider) getEmailByID(ctx context.Context, openID string) (string, error) {
+	u := fmt.Sprintf("https://open.feishu.cn/open-apis/contact/v3/users/%s?user_id_type=open_id", url.PathEscape(openID))
+	b, err := p.do(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get user")
+	}
+
+	var response getUserResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return "", err
+	}
+	if response.Code != 0 {
+		return "", errors.Errorf("failed to get user, code %d, msg %s", response.Code, response.Msg)
+	}
+	return response.Data.User.Email, nil
+}
@@ -0,0 +1,58 @@
+package feishu
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encryptRequest(t *testing.T, encryptKey string, plaintext []byte) []byte {
+	key := sha256.Sum256([]byte(encryptKey))
+	block, err := aes.NewCipher(key[:])
+	require.NoError(t, err)
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	plaintext = append(plaintext, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	iv := []byte("0123456789abcdef")
+	data := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(data, plaintext)
+	body, err := json.Marshal(&encryptedRequest{Encrypt: base64.StdEncoding.EncodeToString(append(iv, data...))})
+	require.NoError(t, err)
+	return body
+}
+
+func TestDecryptRequest(t *testing.T) {
+	a := require.New(t)
+	const encryptKey = "kudryavka"
+	body := encryptRequest(t, encryptKey, []byte(`{"schema":"2.0","header":{"event_type":"card.action.trigger","token":"v"},"event":{"operator":{"open_id":"ou_1"},"action":{"value":{"action":"approve","issue":"projects/p/issues/1"}}}}`))
+
+	request, err := DecryptRequest(encryptKey, body)
+	a.NoError(err)
+	a.Equal(EventTypeCardActionTrigger, request.Header.EventType)
+	a.Equal("v", request.Header.Token)
+	a.Equal("ou_1", request.Event.Operator.OpenID)
+	a.Equal(CardActionValue{Action: ActionApproveIssue, Issue: "projects/p/issues/1"}, request.Event.Action.Value)
+
+	_, err = DecryptRequest("wrong", body)
+	a.Error(err)
+}
+
+func TestVerifyRequest(t *testing.T) {
+	a := require.New(t)
+	body := []byte(`{"encrypt":"x"}`)
+	h := sha256.Sum256([]byte("1700000000" + "nonce" + "key" + string(body)))
+	signature := hex.EncodeToString(h[:])
+	now := time.Unix(1700000000, 0)
+	a.NoError(VerifyRequest("key", "1700000000", "nonce", signature, body, now))
+	a.Error(VerifyRequest("wrong", "1700000000", "nonce", signature, body, now))
+	a.Error(VerifyRequest("key", "1700000001", "nonce", signature, body, now))
+	a.Error(VerifyRequest("key", "1700000000", "nonce", signature, body, now.Add(10*time.Minute)))
+	a.Error(VerifyRequest("key", "invalid", "nonce", signature, body, now))
+}
@@ -59,9 +59,19 @@ type WebhookCardConfig struct {
 }
 
 // WebhookMarkdownSection is the API message for Feishu webhook card i18n content markdown.
+// The element with the "action" tag has the actions instead of the content.
 type WebhookMarkdownSection struct {
-	Tag     string `json:"tag,omitempty"`
-	Content string `json:"content,omitempty"`
+	Tag     string              `json:"tag,omitempty"`
+	Content string              `json:"content,omitempty"`
+	Actions []WebhookCardButton `json:"actions,omitempty"`
+}
+
+// WebhookCardButton is the API message for Feishu webhook card button.
+type WebhookCardButton struct {
+	Tag   string                 `json:"tag"`
+	Text  WebhookCardHeaderTitle `json:"text"`
+	Type  string                 `json:"type"`
+	Value CardActionValue        `json:"value"`
 }
 
 // WebhookCardI18nElements is the API message for Feishu webhook card i18n content.
@@ -99,6 +109,8 @@ func init() {
 }
 
 // feishuReceiver is the receiver for Feishu.
+// The messages are not threaded because the custom bots don't return the ID of the created message,
+// which is required to reply in the thread.
 type feishuReceiver struct {
 }
 
@@ -145,7 +157,7 @@ func postDirectMessage(webhookCtx webhook.Context) {
 			if !ok {
 				continue
 			}
-			err := p.sendMessage(ctx, id, getMessageCard(webhookCtx))
+			err := p.sendMessage(ctx, id, getDirectMessageCard(webhookCtx))
 			if err != nil {
 				err = errors.Wrapf(err, "failed to send message")
 				multierr.AppendInto(&errs, err)
@@ -174,9 +186,7 @@ func postMessage(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
+	client := webhook.NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
@@ -238,3 +248,30 @@ func getMessageCard(context webhook.Context) *WebhookCard {
 		},
 	}
 }
+
+// getDirectMessageCard returns the message card sent by the app, which can call back on the approval actions.
+func getDirectMessageCard(context webhook.Context) *WebhookCard {
+	card := getMessageCard(context)
+	feishu := context.IMSetting.GetFeishu()
+	if context.ApprovalIssueName == "" || feishu.GetVerificationToken() == "" || feishu.GetEncryptKey() == "" {
+		return card
+	}
+	card.I18nElements.English = append(card.I18nElements.English, WebhookMarkdownSection{
+		Tag: "action",
+		Actions: []WebhookCardButton{
+			{
+				Tag:   "button",
+				Text:  WebhookCardHeaderTitle{Content: "Approve", Tag: "plain_text"},
+				Type:  "primary",
+				Value: CardActionValue{Action: ActionApproveIssue, Issue: context.ApprovalIssueName},
+			},
+			{
+				Tag:   "button",
+				Text:  WebhookCardHeaderTitle{Content: "Reject", Tag: "plain_text"},
+				Type:  "danger",
+				Value: CardActionValue{Action: ActionRejectIssue, Issue: context.ApprovalIssueName},
+			},
+		},
+	})
+	return card
+}
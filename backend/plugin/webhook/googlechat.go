@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GoogleChatWebhookThread is the API message for Google Chat webhook thread.
+type GoogleChatWebhookThread struct {
+	Name      string `json:"name,omitempty"`
+	ThreadKey string `json:"threadKey,omitempty"`
+}
+
+// GoogleChatWebhook is the API message for Google Chat webhook.
+type GoogleChatWebhook struct {
+	Text   string                   `json:"text"`
+	Thread *GoogleChatWebhookThread `json:"thread,omitempty"`
+}
+
+// GoogleChatWebhookResponse is the API message for Google Chat webhook response.
+type GoogleChatWebhookResponse struct {
+	Name   string                   `json:"name"`
+	Thread *GoogleChatWebhookThread `json:"thread"`
+}
+
+func init() {
+	Register("bb.plugin.webhook.googlechat", &GoogleChatReceiver{})
+}
+
+// GoogleChatReceiver is the receiver for Google Chat.
+type GoogleChatReceiver struct {
+}
+
+func (*GoogleChatReceiver) Post(context Context) error {
+	var lines []string
+	status := ""
+	switch context.Level {
+	case WebhookSuccess:
+		status = "✅ "
+	case WebhookWarn:
+		status = "⚠️ "
+	case WebhookError:
+		status = "❗ "
+	}
+	lines = append(lines, fmt.Sprintf("*%s%s*", status, context.Title))
+	if context.Description != "" {
+		lines = append(lines, context.Description)
+	}
+	for _, meta := range context.GetMetaList() {
+		lines = append(lines, fmt.Sprintf("*%s*: %s", meta.Name, meta.Value))
+	}
+	lines = append(lines, fmt.Sprintf("By %s (%s)", context.CreatorName, context.CreatorEmail))
+	if context.Link != "" {
+		lines = append(lines, fmt.Sprintf("<%s|View in Bytebase>", context.Link))
+	}
+
+	post := GoogleChatWebhook{
+		Text: strings.Join(lines, "\n"),
+	}
+	webhookURL := context.URL
+	if context.Thread != nil && context.Issue != nil {
+		// The thread key is chosen by us, so the thread is created by the first message with the key.
+		if context.Thread.Key == "" {
+			context.Thread.Key = fmt.Sprintf("bytebase-issue-%d", context.Issue.ID)
+		}
+		post.Thread = &GoogleChatWebhookThread{ThreadKey: context.Thread.Key}
+		u, err := url.Parse(context.URL)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse webhook URL %s", context.URL)
+		}
+		q := u.Query()
+		q.Set("messageReplyOption", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
+		u.RawQuery = q.Encode()
+		webhookURL = u.String()
+	}
+	body, err := json.Marshal(post)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+	}
+	req, err := http.NewRequest("POST",
+		webhookURL, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	client := NewHTTPClient(context)
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+	}
+
+	webhookResponse := &GoogleChatWebhookResponse{}
+	if err := json.Unmarshal(b, webhookResponse); err != nil {
+		return errors.Wrapf(err, "malformed webhook response from %s", context.URL)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MattermostWebhook is the API message for Mattermost webhook.
+type MattermostWebhook struct {
+	Text     string `json:"text"`
+	Username string `json:"username,omitempty"`
+}
+
+func init() {
+	Register("bb.plugin.webhook.mattermost", &MattermostReceiver{})
+}
+
+// MattermostReceiver is the receiver for Mattermost.
+// The messages are not threaded because the incoming webhooks don't return the created post.
+type MattermostReceiver struct {
+}
+
+func (*MattermostReceiver) Post(context Context) error {
+	var lines []string
+	status := ""
+	switch context.Level {
+	case WebhookSuccess:
+		status = ":white_check_mark: "
+	case WebhookWarn:
+		status = ":warning: "
+	case WebhookError:
+		status = ":exclamation: "
+	}
+	title := fmt.Sprintf("#### %s%s", status, context.Title)
+	if context.Link != "" {
+		title = fmt.Sprintf("#### %s[%s](%s)", status, context.Title, context.Link)
+	}
+	lines = append(lines, title)
+	if context.Description != "" {
+		lines = append(lines, context.Description, "")
+	}
+	for _, meta := range context.GetMetaList() {
+		lines = append(lines, fmt.Sprintf("**%s**: %s", meta.Name, meta.Value))
+	}
+	lines = append(lines, "", fmt.Sprintf("By %s (%s)", context.CreatorName, context.CreatorEmail))
+
+	post := MattermostWebhook{
+		Text:     strings.Join(lines, "\n"),
+		Username: "Bytebase",
+	}
+	body, err := json.Marshal(post)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+	}
+	req, err := http.NewRequest("POST",
+		context.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	client := NewHTTPClient(context)
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+	}
+
+	return nil
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
 )
 
+// apiURL is the base URL of the Slack Web API.
+var apiURL = "https://slack.com/api"
+
 type provider struct {
 	c     *http.Client
 	token string
@@ -54,11 +57,13 @@ type conversationsOpenResponse struct {
 type chatPostMessageResponse struct {
 	OK    bool   `json:"ok"`
 	Error string `json:"error"`
+	// TS is the timestamp of the message, which is the ID of the thread started by the message.
+	TS string `json:"ts"`
 }
 
 // https://api.slack.com/methods/auth.test
 func (p *provider) authTest(ctx context.Context) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://slack.com/api/auth.test", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/auth.test", nil)
 	if err != nil {
 		return errors.Wrapf(err, "failed to new request")
 	}
@@ -122,7 +127,7 @@ func (p *provider) lookupByEmail(ctx context.Context, email string) (id string,
 
 	q := url.Values{}
 	q.Set("email", email)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://slack.com/api/users.lookupByEmail", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+"/users.lookupByEmail", nil)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to new request")
 	}
@@ -164,7 +169,7 @@ func (p *provider) lookupByEmail(ctx context.Context, email string) (id string,
 func (p *provider) openConversation(ctx context.Context, userID string) (string, error) {
 	data := url.Values{}
 	data.Set("users", userID)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://slack.com/api/conversations.open", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/conversations.open", strings.NewReader(data.Encode()))
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to new request")
 	}
@@ -197,45 +202,51 @@ func (p *provider) openConversation(ctx context.Context, userID string) (string,
 }
 
 // https://api.slack.com/methods/chat.postMessage
-func (p *provider) chatPostMessage(ctx context.Context, channelID string, webhookContext webhook.Context) error {
+// The message replies in the thread if the threadTS is set. It returns the timestamp of the message.
+func (p *provider) chatPostMessage(ctx context.Context, channelID string, webhookContext webhook.Context, threadTS string) (string, error) {
 	blocks := GetBlocks(webhookContext)
 	blocksJSON, err := json.Marshal(blocks)
 	if err != nil {
-		return errors.Wrapf(err, "failed to marshal blocks")
+		return "", errors.Wrapf(err, "failed to marshal blocks")
 	}
 
 	data := url.Values{}
 	data.Set("channel", channelID)
 	data.Set("blocks", string(blocksJSON))
+	// The text is the fallback of the blocks in the notifications.
+	data.Set("text", webhookContext.Title)
+	if threadTS != "" {
+		data.Set("thread_ts", threadTS)
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://slack.com/api/chat.postMessage", strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+"/chat.postMessage", strings.NewReader(data.Encode()))
 	if err != nil {
-		return errors.Wrapf(err, "failed to new request")
+		return "", errors.Wrapf(err, "failed to new request")
 	}
 	req.Header.Add("Authorization", "Bearer "+p.token)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := p.c.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to send request")
+		return "", errors.Wrapf(err, "failed to send request")
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("received non-200 status code %d", resp.StatusCode)
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read body")
+		return "", errors.Wrapf(err, "failed to read body")
 	}
 	var res chatPostMessageResponse
 	if err := json.Unmarshal(body, &res); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal")
+		return "", errors.Wrapf(err, "failed to unmarshal")
 	}
 	if !res.OK {
-		return errors.Errorf("failed to post message, error: %v", res.Error)
+		return "", errors.Errorf("failed to post message, error: %v", res.Error)
 	}
 
-	return nil
+	return res.TS, nil
 }
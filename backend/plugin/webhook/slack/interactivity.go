@@ -0,0 +1,149 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ActionApproveIssue is the action ID of the button to approve the issue.
+	ActionApproveIssue = "bb_approve_issue"
+	// ActionRejectIssue is the action ID of the button to reject the issue.
+	ActionRejectIssue = "bb_reject_issue"
+
+	signatureVersion = "v0"
+	// maxRequestAge is the maximum age of the interactive request to prevent the replay attacks.
+	maxRequestAge = 5 * time.Minute
+)
+
+// InteractionAction is the action of the block actions payload.
+type InteractionAction struct {
+	ActionID string `json:"action_id"`
+	Value    string `json:"value"`
+}
+
+// InteractionPayload is the payload of the interactive request.
+// Docs: https://api.slack.com/reference/interaction-payloads/block-actions
+type InteractionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	ResponseURL string               `json:"response_url"`
+	Actions     []*InteractionAction `json:"actions"`
+}
+
+type usersInfoResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	User  struct {
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
+// VerifyRequest verifies the signature and the timestamp of the request from Slack.
+// Docs: https://api.slack.com/authentication/verifying-requests-from-slack
+func VerifyRequest(signingSecret, timestamp, signature string, body []byte, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid request timestamp %q", timestamp)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > maxRequestAge || age < -maxRequestAge {
+		return errors.Errorf("request timestamp %q is too far from now", timestamp)
+	}
+	m := hmac.New(sha256.New, []byte(signingSecret))
+	if _, err := m.Write([]byte(signatureVersion + ":" + timestamp + ":")); err != nil {
+		return err
+	}
+	if _, err := m.Write(body); err != nil {
+		return err
+	}
+	want := signatureVersion + "=" + hex.EncodeToString(m.Sum(nil))
+	if !hmac.Equal([]byte(want), []byte(signature)) {
+		return errors.Errorf("invalid request signature")
+	}
+	return nil
+}
+
+// GetUserEmail returns the email of the Slack user.
+func GetUserEmail(ctx context.Context, token, userID string) (string, error) {
+	return newProvider(token).getUserEmail(ctx, userID)
+}
+
+// https://api.slack.com/methods/users.info
+func (p *provider) getUserEmail(ctx context.Context, userID string) (string, error) {
+	q := url.Values{}
+	q.Set("user", userID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://slack.com/api/users.info", nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to new request")
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Add("Authorization", "Bearer "+p.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to send GET request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read body")
+	}
+	var res usersInfoResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+	if !res.OK {
+		return "", errors.Errorf("failed to get user, error: %v", res.Error)
+	}
+	return res.User.Profile.Email, nil
+}
+
+// Respond replies the message only visible to the user of the interaction.
+// Docs: https://api.slack.com/interactivity/handling#message_responses
+func Respond(ctx context.Context, responseURL, text string) error {
+	body, err := json.Marshal(map[string]any{
+		"response_type":    "ephemeral",
+		"replace_original": false,
+		"text":             text,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal response")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := (&http.Client{Timeout: 10 * time.Second}).Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+	return nil
+}
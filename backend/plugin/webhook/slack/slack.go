@@ -31,9 +31,12 @@ type ElementButton struct {
 
 // Element is the API message for Slack webhook element.
 type Element struct {
-	Type   string        `json:"type"`
-	Button ElementButton `json:"text,omitempty"`
-	URL    string        `json:"url,omitempty"`
+	Type     string        `json:"type"`
+	Button   ElementButton `json:"text,omitempty"`
+	URL      string        `json:"url,omitempty"`
+	ActionID string        `json:"action_id,omitempty"`
+	Value    string        `json:"value,omitempty"`
+	Style    string        `json:"style,omitempty"`
 }
 
 // Block is the API message for Slack webhook block.
@@ -54,6 +57,8 @@ func init() {
 }
 
 // Receiver is the receiver for Slack.
+// The incoming webhooks don't return the timestamp of the created message, which is required to reply in the thread.
+// So the messages are posted by the Slack app of the IM integration if the channel is set, which replies in the thread of the issue.
 type Receiver struct {
 }
 
@@ -105,18 +110,44 @@ func GetBlocks(context webhook.Context) []Block {
 		},
 	})
 
-	blockList = append(blockList, Block{
-		Type: "actions",
-		ElementList: []Element{
-			{
+	elementList := []Element{
+		{
+			Type: "button",
+			Button: ElementButton{
+				Type: "plain_text",
+				Text: "View in Bytebase",
+			},
+			URL: context.Link,
+		},
+	}
+	// The interactive requests can only be verified with the signing secret.
+	if context.ApprovalIssueName != "" && context.IMSetting.GetSlack().GetSigningSecret() != "" {
+		elementList = append(elementList,
+			Element{
 				Type: "button",
 				Button: ElementButton{
 					Type: "plain_text",
-					Text: "View in Bytebase",
+					Text: "Approve",
 				},
-				URL: context.Link,
+				ActionID: ActionApproveIssue,
+				Value:    context.ApprovalIssueName,
+				Style:    "primary",
 			},
-		},
+			Element{
+				Type: "button",
+				Button: ElementButton{
+					Type: "plain_text",
+					Text: "Reject",
+				},
+				ActionID: ActionRejectIssue,
+				Value:    context.ApprovalIssueName,
+				Style:    "danger",
+			},
+		)
+	}
+	blockList = append(blockList, Block{
+		Type:        "actions",
+		ElementList: elementList,
 	})
 
 	return blockList
@@ -127,9 +158,31 @@ func (*Receiver) Post(context webhook.Context) error {
 		postDirectMessage(context)
 		return nil
 	}
+	if token := context.IMSetting.GetSlack().GetToken(); token != "" && context.SlackChannelID != "" {
+		return postChannelMessage(context, token)
+	}
 	return postMessage(context)
 }
 
+// postChannelMessage posts the message to the channel by the Slack app.
+// The messages of the issue reply in the thread started by the first message.
+func postChannelMessage(webhookCtx webhook.Context, token string) error {
+	p := newProvider(token)
+	p.c = webhook.NewHTTPClient(webhookCtx)
+	threadTS := ""
+	if webhookCtx.Thread != nil {
+		threadTS = webhookCtx.Thread.Key
+	}
+	ts, err := p.chatPostMessage(context.Background(), webhookCtx.SlackChannelID, webhookCtx, threadTS)
+	if err != nil {
+		return errors.Wrapf(err, "failed to post message to Slack channel %s", webhookCtx.SlackChannelID)
+	}
+	if webhookCtx.Thread != nil && webhookCtx.Thread.Key == "" && webhookCtx.Issue != nil {
+		webhookCtx.Thread.Key = ts
+	}
+	return nil
+}
+
 func postMessage(context webhook.Context) error {
 	blockList := GetBlocks(context)
 
@@ -148,9 +201,7 @@ func postMessage(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
+	client := webhook.NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
@@ -199,7 +250,7 @@ func postDirectMessage(webhookCtx webhook.Context) {
 				if err != nil {
 					return errors.Wrapf(err, "failed to open conversation")
 				}
-				if err := p.chatPostMessage(ctx, channelID, webhookCtx); err != nil {
+				if _, err := p.chatPostMessage(ctx, channelID, webhookCtx, ""); err != nil {
 					return errors.Wrapf(err, "failed to post message")
 				}
 				sent[u.Email] = true
@@ -0,0 +1,89 @@
+package slack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestReceiverChannelThread(t *testing.T) {
+	a := require.New(t)
+	var threadTSs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("/chat.postMessage", r.URL.Path)
+		a.Equal("Bearer xoxb-token", r.Header.Get("Authorization"))
+		a.NoError(r.ParseForm())
+		a.Equal("C0123456789", r.PostForm.Get("channel"))
+		a.NotEmpty(r.PostForm.Get("blocks"))
+		threadTSs = append(threadTSs, r.PostForm.Get("thread_ts"))
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C0123456789","ts":"1700000000.000100"}`))
+	}))
+	defer server.Close()
+	defer func(u string) { apiURL = u }(apiURL)
+	apiURL = server.URL
+
+	thread := &webhook.Thread{}
+	context := webhook.Context{
+		URL:            "https://hooks.slack.com/services/T/B/X",
+		Title:          "Issue created",
+		Issue:          &webhook.Issue{ID: 7, Name: "Add table"},
+		SlackChannelID: "C0123456789",
+		IMSetting:      &storepb.AppIMSetting{Slack: &storepb.AppIMSetting_Slack{Enabled: true, Token: "xoxb-token"}},
+		Thread:         thread,
+	}
+	a.NoError(webhook.Post("bb.plugin.webhook.slack", context))
+	a.Equal("1700000000.000100", thread.Key)
+
+	context.Title = "Issue approved"
+	a.NoError(webhook.Post("bb.plugin.webhook.slack", context))
+
+	a.Equal([]string{"", "1700000000.000100"}, threadTSs)
+}
+
+func TestReceiverChannelError(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error":"not_in_channel"}`))
+	}))
+	defer server.Close()
+	defer func(u string) { apiURL = u }(apiURL)
+	apiURL = server.URL
+
+	thread := &webhook.Thread{}
+	err := webhook.Post("bb.plugin.webhook.slack", webhook.Context{
+		Title:          "Issue created",
+		Issue:          &webhook.Issue{ID: 7},
+		SlackChannelID: "C0123456789",
+		IMSetting:      &storepb.AppIMSetting{Slack: &storepb.AppIMSetting_Slack{Token: "xoxb-token"}},
+		Thread:         thread,
+	})
+	a.ErrorContains(err, "not_in_channel")
+	a.Empty(thread.Key)
+}
+
+func TestReceiverIncomingWebhook(t *testing.T) {
+	a := require.New(t)
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested = true
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	// The incoming webhook is used without the token of the Slack app, and the messages are not threaded.
+	thread := &webhook.Thread{}
+	a.NoError(webhook.Post("bb.plugin.webhook.slack", webhook.Context{
+		URL:            server.URL,
+		Title:          "Issue created",
+		Issue:          &webhook.Issue{ID: 7},
+		SlackChannelID: "C0123456789",
+		Thread:         thread,
+	}))
+	a.True(requested)
+	a.Empty(thread.Key)
+}
@@ -55,6 +55,7 @@ func init() {
 }
 
 // TeamsReceiver is the receiver for Teams.
+// The messages are not threaded because the incoming webhooks can't reply to a message.
 type TeamsReceiver struct {
 }
 
@@ -101,9 +102,7 @@ func (*TeamsReceiver) Post(context Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: Timeout,
-	}
+	client := NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TelegramReplyParameters is the API message for Telegram reply parameters.
+type TelegramReplyParameters struct {
+	MessageID                int64 `json:"message_id"`
+	AllowSendingWithoutReply bool  `json:"allow_sending_without_reply"`
+}
+
+// TelegramWebhook is the API message for Telegram sendMessage.
+// Docs: https://core.telegram.org/bots/api#sendmessage
+type TelegramWebhook struct {
+	ChatID          string                   `json:"chat_id"`
+	Text            string                   `json:"text"`
+	ParseMode       string                   `json:"parse_mode"`
+	ReplyParameters *TelegramReplyParameters `json:"reply_parameters,omitempty"`
+}
+
+// TelegramWebhookResponse is the API message for Telegram sendMessage response.
+type TelegramWebhookResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+	Result      struct {
+		MessageID int64 `json:"message_id"`
+	} `json:"result"`
+}
+
+func init() {
+	Register("bb.plugin.webhook.telegram", &TelegramReceiver{})
+}
+
+// TelegramReceiver is the receiver for Telegram.
+// The webhook URL is the sendMessage URL of the bot with the chat_id query,
+// e.g. https://api.telegram.org/bot{token}/sendMessage?chat_id={chat_id}.
+type TelegramReceiver struct {
+}
+
+func (*TelegramReceiver) Post(context Context) error {
+	// The URL contains the bot token, so it's never included in the errors.
+	u, err := url.Parse(context.URL)
+	if err != nil {
+		return errors.Errorf("failed to parse Telegram webhook URL")
+	}
+	q := u.Query()
+	chatID := q.Get("chat_id")
+	if chatID == "" {
+		return errors.Errorf("chat_id is required in the Telegram webhook URL")
+	}
+	q.Del("chat_id")
+	u.RawQuery = q.Encode()
+
+	var lines []string
+	status := ""
+	switch context.Level {
+	case WebhookSuccess:
+		status = "✅ "
+	case WebhookWarn:
+		status = "⚠️ "
+	case WebhookError:
+		status = "❗ "
+	}
+	title := fmt.Sprintf("<b>%s%s</b>", status, html.EscapeString(context.Title))
+	if context.Link != "" {
+		title = fmt.Sprintf(`%s<a href="%s">%s</a>`, status, html.EscapeString(context.Link), html.EscapeString(context.Title))
+	}
+	lines = append(lines, title)
+	if context.Description != "" {
+		lines = append(lines, html.EscapeString(context.Description))
+	}
+	for _, meta := range context.GetMetaList() {
+		lines = append(lines, fmt.Sprintf("<b>%s</b>: %s", html.EscapeString(meta.Name), html.EscapeString(meta.Value)))
+	}
+	lines = append(lines, html.EscapeString(fmt.Sprintf("By %s (%s)", context.CreatorName, context.CreatorEmail)))
+
+	post := TelegramWebhook{
+		ChatID:    chatID,
+		Text:      strings.Join(lines, "\n"),
+		ParseMode: "HTML",
+	}
+	// The messages of the thread reply to the first message.
+	if context.Thread != nil && context.Thread.Key != "" {
+		messageID, err := strconv.ParseInt(context.Thread.Key, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid Telegram thread key %q", context.Thread.Key)
+		}
+		post.ReplyParameters = &TelegramReplyParameters{
+			MessageID:                messageID,
+			AllowSendingWithoutReply: true,
+		}
+	}
+	body, err := json.Marshal(post)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal Telegram message")
+	}
+	req, err := http.NewRequest("POST",
+		u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Errorf("failed to construct Telegram POST request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	client := NewHTTPClient(context)
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Errorf("failed to POST Telegram message to chat %s", chatID)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read Telegram response")
+	}
+	defer resp.Body.Close()
+
+	webhookResponse := &TelegramWebhookResponse{}
+	if err := json.Unmarshal(b, webhookResponse); err != nil {
+		return errors.Errorf("malformed Telegram response, status code: %d, response body: %s", resp.StatusCode, b)
+	}
+	if resp.StatusCode != http.StatusOK || !webhookResponse.OK {
+		return errors.Errorf("failed to POST Telegram message to chat %s, status code: %d, description: %s", chatID, resp.StatusCode, webhookResponse.Description)
+	}
+
+	if context.Thread != nil && context.Thread.Key == "" && context.Issue != nil {
+		context.Thread.Key = strconv.FormatInt(webhookResponse.Result.MessageID, 10)
+	}
+	return nil
+}
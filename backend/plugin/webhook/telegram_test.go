@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramReceiverThread(t *testing.T) {
+	a := require.New(t)
+	var requests []TelegramWebhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("/bottoken/sendMessage", r.URL.Path)
+		a.Empty(r.URL.Query().Get("chat_id"))
+		var post TelegramWebhook
+		a.NoError(json.NewDecoder(r.Body).Decode(&post))
+		requests = append(requests, post)
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":42}}`))
+	}))
+	defer server.Close()
+
+	thread := &Thread{}
+	context := Context{
+		URL:    server.URL + "/bottoken/sendMessage?chat_id=-100123",
+		Title:  "Issue <a> created",
+		Issue:  &Issue{ID: 1, Name: "a"},
+		Thread: thread,
+	}
+	a.NoError(Post("bb.plugin.webhook.telegram", context))
+	a.Equal("42", thread.Key)
+
+	context.Title = "Issue approved"
+	a.NoError(Post("bb.plugin.webhook.telegram", context))
+
+	a.Len(requests, 2)
+	a.Equal("-100123", requests[0].ChatID)
+	a.Contains(requests[0].Text, "Issue &lt;a&gt; created")
+	a.Nil(requests[0].ReplyParameters)
+	a.Equal(int64(42), requests[1].ReplyParameters.MessageID)
+}
+
+func TestGoogleChatReceiverThread(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("k", r.URL.Query().Get("key"))
+		a.Equal("REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD", r.URL.Query().Get("messageReplyOption"))
+		var post GoogleChatWebhook
+		a.NoError(json.NewDecoder(r.Body).Decode(&post))
+		a.Equal("bytebase-issue-7", post.Thread.ThreadKey)
+		_, _ = w.Write([]byte(`{"name":"spaces/a/messages/b","thread":{"name":"spaces/a/threads/c"}}`))
+	}))
+	defer server.Close()
+
+	thread := &Thread{}
+	a.NoError(Post("bb.plugin.webhook.googlechat", Context{
+		URL:    server.URL + "/v1/spaces/a/messages?key=k",
+		Title:  "Issue created",
+		Issue:  &Issue{ID: 7},
+		Thread: thread,
+	}))
+	a.Equal("bytebase-issue-7", thread.Key)
+}
+
+func TestDiscordReceiverThread(t *testing.T) {
+	a := require.New(t)
+	var requests []DiscordWebhook
+	var threadIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("true", r.URL.Query().Get("wait"))
+		var post DiscordWebhook
+		a.NoError(json.NewDecoder(r.Body).Decode(&post))
+		requests = append(requests, post)
+		threadIDs = append(threadIDs, r.URL.Query().Get("thread_id"))
+		_, _ = w.Write([]byte(`{"id":"1","channel_id":"100"}`))
+	}))
+	defer server.Close()
+
+	thread := &Thread{}
+	context := Context{
+		URL:    server.URL + "/api/webhooks/1/token",
+		Title:  "Issue created",
+		Issue:  &Issue{ID: 7, Name: "Add table"},
+		Thread: thread,
+	}
+	a.NoError(Post("bb.plugin.webhook.discord", context))
+	a.Equal("100", thread.Key)
+
+	context.Title = "Issue approved"
+	a.NoError(Post("bb.plugin.webhook.discord", context))
+
+	a.Len(requests, 2)
+	a.Equal("#7 Add table", requests[0].ThreadName)
+	a.Empty(threadIDs[0])
+	a.Empty(requests[1].ThreadName)
+	a.Equal("100", threadIDs[1])
+}
+
+func TestDiscordReceiverTextChannel(t *testing.T) {
+	a := require.New(t)
+	var requests []DiscordWebhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var post DiscordWebhook
+		a.NoError(json.NewDecoder(r.Body).Decode(&post))
+		requests = append(requests, post)
+		if post.ThreadName != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":220003,"message":"Webhooks can only create threads in forum channels"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"1","channel_id":"100"}`))
+	}))
+	defer server.Close()
+
+	thread := &Thread{}
+	a.NoError(Post("bb.plugin.webhook.discord", Context{
+		URL:    server.URL + "/api/webhooks/1/token",
+		Title:  "Issue created",
+		Issue:  &Issue{ID: 7, Name: "Add table"},
+		Thread: thread,
+	}))
+	a.Empty(thread.Key)
+	a.Len(requests, 2)
+}
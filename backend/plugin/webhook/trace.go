@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// maxTraceBodySize is the maximum size of the request and response body kept in the trace.
+const maxTraceBodySize = 16 * 1024
+
+// Trace is the HTTP exchange with the webhook endpoint.
+// The bodies are truncated to maxTraceBodySize.
+type Trace struct {
+	RequestBody  string
+	ResponseCode int
+	ResponseBody string
+}
+
+// NewHTTPClient returns the HTTP client to post the webhook of the context.
+// The exchange is recorded to the trace of the context if set.
+func NewHTTPClient(context Context) *http.Client {
+	client := &http.Client{
+		Timeout: Timeout,
+	}
+	if context.Trace != nil {
+		client.Transport = &traceTransport{trace: context.Trace}
+	}
+	return client
+}
+
+type traceTransport struct {
+	trace *Trace
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(io.LimitReader(body, maxTraceBodySize))
+		body.Close()
+		if err != nil {
+			return nil, err
+		}
+		t.trace.RequestBody = string(b)
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	// Only the head of the response body is read for the trace, the rest is streamed to the caller.
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxTraceBodySize))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = &traceBody{Reader: io.MultiReader(bytes.NewReader(b), resp.Body), Closer: resp.Body}
+	t.trace.ResponseCode = resp.StatusCode
+	t.trace.ResponseBody = string(b)
+	return resp, nil
+}
+
+// traceBody is the response body with the head already read for the trace.
+type traceBody struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomReceiverTrace(t *testing.T) {
+	a := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		a.Contains(string(body), `"title":"test"`)
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream unavailable"))
+	}))
+	defer server.Close()
+
+	trace := &Trace{}
+	err := Post("bb.plugin.webhook.custom", Context{
+		URL:   server.URL,
+		Title: "test",
+		Trace: trace,
+	})
+	a.Error(err)
+	a.Contains(trace.RequestBody, `"title":"test"`)
+	a.Equal(http.StatusBadGateway, trace.ResponseCode)
+	a.Equal("upstream unavailable", trace.ResponseBody)
+}
+
+func TestTraceLargeResponse(t *testing.T) {
+	a := require.New(t)
+	body := strings.Repeat("x", maxTraceBodySize+10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	trace := &Trace{}
+	resp, err := NewHTTPClient(Context{Trace: trace}).Get(server.URL)
+	a.NoError(err)
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	a.NoError(err)
+	// The caller still reads the whole body while the trace keeps the head.
+	a.Equal(body, string(b))
+	a.Equal(body[:maxTraceBodySize], trace.ResponseBody)
+}
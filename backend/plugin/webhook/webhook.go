@@ -63,6 +63,13 @@ type TaskResult struct {
 	SkippedReason string `json:"skippedReason"`
 }
 
+// Database is the database related to the event.
+// The `name` field is empty if the event is at the instance level.
+type Database struct {
+	Instance string `json:"instance"`
+	Name     string `json:"name"`
+}
+
 // Project object of project.
 type Project struct {
 	ID   int    `json:"id"`
@@ -86,12 +93,35 @@ type Context struct {
 	Stage        *Stage
 	Project      *Project
 	TaskResult   *TaskResult
+	Database     *Database
 	// End users that should be mentioned.
-	MentionEndUsers     []*store.UserMessage
+	// It is not persisted with the context since the user message carries credentials.
+	MentionEndUsers     []*store.UserMessage `json:"-"`
 	MentionUsersByPhone []string
 
 	DirectMessage bool
-	IMSetting     *storepb.AppIMSetting
+	IMSetting     *storepb.AppIMSetting `json:"-"`
+	// ApprovalIssueName is the name of the issue waiting for the approval, e.g. projects/{project}/issues/{issue}.
+	// The receivers supporting the interactive messages render the approve and reject actions if set.
+	ApprovalIssueName string
+
+	// CustomConfig is the config of the custom webhook.
+	CustomConfig *storepb.CustomWebhookConfig `json:"-"`
+	// SlackChannelID is the channel of the Slack webhook, where the Slack app posts the messages in threads.
+	SlackChannelID string `json:"-"`
+
+	// Trace records the HTTP exchange with the webhook endpoint if set.
+	Trace *Trace `json:"-"`
+	// Thread is the message thread of the issue if set.
+	// The receivers supporting threads post the message to the thread of the key,
+	// or start a new thread and set the key if the key is empty.
+	// Telegram, Google Chat, the Discord forum channels and Slack with the channel ID and the IM integration support threads.
+	Thread *Thread `json:"-"`
+}
+
+// Thread is the message thread of the webhook receiver.
+type Thread struct {
+	Key string
 }
 
 // Receiver is the webhook receiver.
@@ -131,6 +161,19 @@ func (c *Context) GetMetaList() []Meta {
 		})
 	}
 
+	if c.Database != nil {
+		m = append(m, Meta{
+			Name:  "Instance",
+			Value: c.Database.Instance,
+		})
+		if c.Database.Name != "" {
+			m = append(m, Meta{
+				Name:  "Database",
+				Value: c.Database.Name,
+			})
+		}
+	}
+
 	if c.TaskResult != nil {
 		m = append(m, Meta{
 			Name:  "Task",
@@ -189,6 +232,19 @@ func (c *Context) GetMetaListZh() []Meta {
 		})
 	}
 
+	if c.Database != nil {
+		m = append(m, Meta{
+			Name:  "实例",
+			Value: c.Database.Instance,
+		})
+		if c.Database.Name != "" {
+			m = append(m, Meta{
+				Name:  "数据库",
+				Value: c.Database.Name,
+			})
+		}
+	}
+
 	if c.TaskResult != nil {
 		m = append(m, Meta{
 			Name:  "任务",
@@ -41,6 +41,7 @@ func init() {
 }
 
 // Receiver is the receiver for WeCom.
+// The messages are not threaded because the group robots can't reply to a message.
 type Receiver struct {
 }
 
@@ -98,9 +99,7 @@ func (*Receiver) sendMessage(context webhook.Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{
-		Timeout: webhook.Timeout,
-	}
+	client := webhook.NewHTTPClient(context)
 	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
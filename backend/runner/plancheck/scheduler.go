@@ -12,6 +12,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
@@ -23,11 +24,12 @@ const (
 )
 
 // NewScheduler creates a new plan check scheduler.
-func NewScheduler(s *store.Store, licenseService enterprise.LicenseService, stateCfg *state.State) *Scheduler {
+func NewScheduler(s *store.Store, licenseService enterprise.LicenseService, stateCfg *state.State, webhookManager *webhook.Manager) *Scheduler {
 	return &Scheduler{
 		store:          s,
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
+		webhookManager: webhookManager,
 		executors:      make(map[store.PlanCheckRunType]Executor),
 	}
 }
@@ -37,6 +39,7 @@ type Scheduler struct {
 	store          *store.Store
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
+	webhookManager *webhook.Manager
 	executors      map[store.PlanCheckRunType]Executor
 }
 
@@ -154,7 +157,67 @@ func (s *Scheduler) markPlanCheckRunDone(ctx context.Context, planCheckRun *stor
 		planCheckRun.UID,
 	); err != nil {
 		slog.Error("failed to mark plan check run done", log.BBError(err))
+		return
+	}
+
+	var errorResults []string
+	for _, r := range results {
+		if r.Status == storepb.PlanCheckRunResult_Result_ERROR {
+			errorResults = append(errorResults, fmt.Sprintf("%s: %s", r.Title, r.Content))
+		}
+	}
+	if len(errorResults) > 0 {
+		if err := s.createPlanCheckRunErrorEvent(ctx, planCheckRun, errorResults); err != nil {
+			slog.Error("failed to create plan check run error event", slog.Int("uid", planCheckRun.UID), log.BBError(err))
+		}
+	}
+}
+
+func (s *Scheduler) createPlanCheckRunErrorEvent(ctx context.Context, planCheckRun *store.PlanCheckRunMessage, errorResults []string) error {
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planCheckRun.PlanUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil {
+		return errors.Errorf("plan %d not found", planCheckRun.PlanUID)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &plan.ProjectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project")
+	}
+	if project == nil {
+		return errors.Errorf("project %q not found", plan.ProjectID)
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PlanUID: &plan.UID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	instanceUID := int(planCheckRun.Config.InstanceUid)
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get instance")
+	}
+	if instance == nil {
+		return errors.Errorf("instance %d not found", instanceUID)
+	}
+
+	event := &webhook.Event{
+		Actor:   s.store.GetSystemBotUser(ctx),
+		Type:    webhook.EventTypePlanCheckRunError,
+		Project: webhook.NewProject(project),
+		PlanCheckRunError: &webhook.EventPlanCheckRunError{
+			PlanUID:       plan.UID,
+			PlanTitle:     plan.Name,
+			InstanceTitle: instance.Title,
+			DatabaseName:  planCheckRun.Config.DatabaseName,
+			Errors:        errorResults,
+		},
+	}
+	if issue != nil {
+		event.Issue = webhook.NewIssue(issue)
 	}
+	s.webhookManager.CreateEvent(ctx, event)
+	return nil
 }
 
 func (s *Scheduler) markPlanCheckRunFailed(ctx context.Context, planCheckRun *store.PlanCheckRunMessage, reason string) {
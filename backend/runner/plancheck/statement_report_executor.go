@@ -163,7 +163,7 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 
 	switch instance.Engine {
 	case storepb.Engine_POSTGRES:
-		pd, ok := driver.(*pgdriver.Driver)
+		pd, ok := db.UnwrapDriver(driver).(*pgdriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid pg driver type")
 		}
@@ -175,7 +175,7 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		}
 		defaultSchema = "public"
 	case storepb.Engine_MYSQL, storepb.Engine_OCEANBASE:
-		md, ok := driver.(*mysqldriver.Driver)
+		md, ok := db.UnwrapDriver(driver).(*mysqldriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid mysql driver type")
 		}
@@ -189,7 +189,7 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		}
 		defaultSchema = ""
 	case storepb.Engine_TIDB:
-		md, ok := driver.(*tidbdriver.Driver)
+		md, ok := db.UnwrapDriver(driver).(*tidbdriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid tidb driver type")
 		}
@@ -202,7 +202,7 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		}
 		defaultSchema = ""
 	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
-		od, ok := driver.(*oracledriver.Driver)
+		od, ok := db.UnwrapDriver(driver).(*oracledriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid oracle driver type")
 		}
@@ -210,7 +210,7 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 
 		defaultSchema = database.DatabaseName
 	case storepb.Engine_MSSQL:
-		md, ok := driver.(*mssqldriver.Driver)
+		md, ok := db.UnwrapDriver(driver).(*mssqldriver.Driver)
 		if !ok {
 			return nil, errors.Errorf("invalid mssql driver type")
 		}
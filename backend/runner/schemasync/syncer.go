@@ -20,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -38,13 +39,14 @@ const (
 )
 
 // NewSyncer creates a schema syncer.
-func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile, licenseService enterprise.LicenseService) *Syncer {
+func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile, licenseService enterprise.LicenseService, webhookManager *webhook.Manager) *Syncer {
 	return &Syncer{
 		store:          stores,
 		dbFactory:      dbFactory,
 		stateCfg:       stateCfg,
 		profile:        profile,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -57,6 +59,7 @@ type Syncer struct {
 	stateCfg        *state.State
 	profile         *config.Profile
 	licenseService  enterprise.LicenseService
+	webhookManager  *webhook.Manager
 	databaseSyncMap sync.Map // map[int]*store.DatabaseMessage
 }
 
@@ -468,17 +471,21 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 						slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
 						log.BBError(err))
 				} else {
-					if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+					_, created, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
 						InstanceID:  instance.ResourceID,
 						DatabaseUID: &database.UID,
 						Type:        api.AnomalyDatabaseSchemaDrift,
 						Payload:     string(payload),
-					}); err != nil {
+					})
+					if err != nil {
 						slog.Error("Failed to create anomaly",
 							slog.String("instance", instance.ResourceID),
 							slog.String("database", database.DatabaseName),
 							slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
 							log.BBError(err))
+					} else if created {
+						s.createAnomalyEvent(ctx, webhook.EventTypeDatabaseSchemaDrift, instance, database,
+							fmt.Sprintf("The schema differs from the schema recorded by version %s.", anomalyPayload.Version))
 					}
 				}
 			} else {
@@ -512,15 +519,18 @@ func (s *Syncer) upsertInstanceConnectionAnomaly(ctx context.Context, instance *
 				log.BBError(err))
 			return
 		}
-		if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		_, created, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
 			InstanceID: instance.ResourceID,
 			Type:       api.AnomalyInstanceConnection,
 			Payload:    string(payload),
-		}); err != nil {
+		})
+		if err != nil {
 			slog.Error("Failed to create anomaly",
 				slog.String("instance", instance.ResourceID),
 				slog.String("type", string(api.AnomalyInstanceConnection)),
 				log.BBError(err))
+		} else if created {
+			s.createAnomalyEvent(ctx, webhook.EventTypeDatabaseConnectionAnomaly, instance, nil, connErr.Error())
 		}
 		return
 	}
@@ -550,17 +560,20 @@ func (s *Syncer) upsertDatabaseConnectionAnomaly(ctx context.Context, instance *
 				slog.String("type", string(api.AnomalyDatabaseConnection)),
 				log.BBError(err))
 		} else {
-			if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+			_, created, err := s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
 				InstanceID:  instance.ResourceID,
 				DatabaseUID: &database.UID,
 				Type:        api.AnomalyDatabaseConnection,
 				Payload:     string(payload),
-			}); err != nil {
+			})
+			if err != nil {
 				slog.Error("Failed to create anomaly",
 					slog.String("instance", instance.ResourceID),
 					slog.String("database", database.DatabaseName),
 					slog.String("type", string(api.AnomalyDatabaseConnection)),
 					log.BBError(err))
+			} else if created {
+				s.createAnomalyEvent(ctx, webhook.EventTypeDatabaseConnectionAnomaly, instance, database, connErr.Error())
 			}
 		}
 		return
@@ -579,6 +592,49 @@ func (s *Syncer) upsertDatabaseConnectionAnomaly(ctx context.Context, instance *
 	}
 }
 
+// createAnomalyEvent notifies the projects of the newly raised anomaly.
+// The instance level anomaly is notified to every project owning a database of the instance.
+func (s *Syncer) createAnomalyEvent(ctx context.Context, eventType webhook.EventType, instance *store.InstanceMessage, database *store.DatabaseMessage, detail string) {
+	databases := []*store.DatabaseMessage{database}
+	databaseName := ""
+	if database == nil {
+		list, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID})
+		if err != nil {
+			slog.Error("Failed to list databases for anomaly event", slog.String("instance", instance.ResourceID), log.BBError(err))
+			return
+		}
+		databases = list
+	} else {
+		databaseName = database.DatabaseName
+	}
+
+	notified := map[string]bool{}
+	for _, db := range databases {
+		if notified[db.ProjectID] {
+			continue
+		}
+		notified[db.ProjectID] = true
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &db.ProjectID})
+		if err != nil {
+			slog.Error("Failed to get project for anomaly event", slog.String("project", db.ProjectID), log.BBError(err))
+			continue
+		}
+		if project == nil || project.Deleted {
+			continue
+		}
+		s.webhookManager.CreateEvent(ctx, &webhook.Event{
+			Actor:   s.store.GetSystemBotUser(ctx),
+			Type:    eventType,
+			Project: webhook.NewProject(project),
+			Anomaly: &webhook.EventAnomaly{
+				InstanceTitle: instance.Title,
+				DatabaseName:  databaseName,
+				Detail:        detail,
+			},
+		})
+	}
+}
+
 func setClassificationAndUserCommentFromComment(dbSchema *storepb.DatabaseSchemaMetadata, databaseConfig *model.DatabaseConfig, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) {
 	for _, schema := range dbSchema.Schemas {
 		schemaConfig := databaseConfig.CreateOrGetSchemaConfig(schema.Name)
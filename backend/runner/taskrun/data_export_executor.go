@@ -1,13 +1,16 @@
 package taskrun
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/pkg/errors"
 
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
@@ -75,13 +78,8 @@ func (exec *DataExportExecutor) RunOnce(ctx context.Context, _ context.Context,
 		Format:    v1pb.ExportFormat(payload.Format),
 		Password:  payload.Password,
 	}
-	bytes, _, exportErr := apiv1.DoExport(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, nil /* user */, instance, database, nil, exec.schemaSyncer)
-	if exportErr != nil {
-		return true, nil, errors.Wrap(exportErr, "failed to export data")
-	}
-
+	// The archive is uploaded in chunks while exporting, so it's never held in memory.
 	exportArchive, err := exec.store.CreateExportArchive(ctx, &store.ExportArchiveMessage{
-		Bytes: bytes,
 		Payload: &storepb.ExportArchivePayload{
 			FileFormat: payload.Format,
 		},
@@ -89,9 +87,43 @@ func (exec *DataExportExecutor) RunOnce(ctx context.Context, _ context.Context,
 	if err != nil {
 		return true, nil, errors.Wrap(err, "failed to create export archive")
 	}
+	w := bufio.NewWriterSize(&exportArchiveChunkWriter{ctx: ctx, store: exec.store, exportArchiveUID: exportArchive.UID}, exportArchiveChunkSize)
+	_, exportErr := apiv1.DoExport(ctx, exec.store, exec.dbFactory, exec.license, exportRequest, nil /* user */, instance, database, nil, exec.schemaSyncer, w)
+	if exportErr == nil {
+		exportErr = w.Flush()
+	}
+	if exportErr != nil {
+		if err := exec.store.DeleteExportArchive(context.WithoutCancel(ctx), exportArchive.UID); err != nil {
+			slog.Error("failed to delete export archive", slog.Int("exportArchive", exportArchive.UID), log.BBError(err))
+		}
+		return true, nil, errors.Wrap(exportErr, "failed to export data")
+	}
 
 	return true, &storepb.TaskRunResult{
 		Detail:           "Data export succeeded",
 		ExportArchiveUid: int32(exportArchive.UID),
 	}, nil
 }
+
+// exportArchiveChunkSize is the size of the export archive chunks.
+const exportArchiveChunkSize = 4 * 1024 * 1024
+
+// exportArchiveChunkWriter writes each write as a chunk of the export archive.
+type exportArchiveChunkWriter struct {
+	ctx              context.Context
+	store            *store.Store
+	exportArchiveUID int
+	seq              int
+}
+
+func (w *exportArchiveChunkWriter) Write(p []byte) (int, error) {
+	for written := 0; written < len(p); {
+		n := min(len(p)-written, exportArchiveChunkSize)
+		if err := w.store.CreateExportArchiveChunk(w.ctx, w.exportArchiveUID, w.seq, p[written:written+n]); err != nil {
+			return written, err
+		}
+		w.seq++
+		written += n
+	}
+	return len(p), nil
+}
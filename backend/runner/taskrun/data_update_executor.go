@@ -189,7 +189,7 @@ func (exec *DataUpdateExecutor) backupData(
 		IgnoreCaseSensitive:     store.IgnoreDatabaseAndTableCaseSensitive(instance),
 	}
 	if instance.Engine == storepb.Engine_ORACLE {
-		oracleDriver, ok := driver.(*oracle.Driver)
+		oracleDriver, ok := db.UnwrapDriver(driver).(*oracle.Driver)
 		if ok {
 			if version, err := oracleDriver.GetVersion(); err == nil {
 				tc.Version = version
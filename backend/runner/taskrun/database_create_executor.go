@@ -49,6 +49,8 @@ var cannotCreateDatabase = map[storepb.Engine]bool{
 	storepb.Engine_REDIS:            true,
 	storepb.Engine_ORACLE:           true,
 	storepb.Engine_DM:               true,
+	storepb.Engine_DB2:              true,
+	storepb.Engine_TRINO:            true,
 	storepb.Engine_OCEANBASE_ORACLE: true,
 }
 
@@ -428,6 +430,8 @@ func getConnectionStatement(dbType storepb.Engine, databaseName string) (string,
 		return fmt.Sprintf("USE DATABASE %s;\n", databaseName), nil
 	case storepb.Engine_SQLITE:
 		return fmt.Sprintf("USE `%s`;\n", databaseName), nil
+	case storepb.Engine_DUCKDB:
+		return fmt.Sprintf("USE \"%s\";\n", databaseName), nil
 	case storepb.Engine_MONGODB:
 		// We embed mongosh to execute the mongodb statement, and `use` statement is not effective in mongosh.
 		// We will connect to the specified database by specifying the database name in the connection string.
@@ -559,6 +559,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			)
 			return
 		}
+		exportArchiveUID := result.GetExportArchiveUid()
 		code := common.Ok
 		result := string(resultBytes)
 		taskRunStatusPatch := &store.TaskRunStatusPatch{
@@ -594,6 +595,9 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		}
 
 		s.createActivityForTaskRunStatusUpdate(ctx, task, api.TaskRunDone, "")
+		if exportArchiveUID != 0 {
+			s.createActivityForDataExportReady(ctx, task)
+		}
 		s.stateCfg.TaskSkippedOrDoneChan <- task.ID
 		return
 	}
@@ -813,6 +817,41 @@ func (s *SchedulerV2) createActivityForTaskRunStatusUpdate(ctx context.Context,
 	}
 }
 
+func (s *SchedulerV2) createActivityForDataExportReady(ctx context.Context, task *store.TaskMessage) {
+	if err := func() error {
+		issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{
+			PipelineID: &task.PipelineID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get issue")
+		}
+		if issue == nil {
+			return nil
+		}
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get instance")
+		}
+		if instance == nil {
+			return errors.Errorf("instance %d not found", task.InstanceID)
+		}
+		s.webhookManager.CreateEvent(ctx, &webhook.Event{
+			Actor:   s.store.GetSystemBotUser(ctx),
+			Type:    webhook.EventTypeDatabaseDataExportReady,
+			Issue:   webhook.NewIssue(issue),
+			Project: webhook.NewProject(issue.Project),
+			DataExportReady: &webhook.EventDataExportReady{
+				TaskTitle:     task.Name,
+				InstanceTitle: instance.Title,
+				DatabaseName:  task.DatabaseName,
+			},
+		})
+		return nil
+	}(); err != nil {
+		slog.Error("failed to create activity for data export ready", log.BBError(err))
+	}
+}
+
 func tasksSkippedOrDone(tasks []*store.TaskMessage) (bool, error) {
 	for _, task := range tasks {
 		skipped, err := utils.GetTaskSkipped(task)
@@ -91,5 +91,9 @@ func (exec *SchemaUpdateExecutor) RunOnce(ctx context.Context, driverCtx context
 		},
 	})
 
+	if err == nil {
+		writeBackSchemaAsync(ctx, exec.store, exec.dbFactory, instance, database)
+	}
+
 	return terminated, result, err
 }
@@ -84,5 +84,9 @@ func (exec *SchemaUpdateSDLExecutor) RunOnce(ctx context.Context, driverCtx cont
 		)
 	}
 
+	if err == nil {
+		writeBackSchemaAsync(ctx, exec.store, exec.dbFactory, instance, database)
+	}
+
 	return terminated, result, err
 }
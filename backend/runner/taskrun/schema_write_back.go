@@ -0,0 +1,237 @@
+package taskrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// schemaWriteBackTimeout is the timeout of writing back the schema of a database.
+const schemaWriteBackTimeout = 10 * time.Minute
+
+// schemaWriteBackLocks are the locks keyed by the VCS connector UID. They serialize the schema write-back
+// of the same connector, so that the concurrent tasks don't race to commit to the same write-back branch.
+var schemaWriteBackLocks sync.Map
+
+func lockSchemaWriteBack(vcsConnectorUID int) func() {
+	mu, _ := schemaWriteBackLocks.LoadOrStore(vcsConnectorUID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// writeBackSchemaAsync writes back the schema in the background, so that the task run
+// is not blocked by the schema dump and the VCS requests.
+func writeBackSchemaAsync(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, database *store.DatabaseMessage) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), schemaWriteBackTimeout)
+	go func() {
+		defer cancel()
+		writeBackSchema(ctx, stores, dbFactory, instance, database)
+	}()
+}
+
+// writeBackSchema commits the latest schema of the database to the repositories of the VCS connectors
+// with the schema write-back enabled in the database project, and opens or updates the pull request
+// from the write-back branch to the connector branch.
+// The failures are only logged because the schema change has been applied.
+func writeBackSchema(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, database *store.DatabaseMessage) {
+	vcsConnectors, err := stores.ListVCSConnectors(ctx, &store.FindVCSConnectorMessage{ProjectID: &database.ProjectID})
+	if err != nil {
+		slog.Error("failed to list VCS connectors for schema write-back", slog.String("project", database.ProjectID), log.BBError(err))
+		return
+	}
+	writeBackConnectors := getSchemaWriteBackConnectors(ctx, vcsConnectors, database, func(ctx context.Context, databaseGroup string) (*store.DatabaseGroupMessage, error) {
+		return getDatabaseGroup(ctx, stores, database.ProjectID, databaseGroup)
+	})
+	if len(writeBackConnectors) == 0 {
+		return
+	}
+
+	schema, err := dumpDatabaseSchema(ctx, dbFactory, instance, database)
+	if err != nil {
+		slog.Error("failed to dump schema for schema write-back",
+			slog.String("instance", instance.ResourceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err),
+		)
+		return
+	}
+
+	for _, vcsConnector := range writeBackConnectors {
+		unlock := lockSchemaWriteBack(vcsConnector.UID)
+		err := writeBackSchemaToVCS(ctx, stores, vcsConnector, instance, database, schema)
+		unlock()
+		if err != nil {
+			slog.Error("failed to write back schema",
+				slog.String("project", vcsConnector.ProjectID),
+				slog.String("vcsConnector", vcsConnector.ResourceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err),
+			)
+		}
+	}
+}
+
+// getSchemaWriteBackConnectors returns the VCS connectors with the schema write-back enabled,
+// whose database group matches the database.
+func getSchemaWriteBackConnectors(ctx context.Context, vcsConnectors []*store.VCSConnectorMessage, database *store.DatabaseMessage, getDatabaseGroup func(context.Context, string) (*store.DatabaseGroupMessage, error)) []*store.VCSConnectorMessage {
+	var writeBackConnectors []*store.VCSConnectorMessage
+	for _, vcsConnector := range vcsConnectors {
+		if vcsConnector.Payload.GetSchemaWriteBack().GetFilePathTemplate() == "" {
+			continue
+		}
+		matched, err := isDatabaseInVCSConnectorGroup(ctx, vcsConnector, database, getDatabaseGroup)
+		if err != nil {
+			slog.Error("failed to check the database group of VCS connector for schema write-back",
+				slog.String("project", vcsConnector.ProjectID),
+				slog.String("vcsConnector", vcsConnector.ResourceID),
+				log.BBError(err),
+			)
+			continue
+		}
+		if matched {
+			writeBackConnectors = append(writeBackConnectors, vcsConnector)
+		}
+	}
+	return writeBackConnectors
+}
+
+// isDatabaseInVCSConnectorGroup returns true if the VCS connector has no database group or the database matches it.
+func isDatabaseInVCSConnectorGroup(ctx context.Context, vcsConnector *store.VCSConnectorMessage, database *store.DatabaseMessage, getDatabaseGroup func(context.Context, string) (*store.DatabaseGroupMessage, error)) (bool, error) {
+	dbg := vcsConnector.Payload.GetDatabaseGroup()
+	if dbg == "" {
+		return true, nil
+	}
+	databaseGroup, err := getDatabaseGroup(ctx, dbg)
+	if err != nil {
+		return false, err
+	}
+	matched, err := utils.CheckDatabaseGroupMatch(ctx, databaseGroup.Expression.GetExpression(), database)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to match database in database group %q", dbg)
+	}
+	return matched, nil
+}
+
+// getDatabaseGroup gets the database group in the project by the full name, e.g. projects/{project}/databaseGroups/{group}.
+func getDatabaseGroup(ctx context.Context, stores *store.Store, projectID string, dbg string) (*store.DatabaseGroupMessage, error) {
+	_, databaseGroupID, err := common.GetProjectIDDatabaseGroupID(dbg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project id and database group id from %q", dbg)
+	}
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", projectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %q not found", projectID)
+	}
+	databaseGroup, err := stores.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{ProjectUID: &project.UID, ResourceID: &databaseGroupID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database group %q", databaseGroupID)
+	}
+	if databaseGroup == nil {
+		return nil, errors.Errorf("database group %q not found", databaseGroupID)
+	}
+	return databaseGroup, nil
+}
+
+func dumpDatabaseSchema(ctx context.Context, dbFactory *dbfactory.DBFactory, instance *store.InstanceMessage, database *store.DatabaseMessage) (string, error) {
+	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	var schemaBuf bytes.Buffer
+	if err := driver.Dump(ctx, &schemaBuf); err != nil {
+		return "", err
+	}
+	return schemaBuf.String(), nil
+}
+
+func writeBackSchemaToVCS(ctx context.Context, stores *store.Store, vcsConnector *store.VCSConnectorMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, schema string) error {
+	vcsProvider, err := stores.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get VCS provider %q", vcsConnector.VCSResourceID)
+	}
+	if vcsProvider == nil {
+		return errors.Errorf("VCS provider %q not found", vcsConnector.VCSResourceID)
+	}
+	provider := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	return writeBackSchemaToRepository(ctx, provider, vcsConnector, instance, database, schema)
+}
+
+// writeBackSchemaToRepository commits the schema to the write-back branch created from the connector branch,
+// and opens or updates the pull request. The commit is skipped if the schema is not changed.
+func writeBackSchemaToRepository(ctx context.Context, provider vcs.Provider, vcsConnector *store.VCSConnectorMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, schema string) error {
+	repositoryID := vcsConnector.Payload.ExternalId
+	writeBack := vcsConnector.Payload.SchemaWriteBack
+	filePath := getSchemaWriteBackFilePath(writeBack.FilePathTemplate, instance, database)
+
+	if _, err := provider.GetBranch(ctx, repositoryID, writeBack.Branch); err != nil {
+		if common.ErrorCode(err) != common.NotFound {
+			return errors.Wrapf(err, "failed to get branch %q", writeBack.Branch)
+		}
+		base, err := provider.GetBranch(ctx, repositoryID, vcsConnector.Payload.Branch)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get branch %q", vcsConnector.Payload.Branch)
+		}
+		if err := provider.CreateBranch(ctx, repositoryID, writeBack.Branch, base.LastCommitID); err != nil {
+			return errors.Wrapf(err, "failed to create branch %q", writeBack.Branch)
+		}
+	}
+
+	// Skip the commit if the schema is not changed, e.g. the data changes.
+	// Any error reading the file is treated as changed, the commit will surface the real error.
+	if content, err := provider.ReadFileContent(ctx, repositoryID, filePath, vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: writeBack.Branch}); err == nil && content == schema {
+		return nil
+	}
+
+	if err := provider.CommitFile(ctx, repositoryID, &vcs.FileCommitCreate{
+		Branch:  writeBack.Branch,
+		Path:    filePath,
+		Content: schema,
+		Message: fmt.Sprintf("chore: update the latest schema of %s/%s", instance.ResourceID, database.DatabaseName),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to commit file %q", filePath)
+	}
+
+	pr, err := provider.UpsertPullRequest(ctx, repositoryID, &vcs.PullRequestCreate{
+		Title:        "chore: update the latest schema from Bytebase",
+		Description:  "This pull request is created by Bytebase to keep the latest schema of the databases in the repository after the rollouts.",
+		SourceBranch: writeBack.Branch,
+		TargetBranch: vcsConnector.Payload.Branch,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to open pull request from %q to %q", writeBack.Branch, vcsConnector.Payload.Branch)
+	}
+	slog.Debug("wrote back the latest schema",
+		slog.String("database", database.DatabaseName),
+		slog.String("file", filePath),
+		slog.String("pullRequest", pr.URL),
+	)
+	return nil
+}
+
+// getSchemaWriteBackFilePath renders the file path template, without the leading "/".
+func getSchemaWriteBackFilePath(filePathTemplate string, instance *store.InstanceMessage, database *store.DatabaseMessage) string {
+	filePath := filePathTemplate
+	filePath = strings.ReplaceAll(filePath, "{{ENV_ID}}", database.EffectiveEnvironmentID)
+	filePath = strings.ReplaceAll(filePath, "{{INSTANCE_ID}}", instance.ResourceID)
+	filePath = strings.ReplaceAll(filePath, "{{DB_NAME}}", database.DatabaseName)
+	return strings.TrimPrefix(filePath, "/")
+}
@@ -0,0 +1,227 @@
+package taskrun
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// fakeWriteBackProvider is the VCS provider keeping the branches and files in memory.
+// The methods not used by the schema write-back panic through the nil embedded interface.
+type fakeWriteBackProvider struct {
+	vcs.Provider
+
+	// branches are the last commits keyed by the branch.
+	branches map[string]string
+	// files are the file contents keyed by the branch and the path.
+	files        map[string]map[string]string
+	commits      []*vcs.FileCommitCreate
+	pullRequests []*vcs.PullRequestCreate
+}
+
+func (p *fakeWriteBackProvider) GetBranch(_ context.Context, _, branchName string) (*vcs.BranchInfo, error) {
+	commit, ok := p.branches[branchName]
+	if !ok {
+		return nil, common.Errorf(common.NotFound, "branch %q not found", branchName)
+	}
+	return &vcs.BranchInfo{Name: branchName, LastCommitID: commit}, nil
+}
+
+func (p *fakeWriteBackProvider) CreateBranch(_ context.Context, _, branchName, fromCommit string) error {
+	p.branches[branchName] = fromCommit
+	return nil
+}
+
+func (p *fakeWriteBackProvider) ReadFileContent(_ context.Context, _, filePath string, refInfo vcs.RefInfo) (string, error) {
+	content, ok := p.files[refInfo.RefName][filePath]
+	if !ok {
+		return "", common.Errorf(common.NotFound, "file %q not found", filePath)
+	}
+	return content, nil
+}
+
+func (p *fakeWriteBackProvider) CommitFile(_ context.Context, _ string, commit *vcs.FileCommitCreate) error {
+	if p.files[commit.Branch] == nil {
+		p.files[commit.Branch] = map[string]string{}
+	}
+	p.files[commit.Branch][commit.Path] = commit.Content
+	p.commits = append(p.commits, commit)
+	return nil
+}
+
+func (p *fakeWriteBackProvider) UpsertPullRequest(_ context.Context, _ string, create *vcs.PullRequestCreate) (*vcs.PullRequest, error) {
+	p.pullRequests = append(p.pullRequests, create)
+	return &vcs.PullRequest{ID: "1", URL: "https://github.com/octocat/db/pull/1"}, nil
+}
+
+func TestGetSchemaWriteBackFilePath(t *testing.T) {
+	instance := &store.InstanceMessage{ResourceID: "mysql-prod"}
+	database := &store.DatabaseMessage{DatabaseName: "employee", EffectiveEnvironmentID: "prod"}
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{template: "schema/{{INSTANCE_ID}}/{{DB_NAME}}.sql", want: "schema/mysql-prod/employee.sql"},
+		{template: "/{{ENV_ID}}/{{INSTANCE_ID}}__{{DB_NAME}}/LATEST.sql", want: "prod/mysql-prod__employee/LATEST.sql"},
+		{template: "{{DB_NAME}}/{{DB_NAME}}.sql", want: "employee/employee.sql"},
+		{template: "schema.sql", want: "schema.sql"},
+	}
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getSchemaWriteBackFilePath(test.template, instance, database), test.template)
+	}
+}
+
+func TestGetSchemaWriteBackConnectors(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	database := &store.DatabaseMessage{
+		ProjectID:              "db-project",
+		InstanceID:             "mysql-prod",
+		DatabaseName:           "employee",
+		EffectiveEnvironmentID: "prod",
+		Metadata:               &storepb.DatabaseMetadata{},
+	}
+	databaseGroups := map[string]*store.DatabaseGroupMessage{
+		"projects/db-project/databaseGroups/employee": {
+			Expression: &expr.Expr{Expression: `resource.database_name == "employee"`},
+		},
+		"projects/db-project/databaseGroups/hr": {
+			Expression: &expr.Expr{Expression: `resource.database_name.startsWith("hr")`},
+		},
+	}
+	getDatabaseGroup := func(_ context.Context, databaseGroup string) (*store.DatabaseGroupMessage, error) {
+		group, ok := databaseGroups[databaseGroup]
+		if !ok {
+			return nil, errors.Errorf("database group %q not found", databaseGroup)
+		}
+		return group, nil
+	}
+	writeBack := &storepb.VCSConnector_SchemaWriteBack{Branch: "bytebase/schema", FilePathTemplate: "schema/{{DB_NAME}}.sql"}
+	vcsConnectors := []*store.VCSConnectorMessage{
+		{ResourceID: "no-write-back", Payload: &storepb.VCSConnector{}},
+		{ResourceID: "no-template", Payload: &storepb.VCSConnector{SchemaWriteBack: &storepb.VCSConnector_SchemaWriteBack{Branch: "bytebase/schema"}}},
+		{ResourceID: "all-databases", Payload: &storepb.VCSConnector{SchemaWriteBack: writeBack}},
+		{ResourceID: "matched-group", Payload: &storepb.VCSConnector{SchemaWriteBack: writeBack, DatabaseGroup: "projects/db-project/databaseGroups/employee"}},
+		{ResourceID: "unmatched-group", Payload: &storepb.VCSConnector{SchemaWriteBack: writeBack, DatabaseGroup: "projects/db-project/databaseGroups/hr"}},
+		{ResourceID: "missing-group", Payload: &storepb.VCSConnector{SchemaWriteBack: writeBack, DatabaseGroup: "projects/db-project/databaseGroups/missing"}},
+	}
+
+	var got []string
+	for _, vcsConnector := range getSchemaWriteBackConnectors(ctx, vcsConnectors, database, getDatabaseGroup) {
+		got = append(got, vcsConnector.ResourceID)
+	}
+	a.Equal([]string{"all-databases", "matched-group"}, got)
+}
+
+func TestWriteBackSchemaToRepository(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	instance := &store.InstanceMessage{ResourceID: "mysql-prod"}
+	database := &store.DatabaseMessage{DatabaseName: "employee", EffectiveEnvironmentID: "prod"}
+	vcsConnector := &store.VCSConnectorMessage{
+		Payload: &storepb.VCSConnector{
+			ExternalId: "octocat/db",
+			Branch:     "main",
+			SchemaWriteBack: &storepb.VCSConnector_SchemaWriteBack{
+				Branch:           "bytebase/schema",
+				FilePathTemplate: "/schema/{{INSTANCE_ID}}/{{DB_NAME}}.sql",
+			},
+		},
+	}
+	provider := &fakeWriteBackProvider{
+		branches: map[string]string{"main": "mainsha"},
+		files:    map[string]map[string]string{},
+	}
+
+	// The write-back branch is created from the connector branch.
+	a.NoError(writeBackSchemaToRepository(ctx, provider, vcsConnector, instance, database, "CREATE TABLE t(id INT);"))
+	a.Equal("mainsha", provider.branches["bytebase/schema"])
+	a.Len(provider.commits, 1)
+	a.Equal(&vcs.FileCommitCreate{
+		Branch:  "bytebase/schema",
+		Path:    "schema/mysql-prod/employee.sql",
+		Content: "CREATE TABLE t(id INT);",
+		Message: "chore: update the latest schema of mysql-prod/employee",
+	}, provider.commits[0])
+	a.Len(provider.pullRequests, 1)
+	a.Equal("bytebase/schema", provider.pullRequests[0].SourceBranch)
+	a.Equal("main", provider.pullRequests[0].TargetBranch)
+
+	// The unchanged schema is not committed again, e.g. after the data changes.
+	provider.branches["bytebase/schema"] = "writebacksha"
+	a.NoError(writeBackSchemaToRepository(ctx, provider, vcsConnector, instance, database, "CREATE TABLE t(id INT);"))
+	a.Len(provider.commits, 1)
+	a.Len(provider.pullRequests, 1)
+	a.Equal("writebacksha", provider.branches["bytebase/schema"])
+
+	// The changed schema is committed to the existing branch and the pull request is updated.
+	a.NoError(writeBackSchemaToRepository(ctx, provider, vcsConnector, instance, database, "CREATE TABLE t(id INT, name TEXT);"))
+	a.Len(provider.commits, 2)
+	a.Len(provider.pullRequests, 2)
+	a.Equal("writebacksha", provider.branches["bytebase/schema"])
+
+	// The missing connector branch fails the write-back.
+	vcsConnector.Payload.Branch = "missing"
+	vcsConnector.Payload.SchemaWriteBack.Branch = "bytebase/missing"
+	a.ErrorContains(writeBackSchemaToRepository(ctx, provider, vcsConnector, instance, database, "CREATE TABLE t(id INT);"), `failed to get branch "missing"`)
+}
+
+func TestLockSchemaWriteBack(t *testing.T) {
+	a := require.New(t)
+	unlock := lockSchemaWriteBack(1)
+
+	locked := make(chan struct{})
+	go func() {
+		unlockAgain := lockSchemaWriteBack(1)
+		close(locked)
+		unlockAgain()
+	}()
+
+	// The other connector is not blocked.
+	lockSchemaWriteBack(2)()
+
+	select {
+	case <-locked:
+		a.Fail("the write-back of the same connector is not serialized")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		a.Fail("the write-back of the same connector is not unlocked")
+	}
+
+	// The concurrent write-backs of the same connector never overlap.
+	var mu sync.Mutex
+	running, overlapped := 0, false
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer lockSchemaWriteBack(3)()
+			mu.Lock()
+			running++
+			overlapped = overlapped || running > 1
+			mu.Unlock()
+			time.Sleep(time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	a.False(overlapped)
+}
@@ -17,6 +17,7 @@ import (
 
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/gitops"
+	"github.com/bytebase/bytebase/backend/api/im"
 	"github.com/bytebase/bytebase/backend/api/lsp"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
@@ -28,6 +29,7 @@ func configureEchoRouters(
 	lspServer *lsp.Server,
 	gitOpsServer *gitops.Service,
 	directorySyncServer *directorysync.Service,
+	imServer *im.Service,
 	mux *grpcruntime.ServeMux,
 	profile *config.Profile,
 ) {
@@ -118,6 +120,9 @@ func configureEchoRouters(
 
 	scimGroup := webhookGroup.Group(scimAPIPrefix)
 	directorySyncServer.RegisterDirectorySyncRoutes(scimGroup)
+
+	imGroup := webhookGroup.Group(imAPIPrefix)
+	imServer.RegisterIMCallbackRoutes(imGroup)
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
@@ -24,6 +24,7 @@ import (
 	"github.com/bytebase/bytebase/backend/api/auth"
 	directorysync "github.com/bytebase/bytebase/backend/api/directory-sync"
 	"github.com/bytebase/bytebase/backend/api/gitops"
+	"github.com/bytebase/bytebase/backend/api/im"
 	"github.com/bytebase/bytebase/backend/api/lsp"
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -31,6 +32,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	secretcomp "github.com/bytebase/bytebase/backend/component/secret"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/component/webhook"
@@ -58,6 +60,7 @@ const (
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
 	scimAPIPrefix    = "/scim"
+	imAPIPrefix      = "/im"
 	// lspAPI is the API for Bytebase Language Server Protocol.
 	lspAPI                 = "/lsp"
 	maxStacksize           = 1024 * 10240
@@ -97,6 +100,7 @@ type Server struct {
 	planService    *apiv1.PlanService
 	rolloutService *apiv1.RolloutService
 	issueService   *apiv1.IssueService
+	sqlService     *apiv1.SQLService
 
 	// MySQL utility binaries
 	mysqlBinDir string
@@ -129,6 +133,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	slog.Info(fmt.Sprintf("readonly=%t", profile.Readonly))
 	slog.Info(fmt.Sprintf("demoName=%s", profile.DemoName))
 	slog.Info(fmt.Sprintf("instanceRunUUID=%s", profile.DeployID))
+	slog.Info(fmt.Sprintf("secretDir=%s", profile.SecretDir))
 	slog.Info("-----Config END-------")
 
 	serverStarted := false
@@ -142,6 +147,11 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	if err = os.MkdirAll(profile.ResourceDir, os.ModePerm); err != nil {
 		return nil, errors.Wrapf(err, "failed to create directory: %q", profile.ResourceDir)
 	}
+	if profile.SecretDir != "" {
+		if err := secretcomp.WatchSecretDirectory(ctx, profile.SecretDir); err != nil {
+			return nil, err
+		}
+	}
 	// Install mysqlutil.
 	s.mysqlBinDir, err = mysqlutil.Install(profile.ResourceDir)
 	if err != nil {
@@ -258,7 +268,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	mux := grpcruntime.NewServeMux(grpcruntime.WithForwardResponseOption(gatewayModifier.Modify))
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile, false)
-	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService)
+	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService, s.webhookManager)
 	if !profile.Readonly {
 		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
 		s.mailSender = mail.NewSender(s.store, s.stateCfg, s.iamManager)
@@ -276,7 +286,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 
-		s.planCheckScheduler = plancheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg)
+		s.planCheckScheduler = plancheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg, s.webhookManager)
 		databaseConnectExecutor := plancheck.NewDatabaseConnectExecutor(storeInstance, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseConnect, databaseConnectExecutor)
 		statementAdviseExecutor := plancheck.NewStatementAdviseExecutor(storeInstance, s.sheetManager, s.dbFactory, s.licenseService)
@@ -350,13 +360,14 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	s.planService, s.rolloutService, s.issueService = planService, rolloutService, issueService
+	s.planService, s.rolloutService, s.issueService, s.sqlService = planService, rolloutService, issueService, sqlService
 	// GitOps webhook server.
-	gitOpsServer := gitops.NewService(s.store, s.licenseService, planService, rolloutService, issueService, sqlService, s.sheetManager)
+	gitOpsServer := gitops.NewService(s.store, s.licenseService, planService, rolloutService, issueService, sqlService, s.sheetManager, s.webhookManager)
 	directorySyncServer := directorysync.NewService(s.store, s.licenseService, s.iamManager)
+	imServer := im.NewService(s.store, issueService)
 
 	// Configure echo server routes.
-	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, directorySyncServer, mux, profile)
+	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, directorySyncServer, imServer, mux, profile)
 
 	serverStarted = true
 	return s, nil
@@ -380,6 +391,8 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		go s.approvalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.relayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.webhookManager.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.metricReporter.Run(ctx, &s.runnerWG)
@@ -460,6 +473,10 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	if s.muxServer != nil {
 		s.muxServer.Close()
 	}
+	// Roll back the open transactions of the SQL Editor sessions, which don't outlive the server.
+	if s.sqlService != nil {
+		s.sqlService.CloseEditorSessions(ctx)
+	}
 
 	// Wait for all runners to exit.
 	s.runnerWG.Wait()
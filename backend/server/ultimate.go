@@ -9,6 +9,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/db/cockroachdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/databricks"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/dm"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/duckdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/dynamodb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/elasticsearch"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/hive"
@@ -24,8 +25,10 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/db/sqlite"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/starrocks"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/tidb"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/trino"
 
 	// Parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/duckdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/partiql"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
@@ -33,6 +36,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/standard"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/trino"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 
 	// Advisors.
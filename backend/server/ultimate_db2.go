@@ -0,0 +1,17 @@
+//go:build db2 && !minidemo
+
+package server
+
+import (
+	// The Db2 engine is only available with the db2 tag, since the driver requires cgo and the IBM Data Server Driver.
+	// Without the tag, the Db2 instances are rejected on creation.
+
+	// Drivers.
+	_ "github.com/bytebase/bytebase/backend/plugin/db/db2"
+
+	// Parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/db2"
+
+	// Advisors.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/db2"
+)
@@ -0,0 +1,303 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// AccessTokenMessage is the message for a personal access token or a service account key.
+type AccessTokenMessage struct {
+	// PrincipalUID is the owner of the token.
+	PrincipalUID int
+	Name         string
+	// TokenHash is the SHA-256 hex digest of the token. The token itself is never stored.
+	TokenHash string
+	// ExpireTime is nil if the token never expires.
+	ExpireTime *time.Time
+	Payload    *storepb.AccessTokenPayload
+
+	// Output only fields.
+	UID          int
+	CreatorUID   int
+	CreatedTime  time.Time
+	LastUsedTime *time.Time
+	Revoked      bool
+}
+
+// FindAccessTokenMessage is the message for finding access tokens.
+type FindAccessTokenMessage struct {
+	UID          *int
+	PrincipalUID *int
+	TokenHash    *string
+	ShowRevoked  bool
+}
+
+// UpdateAccessTokenMessage is the message for updating an access token.
+type UpdateAccessTokenMessage struct {
+	UID          int
+	ExpireTime   *time.Time
+	LastUsedTime *time.Time
+	Revoke       bool
+}
+
+// GetAccessToken gets an access token.
+func (s *Store) GetAccessToken(ctx context.Context, find *FindAccessTokenMessage) (*AccessTokenMessage, error) {
+	tokens, err := s.ListAccessTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	if len(tokens) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d access tokens with filter %+v, expect 1", len(tokens), find)}
+	}
+	return tokens[0], nil
+}
+
+// ListAccessTokens lists access tokens.
+func (s *Store) ListAccessTokens(ctx context.Context, find *FindAccessTokenMessage) ([]*AccessTokenMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.TokenHash; v != nil {
+		where, args = append(where, fmt.Sprintf("token_hash = $%d", len(args)+1)), append(args, *v)
+	}
+	if !find.ShowRevoked {
+		where, args = append(where, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, api.Normal)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			row_status,
+			creator_id,
+			created_ts,
+			principal_id,
+			name,
+			token_hash,
+			expire_ts,
+			last_used_ts,
+			payload
+		FROM access_token
+		WHERE %s
+		ORDER BY id`, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []*AccessTokenMessage
+	for rows.Next() {
+		token, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// CreateAccessToken creates an access token.
+func (s *Store) CreateAccessToken(ctx context.Context, create *AccessTokenMessage, creatorUID int) (*AccessTokenMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	token, err := createAccessTokenImpl(ctx, tx, create, creatorUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// RotateAccessToken creates the replacement token and shortens the expiration of the
+// old token to the end of the overlap window in a single transaction.
+func (s *Store) RotateAccessToken(ctx context.Context, old *AccessTokenMessage, create *AccessTokenMessage, overlapExpireTime time.Time, creatorUID int) (*AccessTokenMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if old.ExpireTime == nil || old.ExpireTime.After(overlapExpireTime) {
+		if _, err := tx.ExecContext(ctx, `UPDATE access_token SET expire_ts = $1 WHERE id = $2`, overlapExpireTime.Unix(), old.UID); err != nil {
+			return nil, err
+		}
+	}
+	token, err := createAccessTokenImpl(ctx, tx, create, creatorUID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// UpdateAccessToken updates an access token.
+func (s *Store) UpdateAccessToken(ctx context.Context, patch *UpdateAccessTokenMessage) (*AccessTokenMessage, error) {
+	set, args := []string{}, []any{}
+	if v := patch.ExpireTime; v != nil {
+		set, args = append(set, fmt.Sprintf("expire_ts = $%d", len(args)+1)), append(args, v.Unix())
+	}
+	if v := patch.LastUsedTime; v != nil {
+		set, args = append(set, fmt.Sprintf("last_used_ts = $%d", len(args)+1)), append(args, v.Unix())
+	}
+	if patch.Revoke {
+		set, args = append(set, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, api.Archived)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update field provided")
+	}
+	args = append(args, patch.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	token, err := scanAccessToken(tx.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE access_token
+		SET %s
+		WHERE id = $%d
+		RETURNING
+			id,
+			row_status,
+			creator_id,
+			created_ts,
+			principal_id,
+			name,
+			token_hash,
+			expire_ts,
+			last_used_ts,
+			payload`, strings.Join(set, ", "), len(args)),
+		args...,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("access token %d not found", patch.UID)}
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func createAccessTokenImpl(ctx context.Context, tx *Tx, create *AccessTokenMessage, creatorUID int) (*AccessTokenMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var expireTs int64
+	if create.ExpireTime != nil {
+		expireTs = create.ExpireTime.Unix()
+	}
+
+	query := `
+		INSERT INTO access_token (
+			creator_id,
+			principal_id,
+			name,
+			token_hash,
+			expire_ts,
+			payload
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_ts
+	`
+	var createdTs int64
+	if err := tx.QueryRowContext(ctx, query,
+		creatorUID,
+		create.PrincipalUID,
+		create.Name,
+		create.TokenHash,
+		expireTs,
+		payload,
+	).Scan(
+		&create.UID,
+		&createdTs,
+	); err != nil {
+		return nil, err
+	}
+	create.CreatorUID = creatorUID
+	create.CreatedTime = time.Unix(createdTs, 0)
+	return create, nil
+}
+
+type accessTokenScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccessToken(scanner accessTokenScanner) (*AccessTokenMessage, error) {
+	var token AccessTokenMessage
+	var rowStatus string
+	var createdTs, expireTs, lastUsedTs int64
+	var payload []byte
+	if err := scanner.Scan(
+		&token.UID,
+		&rowStatus,
+		&token.CreatorUID,
+		&createdTs,
+		&token.PrincipalUID,
+		&token.Name,
+		&token.TokenHash,
+		&expireTs,
+		&lastUsedTs,
+		&payload,
+	); err != nil {
+		return nil, err
+	}
+	tokenPayload := &storepb.AccessTokenPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, tokenPayload); err != nil {
+		return nil, err
+	}
+	token.Payload = tokenPayload
+	token.Revoked = rowStatus == string(api.Archived)
+	token.CreatedTime = time.Unix(createdTs, 0)
+	if expireTs > 0 {
+		expireTime := time.Unix(expireTs, 0)
+		token.ExpireTime = &expireTime
+	}
+	if lastUsedTs > 0 {
+		lastUsedTime := time.Unix(lastUsedTs, 0)
+		token.LastUsedTime = &lastUsedTime
+	}
+	return &token, nil
+}
@@ -55,10 +55,11 @@ type updateAnomalyMessage struct {
 }
 
 // UpsertActiveAnomalyV2 upserts an instance of anomaly.
-func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, upsert *AnomalyMessage) (*AnomalyMessage, error) {
+// It also reports whether the anomaly is newly raised rather than an update of the active one.
+func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, upsert *AnomalyMessage) (*AnomalyMessage, bool, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer tx.Rollback()
 
@@ -71,11 +72,12 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 	}
 	list, err := s.listAnomalyImplV2(ctx, tx, find)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	var anomaly *AnomalyMessage
-	if len(list) == 0 {
+	created := len(list) == 0
+	if created {
 		anomaly, err = s.createAnomalyImplV2(ctx, tx, principalUID, &AnomalyMessage{
 			InstanceID:  upsert.InstanceID,
 			DatabaseUID: upsert.DatabaseUID,
@@ -83,7 +85,7 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 			Payload:     upsert.Payload,
 		})
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	} else if len(list) == 1 {
 		// Even if field value does not change, we still patch to update the updated_ts.
@@ -92,17 +94,17 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 			Payload: upsert.Payload,
 		})
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 	} else {
-		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d active anomalies with filter %+v, expect 1", len(list), find)}
+		return nil, false, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d active anomalies with filter %+v, expect 1", len(list), find)}
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return anomaly, nil
+	return anomaly, created, nil
 }
 
 // ListAnomalyV2 lists anomalies, only return the normal ones.
@@ -16,7 +16,11 @@ import (
 type AuditLogMethod string
 
 // The methods other than v1 api.
-const AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+const (
+	AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+	// AuditLogMethodEditorSessionExpire is the rollback of the open transaction when the SQL Editor session expires.
+	AuditLogMethodEditorSessionExpire AuditLogMethod = "bb.sql.editorSession.expire"
+)
 
 func (m AuditLogMethod) String() string {
 	return string(m)
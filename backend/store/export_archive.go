@@ -141,6 +141,43 @@ func (s *Store) CreateExportArchive(ctx context.Context, create *ExportArchiveMe
 	return create, nil
 }
 
+// CreateExportArchiveChunk appends a chunk of the content to the export archive.
+// The content is the bytes of the export archive followed by the chunks in seq order.
+func (s *Store) CreateExportArchiveChunk(ctx context.Context, exportArchiveUID, seq int, bytes []byte) error {
+	if _, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO export_archive_chunk (
+			export_archive_id,
+			seq,
+			bytes
+		)
+		VALUES ($1, $2, $3)`,
+		exportArchiveUID,
+		seq,
+		bytes,
+	); err != nil {
+		return errors.Wrapf(err, "failed to create chunk %d of export archive %d", seq, exportArchiveUID)
+	}
+	return nil
+}
+
+// GetExportArchiveChunk gets the chunk of the export archive. It returns nil if the chunk doesn't exist.
+func (s *Store) GetExportArchiveChunk(ctx context.Context, exportArchiveUID, seq int) ([]byte, error) {
+	var bytes []byte
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT bytes
+		FROM export_archive_chunk
+		WHERE export_archive_id = $1 AND seq = $2`,
+		exportArchiveUID,
+		seq,
+	).Scan(&bytes); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get chunk %d of export archive %d", seq, exportArchiveUID)
+	}
+	return bytes, nil
+}
+
 // DeleteExportArchive deletes a export archive.
 func (s *Store) DeleteExportArchive(ctx context.Context, uid int) error {
 	tx, err := s.db.BeginTx(ctx, nil)
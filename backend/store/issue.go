@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -638,6 +639,30 @@ func (s *Store) BatchUpdateIssueStatuses(ctx context.Context, issueUIDs []int, s
 	return nil
 }
 
+// CreateIssueWebhookThread saves the thread key of the project webhook for the issue.
+// The existing thread key is kept, so the concurrent deliveries don't overwrite each other.
+func (s *Store) CreateIssueWebhookThread(ctx context.Context, issueUID int, webhookID int, threadKey string) error {
+	query := `
+		UPDATE issue
+		SET payload = jsonb_set(payload, '{webhookThreads}', COALESCE(payload->'webhookThreads', '{}'::JSONB) || jsonb_build_object($1::TEXT, $2::TEXT))
+		WHERE id = $3 AND payload->'webhookThreads'->>$1::TEXT IS NULL
+		RETURNING pipeline_id
+	`
+	var pipelineID sql.NullInt32
+	if err := s.db.db.QueryRowContext(ctx, query, strconv.Itoa(webhookID), threadKey, issueUID).Scan(&pipelineID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to create webhook thread for issue %d", issueUID)
+	}
+
+	s.issueCache.Remove(issueUID)
+	if pipelineID.Valid {
+		s.issueByPipelineCache.Remove(int(pipelineID.Int32))
+	}
+	return nil
+}
+
 func getTsVector(text string) string {
 	seg := getSegmenter()
 	parts := seg.CutTrim(text)
@@ -0,0 +1,178 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// QueryResultCacheMessage is the message for a cached SQL Editor query result.
+type QueryResultCacheMessage struct {
+	CreatorUID int
+	// Database is the database resource name, like instances/{instance}/databases/{database}.
+	Database string
+	// Worksheet is the worksheet resource name, like worksheets/{worksheet}. Empty if the query is not run in a worksheet.
+	Worksheet string
+	Statement string
+	// Result is the encrypted query response. It's only set if IncludeResult is set for listing.
+	Result []byte
+
+	// Output only fields.
+	UID         int
+	CreatedTime time.Time
+}
+
+// FindQueryResultCacheMessage is the message for finding cached query results.
+type FindQueryResultCacheMessage struct {
+	UID        *int
+	CreatorUID *int
+	Database   *string
+	Worksheet  *string
+	// IncludeResult loads the results, which are skipped for listing since they can be large.
+	IncludeResult bool
+	Limit         *int
+}
+
+// GetQueryResultCache gets a cached query result.
+func (s *Store) GetQueryResultCache(ctx context.Context, find *FindQueryResultCacheMessage) (*QueryResultCacheMessage, error) {
+	caches, err := s.ListQueryResultCaches(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(caches) == 0 {
+		return nil, nil
+	}
+	if len(caches) > 1 {
+		return nil, errors.Errorf("found %d cached query results with filter %+v, expect 1", len(caches), find)
+	}
+	return caches[0], nil
+}
+
+// ListQueryResultCaches lists the cached query results, latest first.
+func (s *Store) ListQueryResultCaches(ctx context.Context, find *FindQueryResultCacheMessage) ([]*QueryResultCacheMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorUID; v != nil {
+		where, args = append(where, fmt.Sprintf("creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Database; v != nil {
+		where, args = append(where, fmt.Sprintf("database = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Worksheet; v != nil {
+		where, args = append(where, fmt.Sprintf("worksheet = $%d", len(args)+1)), append(args, *v)
+	}
+	resultColumn := "''::BYTEA"
+	if find.IncludeResult {
+		resultColumn = "result"
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			database,
+			worksheet,
+			statement,
+			%s
+		FROM query_result_cache
+		WHERE %s
+		ORDER BY id DESC`, resultColumn, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var caches []*QueryResultCacheMessage
+	for rows.Next() {
+		cache := &QueryResultCacheMessage{}
+		var createdTs int64
+		if err := rows.Scan(
+			&cache.UID,
+			&cache.CreatorUID,
+			&createdTs,
+			&cache.Database,
+			&cache.Worksheet,
+			&cache.Statement,
+			&cache.Result,
+		); err != nil {
+			return nil, err
+		}
+		cache.CreatedTime = time.Unix(createdTs, 0)
+		caches = append(caches, cache)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return caches, nil
+}
+
+// CreateQueryResultCache caches a query result and evicts the oldest results of the same creator, database and worksheet
+// so that at most keep results are cached.
+func (s *Store) CreateQueryResultCache(ctx context.Context, create *QueryResultCacheMessage, keep int) (*QueryResultCacheMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var createdTs int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO query_result_cache (
+			creator_id,
+			database,
+			worksheet,
+			statement,
+			result
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_ts`,
+		create.CreatorUID,
+		create.Database,
+		create.Worksheet,
+		create.Statement,
+		create.Result,
+	).Scan(&create.UID, &createdTs); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM query_result_cache
+		WHERE creator_id = $1 AND database = $2 AND worksheet = $3 AND id NOT IN (
+			SELECT id FROM query_result_cache
+			WHERE creator_id = $1 AND database = $2 AND worksheet = $3
+			ORDER BY id DESC
+			LIMIT $4
+		)`,
+		create.CreatorUID,
+		create.Database,
+		create.Worksheet,
+		keep,
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to evict cached query results")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit transaction")
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+	return create, nil
+}
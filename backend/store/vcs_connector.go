@@ -49,6 +49,12 @@ type UpdateVCSConnectorMessage struct {
 	Branch        *string
 	BaseDirectory *string
 	DatabaseGroup *string
+	Trigger       *storepb.VCSConnector_Trigger
+	TagPattern    *string
+	// SchemaWriteBack is set to an empty message to disable the schema write-back.
+	SchemaWriteBack *storepb.VCSConnector_SchemaWriteBack
+	// ExternalWebhookID is the comma separated IDs of the webhooks in the VCS.
+	ExternalWebhookID *string
 }
 
 // GetVCSConnector gets a VCS connector.
@@ -206,6 +212,22 @@ func (s *Store) UpdateVCSConnector(ctx context.Context, update *UpdateVCSConnect
 	if v := update.DatabaseGroup; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('databaseGroup', $%d::TEXT)", len(args)+1)), append(args, *v)
 	}
+	if v := update.Trigger; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('trigger', $%d::TEXT)", len(args)+1)), append(args, v.String())
+	}
+	if v := update.TagPattern; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('tagPattern', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
+	if v := update.SchemaWriteBack; v != nil {
+		schemaWriteBack, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('schemaWriteBack', $%d::JSONB)", len(args)+1)), append(args, schemaWriteBack)
+	}
+	if v := update.ExternalWebhookID; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf("jsonb_build_object('externalWebhookId', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
 	if len(payloadSet) != 0 {
 		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
 	}
@@ -231,6 +253,27 @@ func (s *Store) UpdateVCSConnector(ctx context.Context, update *UpdateVCSConnect
 	return tx.Commit()
 }
 
+// UpdateVCSConnectorLastProcessedCommit moves the last processed commit of the VCS connector
+// from oldCommit to newCommit. The update is a compare-and-swap, so concurrent or replayed
+// push events claim the same commit range at most once. It returns false if the last processed
+// commit is no longer oldCommit.
+func (s *Store) UpdateVCSConnectorLastProcessedCommit(ctx context.Context, uid int, oldCommit, newCommit string) (bool, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		UPDATE vcs_connector
+		SET payload = payload || jsonb_build_object('lastProcessedCommit', $1::TEXT)
+		WHERE id = $2 AND COALESCE(payload->>'lastProcessedCommit', '') = $3`,
+		newCommit, uid, oldCommit,
+	)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update last processed commit")
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get rows affected")
+	}
+	return rows == 1, nil
+}
+
 // DeleteVCSConnector deletes a VCS connector.
 func (s *Store) DeleteVCSConnector(ctx context.Context, projectID, resourceID string) error {
 	tx, err := s.db.BeginTx(ctx, nil)
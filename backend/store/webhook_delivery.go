@@ -0,0 +1,326 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the status of a delivery waiting for the next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is the status of a delivery accepted by the webhook endpoint.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is the status of a delivery that exhausts all attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookDeliveryMessage is the message for a delivery of an event to a project webhook.
+type WebhookDeliveryMessage struct {
+	ProjectWebhookID int
+	// Event is the JSON encoded webhook context of the event.
+	Event           []byte
+	Status          WebhookDeliveryStatus
+	NextAttemptTime time.Time
+	Payload         *storepb.WebhookDeliveryPayload
+
+	// Output only fields.
+	UID         int
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	UID              *int
+	ProjectWebhookID *int
+	Limit            *int
+	Offset           *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery.
+type UpdateWebhookDeliveryMessage struct {
+	UID             int
+	Status          *WebhookDeliveryStatus
+	NextAttemptTime *time.Time
+	Payload         *storepb.WebhookDeliveryPayload
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d webhook deliveries with filter %+v, expect 1", len(deliveries), find)}
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists webhook deliveries, the latest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectWebhookID; v != nil {
+		where, args = append(where, fmt.Sprintf("project_webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			project_webhook_id,
+			status,
+			next_attempt_ts,
+			event,
+			payload
+		FROM webhook_delivery
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// CreateWebhookDeliveries enqueues the webhook deliveries to the outbox in a single transaction.
+func (s *Store) CreateWebhookDeliveries(ctx context.Context, creates []*WebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_delivery (
+			project_webhook_id,
+			status,
+			next_attempt_ts,
+			event,
+			payload
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_ts, updated_ts
+	`
+	for _, create := range creates {
+		payload, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		var createdTs, updatedTs int64
+		if err := tx.QueryRowContext(ctx, query,
+			create.ProjectWebhookID,
+			create.Status,
+			create.NextAttemptTime.Unix(),
+			create.Event,
+			payload,
+		).Scan(
+			&create.UID,
+			&createdTs,
+			&updatedTs,
+		); err != nil {
+			return nil, err
+		}
+		create.CreatedTime = time.Unix(createdTs, 0)
+		create.UpdatedTime = time.Unix(updatedTs, 0)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return creates, nil
+}
+
+// ClaimWebhookDeliveries claims at most limit pending deliveries that are due.
+// The next attempt of the claimed deliveries is postponed by lease so that they are neither claimed
+// by other replicas nor lost if the server stops before the attempt is recorded.
+func (s *Store) ClaimWebhookDeliveries(ctx context.Context, lease time.Duration, limit int) ([]*WebhookDeliveryMessage, error) {
+	now := time.Now()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE webhook_delivery
+		SET next_attempt_ts = $1
+		WHERE id IN (
+			SELECT id FROM webhook_delivery
+			WHERE status = $2 AND next_attempt_ts <= $3
+			ORDER BY next_attempt_ts
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id,
+			created_ts,
+			updated_ts,
+			project_webhook_id,
+			status,
+			next_attempt_ts,
+			event,
+			payload`,
+		now.Add(lease).Unix(),
+		WebhookDeliveryPending,
+		now.Unix(),
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates a webhook delivery.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, patch *UpdateWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	set, args := []string{"updated_ts = extract(epoch from now())"}, []any{}
+	if v := patch.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := patch.NextAttemptTime; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_ts = $%d", len(args)+1)), append(args, v.Unix())
+	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	args = append(args, patch.UID)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	delivery, err := scanWebhookDelivery(tx.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE webhook_delivery
+		SET %s
+		WHERE id = $%d
+		RETURNING
+			id,
+			created_ts,
+			updated_ts,
+			project_webhook_id,
+			status,
+			next_attempt_ts,
+			event,
+			payload`, strings.Join(set, ", "), len(args)),
+		args...,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("webhook delivery %d not found", patch.UID)}
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// DeleteWebhookDeliveries deletes the finished deliveries last updated before the given time.
+func (s *Store) DeleteWebhookDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.db.ExecContext(ctx, `DELETE FROM webhook_delivery WHERE status != $1 AND updated_ts < $2`, WebhookDeliveryPending, before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+type webhookDeliveryScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWebhookDelivery(scanner webhookDeliveryScanner) (*WebhookDeliveryMessage, error) {
+	var delivery WebhookDeliveryMessage
+	var createdTs, updatedTs, nextAttemptTs int64
+	var payload []byte
+	if err := scanner.Scan(
+		&delivery.UID,
+		&createdTs,
+		&updatedTs,
+		&delivery.ProjectWebhookID,
+		&delivery.Status,
+		&nextAttemptTs,
+		&delivery.Event,
+		&payload,
+	); err != nil {
+		return nil, err
+	}
+	deliveryPayload := &storepb.WebhookDeliveryPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, deliveryPayload); err != nil {
+		return nil, err
+	}
+	delivery.Payload = deliveryPayload
+	delivery.CreatedTime = time.Unix(createdTs, 0)
+	delivery.UpdatedTime = time.Unix(updatedTs, 0)
+	delivery.NextAttemptTime = time.Unix(nextAttemptTs, 0)
+	return &delivery, nil
+}
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+)
+
+// WebhookEventRecordMessage is the message for the webhook events raised for a subject.
+type WebhookEventRecordMessage struct {
+	// Key is the subject of the events, e.g. the expiring role grant or the pull request.
+	Key string
+	// Fingerprints are the fingerprints of the notified contents, e.g. the SQL review violations of the pull request.
+	Fingerprints []string
+	// ExpireTime is when the record can be deleted.
+	ExpireTime time.Time
+}
+
+// GetWebhookEventRecord gets the webhook event record by key.
+func (s *Store) GetWebhookEventRecord(ctx context.Context, key string) (*WebhookEventRecordMessage, error) {
+	record := &WebhookEventRecordMessage{Key: key}
+	var fingerprints pgtype.TextArray
+	var expireTs int64
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT fingerprints, expire_ts
+		FROM webhook_event_record
+		WHERE key = $1`,
+		key,
+	).Scan(&fingerprints, &expireTs); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := fingerprints.AssignTo(&record.Fingerprints); err != nil {
+		return nil, errors.Wrapf(err, "failed to assign fingerprints")
+	}
+	record.ExpireTime = time.Unix(expireTs, 0)
+	return record, nil
+}
+
+// CreateWebhookEventRecordIfNotExists creates the webhook event record.
+// It returns false if the record of the key exists, which means the event has been raised.
+func (s *Store) CreateWebhookEventRecordIfNotExists(ctx context.Context, create *WebhookEventRecordMessage) (bool, error) {
+	result, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO webhook_event_record (key, fingerprints, expire_ts)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO NOTHING`,
+		create.Key,
+		create.Fingerprints,
+		create.ExpireTime.Unix(),
+	)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// UpsertWebhookEventRecord creates or replaces the webhook event record.
+func (s *Store) UpsertWebhookEventRecord(ctx context.Context, upsert *WebhookEventRecordMessage) error {
+	if _, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO webhook_event_record (key, fingerprints, expire_ts)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET
+			updated_ts = extract(epoch from now()),
+			fingerprints = EXCLUDED.fingerprints,
+			expire_ts = EXCLUDED.expire_ts`,
+		upsert.Key,
+		upsert.Fingerprints,
+		upsert.ExpireTime.Unix(),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteExpiredWebhookEventRecords deletes the webhook event records expired before the time.
+func (s *Store) DeleteExpiredWebhookEventRecords(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.db.ExecContext(ctx, `DELETE FROM webhook_event_record WHERE expire_ts < $1`, before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: store/access_token.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AccessTokenPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The permissions granted to the token, e.g. bb.databases.query.
+	// The token inherits all permissions of its owner if empty.
+	Permissions []string `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// The project resource ID that the token is scoped to.
+	// The token can access all projects of its owner if empty.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// The ID of the token that this token was rotated from.
+	RotatedFrom int32 `protobuf:"varint,3,opt,name=rotated_from,json=rotatedFrom,proto3" json:"rotated_from,omitempty"`
+}
+
+func (x *AccessTokenPayload) Reset() {
+	*x = AccessTokenPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_access_token_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessTokenPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokenPayload) ProtoMessage() {}
+
+func (x *AccessTokenPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_access_token_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokenPayload.ProtoReflect.Descriptor instead.
+func (*AccessTokenPayload) Descriptor() ([]byte, []int) {
+	return file_store_access_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccessTokenPayload) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccessTokenPayload) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *AccessTokenPayload) GetRotatedFrom() int32 {
+	if x != nil {
+		return x.RotatedFrom
+	}
+	return 0
+}
+
+var File_store_access_token_proto protoreflect.FileDescriptor
+
+var file_store_access_token_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x73, 0x0a, 0x12, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+	0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_access_token_proto_rawDescOnce sync.Once
+	file_store_access_token_proto_rawDescData = file_store_access_token_proto_rawDesc
+)
+
+func file_store_access_token_proto_rawDescGZIP() []byte {
+	file_store_access_token_proto_rawDescOnce.Do(func() {
+		file_store_access_token_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_access_token_proto_rawDescData)
+	})
+	return file_store_access_token_proto_rawDescData
+}
+
+var file_store_access_token_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_access_token_proto_goTypes = []any{
+	(*AccessTokenPayload)(nil), // 0: bytebase.store.AccessTokenPayload
+}
+var file_store_access_token_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_store_access_token_proto_init() }
+func file_store_access_token_proto_init() {
+	if File_store_access_token_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_access_token_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AccessTokenPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_access_token_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_access_token_proto_goTypes,
+		DependencyIndexes: file_store_access_token_proto_depIdxs,
+		MessageInfos:      file_store_access_token_proto_msgTypes,
+	}.Build()
+	File_store_access_token_proto = out.File
+	file_store_access_token_proto_rawDesc = nil
+	file_store_access_token_proto_goTypes = nil
+	file_store_access_token_proto_depIdxs = nil
+}
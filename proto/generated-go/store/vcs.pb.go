@@ -20,6 +20,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type VCSConnector_Trigger int32
+
+const (
+	// Unspecified is treated as PULL_REQUEST for the existing connectors.
+	VCSConnector_TRIGGER_UNSPECIFIED VCSConnector_Trigger = 0
+	// Review the pull requests and create the issue when they are merged.
+	VCSConnector_PULL_REQUEST VCSConnector_Trigger = 1
+	// Create the issue for the new migration files pushed to the branch.
+	VCSConnector_PUSH VCSConnector_Trigger = 2
+	// Create the issue for the new migration files when a matching tag is pushed.
+	VCSConnector_TAG VCSConnector_Trigger = 3
+)
+
+// Enum value maps for VCSConnector_Trigger.
+var (
+	VCSConnector_Trigger_name = map[int32]string{
+		0: "TRIGGER_UNSPECIFIED",
+		1: "PULL_REQUEST",
+		2: "PUSH",
+		3: "TAG",
+	}
+	VCSConnector_Trigger_value = map[string]int32{
+		"TRIGGER_UNSPECIFIED": 0,
+		"PULL_REQUEST":        1,
+		"PUSH":                2,
+		"TAG":                 3,
+	}
+)
+
+func (x VCSConnector_Trigger) Enum() *VCSConnector_Trigger {
+	p := new(VCSConnector_Trigger)
+	*p = x
+	return p
+}
+
+func (x VCSConnector_Trigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VCSConnector_Trigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_vcs_proto_enumTypes[0].Descriptor()
+}
+
+func (VCSConnector_Trigger) Type() protoreflect.EnumType {
+	return &file_store_vcs_proto_enumTypes[0]
+}
+
+func (x VCSConnector_Trigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VCSConnector_Trigger.Descriptor instead.
+func (VCSConnector_Trigger) EnumDescriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
 type VCSConnector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -47,7 +103,15 @@ type VCSConnector struct {
 	WebhookSecretToken string `protobuf:"bytes,8,opt,name=webhook_secret_token,json=webhookSecretToken,proto3" json:"webhook_secret_token,omitempty"`
 	// Apply changes to the database group. Optional, if not set, will apply changes to all databases in the project.
 	// Format: projects/{project}/databaseGroups/{databaseGroup}
-	DatabaseGroup string `protobuf:"bytes,9,opt,name=database_group,json=databaseGroup,proto3" json:"database_group,omitempty"`
+	DatabaseGroup string               `protobuf:"bytes,9,opt,name=database_group,json=databaseGroup,proto3" json:"database_group,omitempty"`
+	Trigger       VCSConnector_Trigger `protobuf:"varint,10,opt,name=trigger,proto3,enum=bytebase.store.VCSConnector_Trigger" json:"trigger,omitempty"`
+	// The glob pattern of the tags for the TAG trigger, e.g. "v*". Empty matches all tags.
+	TagPattern string `protobuf:"bytes,11,opt,name=tag_pattern,json=tagPattern,proto3" json:"tag_pattern,omitempty"`
+	// The last commit processed by the PUSH or TAG trigger.
+	// The new migration files are collected by diffing the pushed commit against it.
+	LastProcessedCommit string `protobuf:"bytes,12,opt,name=last_processed_commit,json=lastProcessedCommit,proto3" json:"last_processed_commit,omitempty"`
+	// Write back the latest schema to the repository after rollouts. Disabled if the file path template is empty.
+	SchemaWriteBack *VCSConnector_SchemaWriteBack `protobuf:"bytes,13,opt,name=schema_write_back,json=schemaWriteBack,proto3" json:"schema_write_back,omitempty"`
 }
 
 func (x *VCSConnector) Reset() {
@@ -145,12 +209,98 @@ func (x *VCSConnector) GetDatabaseGroup() string {
 	return ""
 }
 
+func (x *VCSConnector) GetTrigger() VCSConnector_Trigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return VCSConnector_TRIGGER_UNSPECIFIED
+}
+
+func (x *VCSConnector) GetTagPattern() string {
+	if x != nil {
+		return x.TagPattern
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetLastProcessedCommit() string {
+	if x != nil {
+		return x.LastProcessedCommit
+	}
+	return ""
+}
+
+func (x *VCSConnector) GetSchemaWriteBack() *VCSConnector_SchemaWriteBack {
+	if x != nil {
+		return x.SchemaWriteBack
+	}
+	return nil
+}
+
+type VCSConnector_SchemaWriteBack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The branch to commit the latest schema to. The pull request is opened from it to the connector branch.
+	Branch string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// The path template of the schema file in the repository, e.g. "/schema/{{ENV_ID}}/{{INSTANCE_ID}}/{{DB_NAME}}/LATEST.sql".
+	// Available placeholders: {{ENV_ID}}, {{INSTANCE_ID}}, {{DB_NAME}}.
+	FilePathTemplate string `protobuf:"bytes,2,opt,name=file_path_template,json=filePathTemplate,proto3" json:"file_path_template,omitempty"`
+}
+
+func (x *VCSConnector_SchemaWriteBack) Reset() {
+	*x = VCSConnector_SchemaWriteBack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_vcs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VCSConnector_SchemaWriteBack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VCSConnector_SchemaWriteBack) ProtoMessage() {}
+
+func (x *VCSConnector_SchemaWriteBack) ProtoReflect() protoreflect.Message {
+	mi := &file_store_vcs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VCSConnector_SchemaWriteBack.ProtoReflect.Descriptor instead.
+func (*VCSConnector_SchemaWriteBack) Descriptor() ([]byte, []int) {
+	return file_store_vcs_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *VCSConnector_SchemaWriteBack) GetFilePathTemplate() string {
+	if x != nil {
+		return x.FilePathTemplate
+	}
+	return ""
+}
+
 var File_store_vcs_proto protoreflect.FileDescriptor
 
 var file_store_vcs_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x22, 0xc3, 0x02, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x22, 0xd4, 0x05, 0x0a, 0x0c, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
 	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c,
@@ -170,9 +320,34 @@ var file_store_vcs_proto_rawDesc = []byte{
 	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f,
 	0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x5f, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61,
+	0x67, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x11,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x43, 0x53, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x1a, 0x57, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x47, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x52,
+	0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x55, 0x53, 0x48, 0x10, 0x02, 0x12,
+	0x07, 0x0a, 0x03, 0x54, 0x41, 0x47, 0x10, 0x03, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -187,16 +362,21 @@ func file_store_vcs_proto_rawDescGZIP() []byte {
 	return file_store_vcs_proto_rawDescData
 }
 
-var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_vcs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_vcs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_vcs_proto_goTypes = []any{
-	(*VCSConnector)(nil), // 0: bytebase.store.VCSConnector
+	(VCSConnector_Trigger)(0),            // 0: bytebase.store.VCSConnector.Trigger
+	(*VCSConnector)(nil),                 // 1: bytebase.store.VCSConnector
+	(*VCSConnector_SchemaWriteBack)(nil), // 2: bytebase.store.VCSConnector.SchemaWriteBack
 }
 var file_store_vcs_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: bytebase.store.VCSConnector.trigger:type_name -> bytebase.store.VCSConnector.Trigger
+	2, // 1: bytebase.store.VCSConnector.schema_write_back:type_name -> bytebase.store.VCSConnector.SchemaWriteBack
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_store_vcs_proto_init() }
@@ -217,19 +397,32 @@ func file_store_vcs_proto_init() {
 				return nil
 			}
 		}
+		file_store_vcs_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VCSConnector_SchemaWriteBack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_vcs_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_vcs_proto_goTypes,
 		DependencyIndexes: file_store_vcs_proto_depIdxs,
+		EnumInfos:         file_store_vcs_proto_enumTypes,
 		MessageInfos:      file_store_vcs_proto_msgTypes,
 	}.Build()
 	File_store_vcs_proto = out.File
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: store/webhook_delivery.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WebhookDeliveryPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempts []*WebhookDeliveryPayload_Attempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// The ID of the delivery that this delivery is manually redelivered from.
+	RedeliveredFrom int32 `protobuf:"varint,2,opt,name=redelivered_from,json=redeliveredFrom,proto3" json:"redelivered_from,omitempty"`
+}
+
+func (x *WebhookDeliveryPayload) Reset() {
+	*x = WebhookDeliveryPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_webhook_delivery_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryPayload) ProtoMessage() {}
+
+func (x *WebhookDeliveryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_webhook_delivery_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryPayload.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryPayload) Descriptor() ([]byte, []int) {
+	return file_store_webhook_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhookDeliveryPayload) GetAttempts() []*WebhookDeliveryPayload_Attempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryPayload) GetRedeliveredFrom() int32 {
+	if x != nil {
+		return x.RedeliveredFrom
+	}
+	return 0
+}
+
+type WebhookDeliveryPayload_Attempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// The request body posted to the webhook endpoint. It is truncated if too large.
+	RequestBody string `protobuf:"bytes,2,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	// The HTTP status code of the response. It is 0 if no response is received.
+	ResponseCode int32 `protobuf:"varint,3,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	// The response body. It is truncated if too large.
+	ResponseBody string               `protobuf:"bytes,4,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	Latency      *durationpb.Duration `protobuf:"bytes,5,opt,name=latency,proto3" json:"latency,omitempty"`
+	// The error if the attempt failed.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *WebhookDeliveryPayload_Attempt) Reset() {
+	*x = WebhookDeliveryPayload_Attempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_webhook_delivery_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveryPayload_Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryPayload_Attempt) ProtoMessage() {}
+
+func (x *WebhookDeliveryPayload_Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_store_webhook_delivery_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryPayload_Attempt.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryPayload_Attempt) Descriptor() ([]byte, []int) {
+	return file_store_webhook_delivery_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *WebhookDeliveryPayload_Attempt) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryPayload_Attempt) GetRequestBody() string {
+	if x != nil {
+		return x.RequestBody
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryPayload_Attempt) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryPayload_Attempt) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryPayload_Attempt) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *WebhookDeliveryPayload_Attempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_store_webhook_delivery_proto protoreflect.FileDescriptor
+
+var file_store_webhook_delivery_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x83, 0x03, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62,
+	0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x1a, 0xf1, 0x01, 0x0a, 0x07, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_store_webhook_delivery_proto_rawDescOnce sync.Once
+	file_store_webhook_delivery_proto_rawDescData = file_store_webhook_delivery_proto_rawDesc
+)
+
+func file_store_webhook_delivery_proto_rawDescGZIP() []byte {
+	file_store_webhook_delivery_proto_rawDescOnce.Do(func() {
+		file_store_webhook_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(file_store_webhook_delivery_proto_rawDescData)
+	})
+	return file_store_webhook_delivery_proto_rawDescData
+}
+
+var file_store_webhook_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_webhook_delivery_proto_goTypes = []any{
+	(*WebhookDeliveryPayload)(nil),         // 0: bytebase.store.WebhookDeliveryPayload
+	(*WebhookDeliveryPayload_Attempt)(nil), // 1: bytebase.store.WebhookDeliveryPayload.Attempt
+	(*timestamppb.Timestamp)(nil),          // 2: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 3: google.protobuf.Duration
+}
+var file_store_webhook_delivery_proto_depIdxs = []int32{
+	1, // 0: bytebase.store.WebhookDeliveryPayload.attempts:type_name -> bytebase.store.WebhookDeliveryPayload.Attempt
+	2, // 1: bytebase.store.WebhookDeliveryPayload.Attempt.time:type_name -> google.protobuf.Timestamp
+	3, // 2: bytebase.store.WebhookDeliveryPayload.Attempt.latency:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_store_webhook_delivery_proto_init() }
+func file_store_webhook_delivery_proto_init() {
+	if File_store_webhook_delivery_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_store_webhook_delivery_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*WebhookDeliveryPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_webhook_delivery_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*WebhookDeliveryPayload_Attempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_store_webhook_delivery_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_webhook_delivery_proto_goTypes,
+		DependencyIndexes: file_store_webhook_delivery_proto_depIdxs,
+		MessageInfos:      file_store_webhook_delivery_proto_msgTypes,
+	}.Build()
+	File_store_webhook_delivery_proto = out.File
+	file_store_webhook_delivery_proto_rawDesc = nil
+	file_store_webhook_delivery_proto_goTypes = nil
+	file_store_webhook_delivery_proto_depIdxs = nil
+}
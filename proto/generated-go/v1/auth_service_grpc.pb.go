@@ -20,14 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_GetUser_FullMethodName      = "/bytebase.v1.AuthService/GetUser"
-	AuthService_ListUsers_FullMethodName    = "/bytebase.v1.AuthService/ListUsers"
-	AuthService_CreateUser_FullMethodName   = "/bytebase.v1.AuthService/CreateUser"
-	AuthService_UpdateUser_FullMethodName   = "/bytebase.v1.AuthService/UpdateUser"
-	AuthService_DeleteUser_FullMethodName   = "/bytebase.v1.AuthService/DeleteUser"
-	AuthService_UndeleteUser_FullMethodName = "/bytebase.v1.AuthService/UndeleteUser"
-	AuthService_Login_FullMethodName        = "/bytebase.v1.AuthService/Login"
-	AuthService_Logout_FullMethodName       = "/bytebase.v1.AuthService/Logout"
+	AuthService_GetUser_FullMethodName           = "/bytebase.v1.AuthService/GetUser"
+	AuthService_ListUsers_FullMethodName         = "/bytebase.v1.AuthService/ListUsers"
+	AuthService_CreateUser_FullMethodName        = "/bytebase.v1.AuthService/CreateUser"
+	AuthService_UpdateUser_FullMethodName        = "/bytebase.v1.AuthService/UpdateUser"
+	AuthService_DeleteUser_FullMethodName        = "/bytebase.v1.AuthService/DeleteUser"
+	AuthService_UndeleteUser_FullMethodName      = "/bytebase.v1.AuthService/UndeleteUser"
+	AuthService_ListAccessTokens_FullMethodName  = "/bytebase.v1.AuthService/ListAccessTokens"
+	AuthService_CreateAccessToken_FullMethodName = "/bytebase.v1.AuthService/CreateAccessToken"
+	AuthService_RevokeAccessToken_FullMethodName = "/bytebase.v1.AuthService/RevokeAccessToken"
+	AuthService_RotateAccessToken_FullMethodName = "/bytebase.v1.AuthService/RotateAccessToken"
+	AuthService_ExchangeToken_FullMethodName     = "/bytebase.v1.AuthService/ExchangeToken"
+	AuthService_Login_FullMethodName             = "/bytebase.v1.AuthService/Login"
+	AuthService_Logout_FullMethodName            = "/bytebase.v1.AuthService/Logout"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -51,6 +56,21 @@ type AuthServiceClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	UndeleteUser(ctx context.Context, in *UndeleteUserRequest, opts ...grpc.CallOption) (*User, error)
+	// List the personal access tokens or service account keys of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the tokens.
+	ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error)
+	// Create a personal access token or a service account key for the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can create the token.
+	// The plaintext token is only returned in the response.
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error)
+	// Revoke the access token. Revoked tokens are rejected immediately.
+	RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error)
+	// Rotate the access token.
+	// A new token with the same scope is created, and the old token keeps working until the overlap window ends.
+	RotateAccessToken(ctx context.Context, in *RotateAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error)
+	// Exchange an OIDC ID token issued to a CI workload for a short-lived access token of the service account.
+	// The ID token must match the workload identity configuration of the service account.
+	ExchangeToken(ctx context.Context, in *ExchangeTokenRequest, opts ...grpc.CallOption) (*ExchangeTokenResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -123,6 +143,56 @@ func (c *authServiceClient) UndeleteUser(ctx context.Context, in *UndeleteUserRe
 	return out, nil
 }
 
+func (c *authServiceClient) ListAccessTokens(ctx context.Context, in *ListAccessTokensRequest, opts ...grpc.CallOption) (*ListAccessTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccessTokensResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListAccessTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessToken)
+	err := c.cc.Invoke(ctx, AuthService_CreateAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeAccessToken(ctx context.Context, in *RevokeAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessToken)
+	err := c.cc.Invoke(ctx, AuthService_RevokeAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RotateAccessToken(ctx context.Context, in *RotateAccessTokenRequest, opts ...grpc.CallOption) (*AccessToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccessToken)
+	err := c.cc.Invoke(ctx, AuthService_RotateAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ExchangeToken(ctx context.Context, in *ExchangeTokenRequest, opts ...grpc.CallOption) (*ExchangeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExchangeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -164,6 +234,21 @@ type AuthServiceServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error)
+	// List the personal access tokens or service account keys of the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can list the tokens.
+	ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error)
+	// Create a personal access token or a service account key for the user.
+	// Only the user itself and the user with bb.users.update permission on the workspace can create the token.
+	// The plaintext token is only returned in the response.
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*AccessToken, error)
+	// Revoke the access token. Revoked tokens are rejected immediately.
+	RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*AccessToken, error)
+	// Rotate the access token.
+	// A new token with the same scope is created, and the old token keeps working until the overlap window ends.
+	RotateAccessToken(context.Context, *RotateAccessTokenRequest) (*AccessToken, error)
+	// Exchange an OIDC ID token issued to a CI workload for a short-lived access token of the service account.
+	// The ID token must match the workload identity configuration of the service account.
+	ExchangeToken(context.Context, *ExchangeTokenRequest) (*ExchangeTokenResponse, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthServiceServer()
@@ -194,6 +279,21 @@ func (UnimplementedAuthServiceServer) DeleteUser(context.Context, *DeleteUserReq
 func (UnimplementedAuthServiceServer) UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndeleteUser not implemented")
 }
+func (UnimplementedAuthServiceServer) ListAccessTokens(context.Context, *ListAccessTokensRequest) (*ListAccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*AccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeAccessToken(context.Context, *RevokeAccessTokenRequest) (*AccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAccessToken not implemented")
+}
+func (UnimplementedAuthServiceServer) RotateAccessToken(context.Context, *RotateAccessTokenRequest) (*AccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAccessToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ExchangeToken(context.Context, *ExchangeTokenRequest) (*ExchangeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeToken not implemented")
+}
 func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -329,6 +429,96 @@ func _AuthService_UndeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ListAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListAccessTokens(ctx, req.(*ListAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeAccessToken(ctx, req.(*RevokeAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RotateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RotateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RotateAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RotateAccessToken(ctx, req.(*RotateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ExchangeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExchangeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExchangeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExchangeToken(ctx, req.(*ExchangeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -396,6 +586,26 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UndeleteUser",
 			Handler:    _AuthService_UndeleteUser_Handler,
 		},
+		{
+			MethodName: "ListAccessTokens",
+			Handler:    _AuthService_ListAccessTokens_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _AuthService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "RevokeAccessToken",
+			Handler:    _AuthService_RevokeAccessToken_Handler,
+		},
+		{
+			MethodName: "RotateAccessToken",
+			Handler:    _AuthService_RotateAccessToken_Handler,
+		},
+		{
+			MethodName: "ExchangeToken",
+			Handler:    _AuthService_ExchangeToken_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _AuthService_Login_Handler,
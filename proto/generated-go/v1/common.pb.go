@@ -98,6 +98,9 @@ const (
 	Engine_DYNAMODB           Engine = 23
 	Engine_DATABRICKS         Engine = 24
 	Engine_COCKROACHDB        Engine = 25
+	Engine_DUCKDB             Engine = 26
+	Engine_DB2                Engine = 27
+	Engine_TRINO              Engine = 28
 )
 
 // Enum value maps for Engine.
@@ -129,6 +132,9 @@ var (
 		23: "DYNAMODB",
 		24: "DATABRICKS",
 		25: "COCKROACHDB",
+		26: "DUCKDB",
+		27: "DB2",
+		28: "TRINO",
 	}
 	Engine_value = map[string]int32{
 		"ENGINE_UNSPECIFIED": 0,
@@ -157,6 +163,9 @@ var (
 		"DYNAMODB":           23,
 		"DATABRICKS":         24,
 		"COCKROACHDB":        25,
+		"DUCKDB":             26,
+		"DB2":                27,
+		"TRINO":              28,
 	}
 )
 
@@ -200,6 +209,8 @@ const (
 	VCSType_BITBUCKET VCSType = 3
 	// Azure DevOps. Using for Azure DevOps GitOps workflow.
 	VCSType_AZURE_DEVOPS VCSType = 4
+	// Gitea type. Using for Gitea and its fork Forgejo.
+	VCSType_GITEA VCSType = 5
 )
 
 // Enum value maps for VCSType.
@@ -210,6 +221,7 @@ var (
 		2: "GITLAB",
 		3: "BITBUCKET",
 		4: "AZURE_DEVOPS",
+		5: "GITEA",
 	}
 	VCSType_value = map[string]int32{
 		"VCS_TYPE_UNSPECIFIED": 0,
@@ -217,6 +229,7 @@ var (
 		"GITLAB":               2,
 		"BITBUCKET":            3,
 		"AZURE_DEVOPS":         4,
+		"GITEA":                5,
 	}
 )
 
@@ -307,6 +320,9 @@ const (
 	ExportFormat_JSON               ExportFormat = 2
 	ExportFormat_SQL                ExportFormat = 3
 	ExportFormat_XLSX               ExportFormat = 4
+	ExportFormat_PARQUET            ExportFormat = 5
+	// NDJSON is the newline delimited JSON with one row per line.
+	ExportFormat_NDJSON ExportFormat = 6
 )
 
 // Enum value maps for ExportFormat.
@@ -317,6 +333,8 @@ var (
 		2: "JSON",
 		3: "SQL",
 		4: "XLSX",
+		5: "PARQUET",
+		6: "NDJSON",
 	}
 	ExportFormat_value = map[string]int32{
 		"FORMAT_UNSPECIFIED": 0,
@@ -324,6 +342,8 @@ var (
 		"JSON":               2,
 		"SQL":                3,
 		"XLSX":               4,
+		"PARQUET":            5,
+		"NDJSON":             6,
 	}
 )
 
@@ -479,7 +499,7 @@ var file_v1_common_proto_rawDesc = []byte{
 	0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
 	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56,
 	0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02,
-	0x2a, 0xf6, 0x02, 0x0a, 0x06, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x45,
+	0x2a, 0x96, 0x03, 0x0a, 0x06, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x45,
 	0x4e, 0x47, 0x49, 0x4e, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
 	0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x48, 0x4f, 0x55, 0x53,
 	0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x02, 0x12, 0x0c,
@@ -502,24 +522,29 @@ var file_v1_common_proto_rawDesc = []byte{
 	0x42, 0x49, 0x47, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x16, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x59,
 	0x4e, 0x41, 0x4d, 0x4f, 0x44, 0x42, 0x10, 0x17, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x41, 0x54, 0x41,
 	0x42, 0x52, 0x49, 0x43, 0x4b, 0x53, 0x10, 0x18, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x43, 0x4b,
-	0x52, 0x4f, 0x41, 0x43, 0x48, 0x44, 0x42, 0x10, 0x19, 0x2a, 0x5c, 0x0a, 0x07, 0x56, 0x43, 0x53,
+	0x52, 0x4f, 0x41, 0x43, 0x48, 0x44, 0x42, 0x10, 0x19, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x55, 0x43,
+	0x4b, 0x44, 0x42, 0x10, 0x1a, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x42, 0x32, 0x10, 0x1b, 0x12, 0x09,
+	0x0a, 0x05, 0x54, 0x52, 0x49, 0x4e, 0x4f, 0x10, 0x1c, 0x2a, 0x67, 0x0a, 0x07, 0x56, 0x43, 0x53,
 	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x43, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
 	0x0a, 0x06, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49,
 	0x54, 0x4c, 0x41, 0x42, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x49, 0x54, 0x42, 0x55, 0x43,
 	0x4b, 0x45, 0x54, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x5a, 0x55, 0x52, 0x45, 0x5f, 0x44,
-	0x45, 0x56, 0x4f, 0x50, 0x53, 0x10, 0x04, 0x2a, 0x4e, 0x0a, 0x0c, 0x4d, 0x61, 0x73, 0x6b, 0x69,
-	0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x53, 0x4b, 0x49,
-	0x4e, 0x47, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a,
-	0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e,
-	0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x58,
-	0x4c, 0x53, 0x58, 0x10, 0x04, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x56, 0x4f, 0x50, 0x53, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x49, 0x54, 0x45, 0x41,
+	0x10, 0x05, 0x2a, 0x4e, 0x0a, 0x0c, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x53, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c,
+	0x10, 0x03, 0x2a, 0x65, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53,
+	0x56, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x53, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x04,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x05, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x06, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
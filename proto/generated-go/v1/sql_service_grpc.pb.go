@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,16 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SQLService_Query_FullMethodName                = "/bytebase.v1.SQLService/Query"
-	SQLService_Execute_FullMethodName              = "/bytebase.v1.SQLService/Execute"
-	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
-	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
-	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
-	SQLService_Check_FullMethodName                = "/bytebase.v1.SQLService/Check"
-	SQLService_ParseMyBatisMapper_FullMethodName   = "/bytebase.v1.SQLService/ParseMyBatisMapper"
-	SQLService_Pretty_FullMethodName               = "/bytebase.v1.SQLService/Pretty"
-	SQLService_StringifyMetadata_FullMethodName    = "/bytebase.v1.SQLService/StringifyMetadata"
-	SQLService_GenerateRestoreSQL_FullMethodName   = "/bytebase.v1.SQLService/GenerateRestoreSQL"
+	SQLService_Query_FullMethodName                            = "/bytebase.v1.SQLService/Query"
+	SQLService_QueryStream_FullMethodName                      = "/bytebase.v1.SQLService/QueryStream"
+	SQLService_CancelQuery_FullMethodName                      = "/bytebase.v1.SQLService/CancelQuery"
+	SQLService_ExplainQuery_FullMethodName                     = "/bytebase.v1.SQLService/ExplainQuery"
+	SQLService_CreateEditorSession_FullMethodName              = "/bytebase.v1.SQLService/CreateEditorSession"
+	SQLService_ListEditorSessions_FullMethodName               = "/bytebase.v1.SQLService/ListEditorSessions"
+	SQLService_DeleteEditorSession_FullMethodName              = "/bytebase.v1.SQLService/DeleteEditorSession"
+	SQLService_BeginEditorSessionTransaction_FullMethodName    = "/bytebase.v1.SQLService/BeginEditorSessionTransaction"
+	SQLService_CommitEditorSessionTransaction_FullMethodName   = "/bytebase.v1.SQLService/CommitEditorSessionTransaction"
+	SQLService_RollbackEditorSessionTransaction_FullMethodName = "/bytebase.v1.SQLService/RollbackEditorSessionTransaction"
+	SQLService_ListQueryResults_FullMethodName                 = "/bytebase.v1.SQLService/ListQueryResults"
+	SQLService_CompareQueryResults_FullMethodName              = "/bytebase.v1.SQLService/CompareQueryResults"
+	SQLService_Execute_FullMethodName                          = "/bytebase.v1.SQLService/Execute"
+	SQLService_AdminExecute_FullMethodName                     = "/bytebase.v1.SQLService/AdminExecute"
+	SQLService_SearchQueryHistories_FullMethodName             = "/bytebase.v1.SQLService/SearchQueryHistories"
+	SQLService_Export_FullMethodName                           = "/bytebase.v1.SQLService/Export"
+	SQLService_ExportStream_FullMethodName                     = "/bytebase.v1.SQLService/ExportStream"
+	SQLService_Check_FullMethodName                            = "/bytebase.v1.SQLService/Check"
+	SQLService_ParseMyBatisMapper_FullMethodName               = "/bytebase.v1.SQLService/ParseMyBatisMapper"
+	SQLService_Pretty_FullMethodName                           = "/bytebase.v1.SQLService/Pretty"
+	SQLService_StringifyMetadata_FullMethodName                = "/bytebase.v1.SQLService/StringifyMetadata"
+	SQLService_GenerateRestoreSQL_FullMethodName               = "/bytebase.v1.SQLService/GenerateRestoreSQL"
 )
 
 // SQLServiceClient is the client API for SQLService service.
@@ -36,11 +49,49 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SQLServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// QueryStream streams the result of a single query statement in batches from a live database cursor.
+	// The request with the cursor of a previous response fetches the next page of the same query.
+	// The connection of the cursor is released once all rows are read or the cursor is idle for a while.
+	QueryStream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryStreamResponse], error)
+	// CancelQuery cancels the running query on the database side.
+	// Only the user who issued the query or the workspace admin can cancel it.
+	CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExplainQuery returns the normalized query plan of a single query statement with the automated findings.
+	ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...grpc.CallOption) (*ExplainQueryResponse, error)
+	// CreateEditorSession creates a SQL Editor session pinned to a dedicated database connection.
+	// The statements of the Query and AdminExecute requests in the session run in the same connection,
+	// so the session variables, temporary tables and transactions are kept between them.
+	// The session is ephemeral. It lives in the memory of the server replica that creates it, so the requests of
+	// the session must be routed to the same replica, e.g. with sticky sessions in the high availability deployment.
+	// The session is closed and its open transaction is rolled back after it's idle for the idle timeout,
+	// or when the replica shuts down.
+	CreateEditorSession(ctx context.Context, in *CreateEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	// ListEditorSessions lists the SQL Editor sessions of the caller in the database.
+	ListEditorSessions(ctx context.Context, in *ListEditorSessionsRequest, opts ...grpc.CallOption) (*ListEditorSessionsResponse, error)
+	// DeleteEditorSession closes the SQL Editor session and releases its connection.
+	// The open transaction of the session is rolled back.
+	DeleteEditorSession(ctx context.Context, in *DeleteEditorSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BeginEditorSessionTransaction begins a transaction in the admin SQL Editor session.
+	// The statements of the following AdminExecute requests in the session run in the transaction until it's committed or rolled back.
+	BeginEditorSessionTransaction(ctx context.Context, in *BeginEditorSessionTransactionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	// CommitEditorSessionTransaction commits the open transaction of the admin SQL Editor session.
+	CommitEditorSessionTransaction(ctx context.Context, in *CommitEditorSessionTransactionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	// RollbackEditorSessionTransaction rolls back the open transaction of the admin SQL Editor session.
+	RollbackEditorSessionTransaction(ctx context.Context, in *RollbackEditorSessionTransactionRequest, opts ...grpc.CallOption) (*EditorSession, error)
+	// ListQueryResults lists the cached query results of the caller in the database, latest first.
+	ListQueryResults(ctx context.Context, in *ListQueryResultsRequest, opts ...grpc.CallOption) (*ListQueryResultsResponse, error)
+	// CompareQueryResults compares two cached results of the same query by the key columns.
+	CompareQueryResults(ctx context.Context, in *CompareQueryResultsRequest, opts ...grpc.CallOption) (*CompareQueryResultsResponse, error)
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error)
 	// SearchQueryHistories searches query histories for the caller.
 	SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error)
+	// Export returns the whole export file in a single response.
+	// Use ExportStream for the large results.
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// ExportStream streams the export file content in chunks, so that the file is never held in memory.
+	// The file is the concatenation of the content of all responses.
+	ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
 	ParseMyBatisMapper(ctx context.Context, in *ParseMyBatisMapperRequest, opts ...grpc.CallOption) (*ParseMyBatisMapperResponse, error)
 	Pretty(ctx context.Context, in *PrettyRequest, opts ...grpc.CallOption) (*PrettyResponse, error)
@@ -67,6 +118,125 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) QueryStream(ctx context.Context, in *QueryStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_QueryStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QueryStreamRequest, QueryStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_QueryStreamClient = grpc.ServerStreamingClient[QueryStreamResponse]
+
+func (c *sQLServiceClient) CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_CancelQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...grpc.CallOption) (*ExplainQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainQueryResponse)
+	err := c.cc.Invoke(ctx, SQLService_ExplainQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CreateEditorSession(ctx context.Context, in *CreateEditorSessionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_CreateEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) ListEditorSessions(ctx context.Context, in *ListEditorSessionsRequest, opts ...grpc.CallOption) (*ListEditorSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEditorSessionsResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListEditorSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) DeleteEditorSession(ctx context.Context, in *DeleteEditorSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_DeleteEditorSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) BeginEditorSessionTransaction(ctx context.Context, in *BeginEditorSessionTransactionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_BeginEditorSessionTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CommitEditorSessionTransaction(ctx context.Context, in *CommitEditorSessionTransactionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_CommitEditorSessionTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) RollbackEditorSessionTransaction(ctx context.Context, in *RollbackEditorSessionTransactionRequest, opts ...grpc.CallOption) (*EditorSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditorSession)
+	err := c.cc.Invoke(ctx, SQLService_RollbackEditorSessionTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) ListQueryResults(ctx context.Context, in *ListQueryResultsRequest, opts ...grpc.CallOption) (*ListQueryResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueryResultsResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListQueryResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) CompareQueryResults(ctx context.Context, in *CompareQueryResultsRequest, opts ...grpc.CallOption) (*CompareQueryResultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareQueryResultsResponse)
+	err := c.cc.Invoke(ctx, SQLService_CompareQueryResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteResponse)
@@ -79,7 +249,7 @@ func (c *sQLServiceClient) Execute(ctx context.Context, in *ExecuteRequest, opts
 
 func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_AdminExecute_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_AdminExecute_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +280,25 @@ func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts .
 	return out, nil
 }
 
+func (c *sQLServiceClient) ExportStream(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[2], SQLService_ExportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportStreamClient = grpc.ServerStreamingClient[ExportResponse]
+
 func (c *sQLServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckResponse)
@@ -165,11 +354,49 @@ func (c *sQLServiceClient) GenerateRestoreSQL(ctx context.Context, in *GenerateR
 // for forward compatibility.
 type SQLServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// QueryStream streams the result of a single query statement in batches from a live database cursor.
+	// The request with the cursor of a previous response fetches the next page of the same query.
+	// The connection of the cursor is released once all rows are read or the cursor is idle for a while.
+	QueryStream(*QueryStreamRequest, grpc.ServerStreamingServer[QueryStreamResponse]) error
+	// CancelQuery cancels the running query on the database side.
+	// Only the user who issued the query or the workspace admin can cancel it.
+	CancelQuery(context.Context, *CancelQueryRequest) (*emptypb.Empty, error)
+	// ExplainQuery returns the normalized query plan of a single query statement with the automated findings.
+	ExplainQuery(context.Context, *ExplainQueryRequest) (*ExplainQueryResponse, error)
+	// CreateEditorSession creates a SQL Editor session pinned to a dedicated database connection.
+	// The statements of the Query and AdminExecute requests in the session run in the same connection,
+	// so the session variables, temporary tables and transactions are kept between them.
+	// The session is ephemeral. It lives in the memory of the server replica that creates it, so the requests of
+	// the session must be routed to the same replica, e.g. with sticky sessions in the high availability deployment.
+	// The session is closed and its open transaction is rolled back after it's idle for the idle timeout,
+	// or when the replica shuts down.
+	CreateEditorSession(context.Context, *CreateEditorSessionRequest) (*EditorSession, error)
+	// ListEditorSessions lists the SQL Editor sessions of the caller in the database.
+	ListEditorSessions(context.Context, *ListEditorSessionsRequest) (*ListEditorSessionsResponse, error)
+	// DeleteEditorSession closes the SQL Editor session and releases its connection.
+	// The open transaction of the session is rolled back.
+	DeleteEditorSession(context.Context, *DeleteEditorSessionRequest) (*emptypb.Empty, error)
+	// BeginEditorSessionTransaction begins a transaction in the admin SQL Editor session.
+	// The statements of the following AdminExecute requests in the session run in the transaction until it's committed or rolled back.
+	BeginEditorSessionTransaction(context.Context, *BeginEditorSessionTransactionRequest) (*EditorSession, error)
+	// CommitEditorSessionTransaction commits the open transaction of the admin SQL Editor session.
+	CommitEditorSessionTransaction(context.Context, *CommitEditorSessionTransactionRequest) (*EditorSession, error)
+	// RollbackEditorSessionTransaction rolls back the open transaction of the admin SQL Editor session.
+	RollbackEditorSessionTransaction(context.Context, *RollbackEditorSessionTransactionRequest) (*EditorSession, error)
+	// ListQueryResults lists the cached query results of the caller in the database, latest first.
+	ListQueryResults(context.Context, *ListQueryResultsRequest) (*ListQueryResultsResponse, error)
+	// CompareQueryResults compares two cached results of the same query by the key columns.
+	CompareQueryResults(context.Context, *CompareQueryResultsRequest) (*CompareQueryResultsResponse, error)
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
 	AdminExecute(grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]) error
 	// SearchQueryHistories searches query histories for the caller.
 	SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error)
+	// Export returns the whole export file in a single response.
+	// Use ExportStream for the large results.
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// ExportStream streams the export file content in chunks, so that the file is never held in memory.
+	// The file is the concatenation of the content of all responses.
+	ExportStream(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
 	ParseMyBatisMapper(context.Context, *ParseMyBatisMapperRequest) (*ParseMyBatisMapperResponse, error)
 	Pretty(context.Context, *PrettyRequest) (*PrettyResponse, error)
@@ -189,6 +416,39 @@ type UnimplementedSQLServiceServer struct{}
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) QueryStream(*QueryStreamRequest, grpc.ServerStreamingServer[QueryStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
+}
+func (UnimplementedSQLServiceServer) CancelQuery(context.Context, *CancelQueryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQuery not implemented")
+}
+func (UnimplementedSQLServiceServer) ExplainQuery(context.Context, *ExplainQueryRequest) (*ExplainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainQuery not implemented")
+}
+func (UnimplementedSQLServiceServer) CreateEditorSession(context.Context, *CreateEditorSessionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEditorSession not implemented")
+}
+func (UnimplementedSQLServiceServer) ListEditorSessions(context.Context, *ListEditorSessionsRequest) (*ListEditorSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEditorSessions not implemented")
+}
+func (UnimplementedSQLServiceServer) DeleteEditorSession(context.Context, *DeleteEditorSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEditorSession not implemented")
+}
+func (UnimplementedSQLServiceServer) BeginEditorSessionTransaction(context.Context, *BeginEditorSessionTransactionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginEditorSessionTransaction not implemented")
+}
+func (UnimplementedSQLServiceServer) CommitEditorSessionTransaction(context.Context, *CommitEditorSessionTransactionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitEditorSessionTransaction not implemented")
+}
+func (UnimplementedSQLServiceServer) RollbackEditorSessionTransaction(context.Context, *RollbackEditorSessionTransactionRequest) (*EditorSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackEditorSessionTransaction not implemented")
+}
+func (UnimplementedSQLServiceServer) ListQueryResults(context.Context, *ListQueryResultsRequest) (*ListQueryResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueryResults not implemented")
+}
+func (UnimplementedSQLServiceServer) CompareQueryResults(context.Context, *CompareQueryResultsRequest) (*CompareQueryResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareQueryResults not implemented")
+}
 func (UnimplementedSQLServiceServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
@@ -201,6 +461,9 @@ func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *Sear
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSQLServiceServer) ExportStream(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStream not implemented")
+}
 func (UnimplementedSQLServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
@@ -255,6 +518,197 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).QueryStream(m, &grpc.GenericServerStream[QueryStreamRequest, QueryStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_QueryStreamServer = grpc.ServerStreamingServer[QueryStreamResponse]
+
+func _SQLService_CancelQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CancelQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CancelQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CancelQuery(ctx, req.(*CancelQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ExplainQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ExplainQuery(ctx, req.(*ExplainQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CreateEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CreateEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CreateEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CreateEditorSession(ctx, req.(*CreateEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_ListEditorSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEditorSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListEditorSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListEditorSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListEditorSessions(ctx, req.(*ListEditorSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_DeleteEditorSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEditorSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).DeleteEditorSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_DeleteEditorSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).DeleteEditorSession(ctx, req.(*DeleteEditorSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_BeginEditorSessionTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginEditorSessionTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).BeginEditorSessionTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_BeginEditorSessionTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).BeginEditorSessionTransaction(ctx, req.(*BeginEditorSessionTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CommitEditorSessionTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitEditorSessionTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CommitEditorSessionTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CommitEditorSessionTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CommitEditorSessionTransaction(ctx, req.(*CommitEditorSessionTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_RollbackEditorSessionTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackEditorSessionTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).RollbackEditorSessionTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_RollbackEditorSessionTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).RollbackEditorSessionTransaction(ctx, req.(*RollbackEditorSessionTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_ListQueryResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListQueryResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListQueryResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListQueryResults(ctx, req.(*ListQueryResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_CompareQueryResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareQueryResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CompareQueryResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CompareQueryResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CompareQueryResults(ctx, req.(*CompareQueryResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteRequest)
 	if err := dec(in); err != nil {
@@ -316,6 +770,17 @@ func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ExportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).ExportStream(m, &grpc.GenericServerStream[ExportRequest, ExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_ExportStreamServer = grpc.ServerStreamingServer[ExportResponse]
+
 func _SQLService_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRequest)
 	if err := dec(in); err != nil {
@@ -417,6 +882,46 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "CancelQuery",
+			Handler:    _SQLService_CancelQuery_Handler,
+		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _SQLService_ExplainQuery_Handler,
+		},
+		{
+			MethodName: "CreateEditorSession",
+			Handler:    _SQLService_CreateEditorSession_Handler,
+		},
+		{
+			MethodName: "ListEditorSessions",
+			Handler:    _SQLService_ListEditorSessions_Handler,
+		},
+		{
+			MethodName: "DeleteEditorSession",
+			Handler:    _SQLService_DeleteEditorSession_Handler,
+		},
+		{
+			MethodName: "BeginEditorSessionTransaction",
+			Handler:    _SQLService_BeginEditorSessionTransaction_Handler,
+		},
+		{
+			MethodName: "CommitEditorSessionTransaction",
+			Handler:    _SQLService_CommitEditorSessionTransaction_Handler,
+		},
+		{
+			MethodName: "RollbackEditorSessionTransaction",
+			Handler:    _SQLService_RollbackEditorSessionTransaction_Handler,
+		},
+		{
+			MethodName: "ListQueryResults",
+			Handler:    _SQLService_ListQueryResults_Handler,
+		},
+		{
+			MethodName: "CompareQueryResults",
+			Handler:    _SQLService_CompareQueryResults_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _SQLService_Execute_Handler,
@@ -451,12 +956,22 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryStream",
+			Handler:       _SQLService_QueryStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AdminExecute",
 			Handler:       _SQLService_AdminExecute_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ExportStream",
+			Handler:       _SQLService_ExportStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/sql_service.proto",
 }